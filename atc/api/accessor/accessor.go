@@ -13,8 +13,10 @@ type Access interface {
 	IsAuthorized(string) bool
 	IsAdmin() bool
 	IsSystem() bool
+	IsWorker() bool
 	TeamNames() []string
 	CSRFToken() string
+	UserName() string
 }
 
 type access struct {
@@ -72,6 +74,16 @@ func (a *access) IsSystem() bool {
 	return false
 }
 
+func (a *access) IsWorker() bool {
+	if claims, ok := a.Token.Claims.(jwt.MapClaims); ok {
+		if isWorkerClaim, ok := claims["worker"]; ok {
+			isWorker, ok := isWorkerClaim.(bool)
+			return ok && isWorker
+		}
+	}
+	return false
+}
+
 func (a *access) TeamRoles() map[string][]string {
 	teamRoles := map[string][]string{}
 
@@ -115,6 +127,17 @@ func (a *access) CSRFToken() string {
 	return ""
 }
 
+func (a *access) UserName() string {
+	if claims, ok := a.Token.Claims.(jwt.MapClaims); ok {
+		if userNameClaim, ok := claims["user_name"]; ok {
+			if userName, ok := userNameClaim.(string); ok {
+				return userName
+			}
+		}
+	}
+	return ""
+}
+
 var requiredRoles = map[string]string{
 	atc.SaveConfig:                    "member",
 	atc.GetConfig:                     "viewer",
@@ -174,18 +197,25 @@ var requiredRoles = map[string]string{
 	atc.RegisterWorker:                "member",
 	atc.LandWorker:                    "member",
 	atc.RetireWorker:                  "member",
+	atc.CordonWorker:                  "member",
+	atc.UncordonWorker:                "member",
 	atc.PruneWorker:                   "member",
 	atc.HeartbeatWorker:               "member",
+	atc.ConnectWorker:                 "member",
 	atc.ListWorkers:                   "viewer",
+	atc.ListWorkerBuilds:              "viewer",
 	atc.DeleteWorker:                  "member",
 	atc.SetLogLevel:                   "member",
 	atc.GetLogLevel:                   "viewer",
+	atc.GetEncryptionKeyRotation:      "viewer",
 	atc.DownloadCLI:                   "viewer",
 	atc.GetInfo:                       "viewer",
 	atc.GetInfoCreds:                  "viewer",
 	atc.ListContainers:                "viewer",
 	atc.GetContainer:                  "viewer",
 	atc.HijackContainer:               "member",
+	atc.StreamOutContainerFiles:       "member",
+	atc.StreamInContainerFiles:        "member",
 	atc.ListDestroyingContainers:      "viewer",
 	atc.ReportWorkerContainers:        "member",
 	atc.ListVolumes:                   "viewer",
@@ -198,4 +228,5 @@ var requiredRoles = map[string]string{
 	atc.ListTeamBuilds:                "viewer",
 	atc.SendInputToBuildPlan:          "member",
 	atc.ReadOutputFromBuildPlan:       "member",
+	atc.ReadArtifactFromBuildStep:     "member",
 }
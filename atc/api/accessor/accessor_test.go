@@ -145,6 +145,44 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Is Worker", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, "some-action")
+		})
+
+		Context("when request has worker claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"worker": true}
+			})
+			It("returns true", func() {
+				Expect(access.IsWorker()).To(BeTrue())
+			})
+		})
+
+		Context("when request has worker claim set to false", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"worker": false}
+			})
+			It("returns false", func() {
+				Expect(access.IsWorker()).To(BeFalse())
+			})
+		})
+
+		Context("when request does not have worker claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{}
+			})
+			It("returns false", func() {
+				Expect(access.IsWorker()).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Is authenticated", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -270,6 +308,34 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Get User Name", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, "some-action")
+		})
+
+		Context("when request has user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"user_name": "some-user"}
+			})
+			It("returns the user name", func() {
+				Expect(access.UserName()).To(Equal("some-user"))
+			})
+		})
+
+		Context("when request does not have user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{}
+			})
+			It("returns empty", func() {
+				Expect(access.UserName()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Get Team Names", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -563,6 +629,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.RetireWorker, atc.RetireWorker, "member", true),
 		Entry("viewer :: "+atc.RetireWorker, atc.RetireWorker, "viewer", false),
 
+		Entry("owner :: "+atc.CordonWorker, atc.CordonWorker, "owner", true),
+		Entry("member :: "+atc.CordonWorker, atc.CordonWorker, "member", true),
+		Entry("viewer :: "+atc.CordonWorker, atc.CordonWorker, "viewer", false),
+
+		Entry("owner :: "+atc.UncordonWorker, atc.UncordonWorker, "owner", true),
+		Entry("member :: "+atc.UncordonWorker, atc.UncordonWorker, "member", true),
+		Entry("viewer :: "+atc.UncordonWorker, atc.UncordonWorker, "viewer", false),
+
 		Entry("owner :: "+atc.PruneWorker, atc.PruneWorker, "owner", true),
 		Entry("member :: "+atc.PruneWorker, atc.PruneWorker, "member", true),
 		Entry("viewer :: "+atc.PruneWorker, atc.PruneWorker, "viewer", false),
@@ -571,10 +645,18 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "member", true),
 		Entry("viewer :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "viewer", false),
 
+		Entry("owner :: "+atc.ConnectWorker, atc.ConnectWorker, "owner", true),
+		Entry("member :: "+atc.ConnectWorker, atc.ConnectWorker, "member", true),
+		Entry("viewer :: "+atc.ConnectWorker, atc.ConnectWorker, "viewer", false),
+
 		Entry("owner :: "+atc.ListWorkers, atc.ListWorkers, "owner", true),
 		Entry("member :: "+atc.ListWorkers, atc.ListWorkers, "member", true),
 		Entry("viewer :: "+atc.ListWorkers, atc.ListWorkers, "viewer", true),
 
+		Entry("owner :: "+atc.ListWorkerBuilds, atc.ListWorkerBuilds, "owner", true),
+		Entry("member :: "+atc.ListWorkerBuilds, atc.ListWorkerBuilds, "member", true),
+		Entry("viewer :: "+atc.ListWorkerBuilds, atc.ListWorkerBuilds, "viewer", true),
+
 		Entry("owner :: "+atc.DeleteWorker, atc.DeleteWorker, "owner", true),
 		Entry("member :: "+atc.DeleteWorker, atc.DeleteWorker, "member", true),
 		Entry("viewer :: "+atc.DeleteWorker, atc.DeleteWorker, "viewer", false),
@@ -611,6 +693,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.HijackContainer, atc.HijackContainer, "member", true),
 		Entry("viewer :: "+atc.HijackContainer, atc.HijackContainer, "viewer", false),
 
+		Entry("owner :: "+atc.StreamOutContainerFiles, atc.StreamOutContainerFiles, "owner", true),
+		Entry("member :: "+atc.StreamOutContainerFiles, atc.StreamOutContainerFiles, "member", true),
+		Entry("viewer :: "+atc.StreamOutContainerFiles, atc.StreamOutContainerFiles, "viewer", false),
+
+		Entry("owner :: "+atc.StreamInContainerFiles, atc.StreamInContainerFiles, "owner", true),
+		Entry("member :: "+atc.StreamInContainerFiles, atc.StreamInContainerFiles, "member", true),
+		Entry("viewer :: "+atc.StreamInContainerFiles, atc.StreamInContainerFiles, "viewer", false),
+
 		Entry("owner :: "+atc.ListDestroyingContainers, atc.ListDestroyingContainers, "owner", true),
 		Entry("member :: "+atc.ListDestroyingContainers, atc.ListDestroyingContainers, "member", true),
 		Entry("viewer :: "+atc.ListDestroyingContainers, atc.ListDestroyingContainers, "viewer", true),
@@ -658,5 +748,9 @@ var _ = Describe("Accessor", func() {
 		Entry("owner :: "+atc.ReadOutputFromBuildPlan, atc.ReadOutputFromBuildPlan, "owner", true),
 		Entry("member :: "+atc.ReadOutputFromBuildPlan, atc.ReadOutputFromBuildPlan, "member", true),
 		Entry("viewer :: "+atc.ReadOutputFromBuildPlan, atc.ReadOutputFromBuildPlan, "viewer", false),
+
+		Entry("owner :: "+atc.ReadArtifactFromBuildStep, atc.ReadArtifactFromBuildStep, "owner", true),
+		Entry("member :: "+atc.ReadArtifactFromBuildStep, atc.ReadArtifactFromBuildStep, "member", true),
+		Entry("viewer :: "+atc.ReadArtifactFromBuildStep, atc.ReadArtifactFromBuildStep, "viewer", false),
 	)
 })
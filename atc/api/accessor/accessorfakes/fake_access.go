@@ -59,6 +59,16 @@ type FakeAccess struct {
 	isSystemReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsWorkerStub        func() bool
+	isWorkerMutex       sync.RWMutex
+	isWorkerArgsForCall []struct {
+	}
+	isWorkerReturns struct {
+		result1 bool
+	}
+	isWorkerReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	TeamNamesStub        func() []string
 	teamNamesMutex       sync.RWMutex
 	teamNamesArgsForCall []struct {
@@ -69,6 +79,16 @@ type FakeAccess struct {
 	teamNamesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	UserNameStub        func() string
+	userNameMutex       sync.RWMutex
+	userNameArgsForCall []struct {
+	}
+	userNameReturns struct {
+		result1 string
+	}
+	userNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -341,6 +361,58 @@ func (fake *FakeAccess) IsSystemReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsWorker() bool {
+	fake.isWorkerMutex.Lock()
+	ret, specificReturn := fake.isWorkerReturnsOnCall[len(fake.isWorkerArgsForCall)]
+	fake.isWorkerArgsForCall = append(fake.isWorkerArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsWorker", []interface{}{})
+	fake.isWorkerMutex.Unlock()
+	if fake.IsWorkerStub != nil {
+		return fake.IsWorkerStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isWorkerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsWorkerCallCount() int {
+	fake.isWorkerMutex.RLock()
+	defer fake.isWorkerMutex.RUnlock()
+	return len(fake.isWorkerArgsForCall)
+}
+
+func (fake *FakeAccess) IsWorkerCalls(stub func() bool) {
+	fake.isWorkerMutex.Lock()
+	defer fake.isWorkerMutex.Unlock()
+	fake.IsWorkerStub = stub
+}
+
+func (fake *FakeAccess) IsWorkerReturns(result1 bool) {
+	fake.isWorkerMutex.Lock()
+	defer fake.isWorkerMutex.Unlock()
+	fake.IsWorkerStub = nil
+	fake.isWorkerReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsWorkerReturnsOnCall(i int, result1 bool) {
+	fake.isWorkerMutex.Lock()
+	defer fake.isWorkerMutex.Unlock()
+	fake.IsWorkerStub = nil
+	if fake.isWorkerReturnsOnCall == nil {
+		fake.isWorkerReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isWorkerReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) TeamNames() []string {
 	fake.teamNamesMutex.Lock()
 	ret, specificReturn := fake.teamNamesReturnsOnCall[len(fake.teamNamesArgsForCall)]
@@ -393,6 +465,58 @@ func (fake *FakeAccess) TeamNamesReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakeAccess) UserName() string {
+	fake.userNameMutex.Lock()
+	ret, specificReturn := fake.userNameReturnsOnCall[len(fake.userNameArgsForCall)]
+	fake.userNameArgsForCall = append(fake.userNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UserName", []interface{}{})
+	fake.userNameMutex.Unlock()
+	if fake.UserNameStub != nil {
+		return fake.UserNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.userNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) UserNameCallCount() int {
+	fake.userNameMutex.RLock()
+	defer fake.userNameMutex.RUnlock()
+	return len(fake.userNameArgsForCall)
+}
+
+func (fake *FakeAccess) UserNameCalls(stub func() string) {
+	fake.userNameMutex.Lock()
+	defer fake.userNameMutex.Unlock()
+	fake.UserNameStub = stub
+}
+
+func (fake *FakeAccess) UserNameReturns(result1 string) {
+	fake.userNameMutex.Lock()
+	defer fake.userNameMutex.Unlock()
+	fake.UserNameStub = nil
+	fake.userNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAccess) UserNameReturnsOnCall(i int, result1 string) {
+	fake.userNameMutex.Lock()
+	defer fake.userNameMutex.Unlock()
+	fake.UserNameStub = nil
+	if fake.userNameReturnsOnCall == nil {
+		fake.userNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.userNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -406,8 +530,12 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.isAuthorizedMutex.RUnlock()
 	fake.isSystemMutex.RLock()
 	defer fake.isSystemMutex.RUnlock()
+	fake.isWorkerMutex.RLock()
+	defer fake.isWorkerMutex.RUnlock()
 	fake.teamNamesMutex.RLock()
 	defer fake.teamNamesMutex.RUnlock()
+	fake.userNameMutex.RLock()
+	defer fake.userNameMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
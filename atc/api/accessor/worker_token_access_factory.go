@@ -0,0 +1,102 @@
+package accessor
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// workerActions are the only actions which may be performed using a
+// pre-shared worker token.
+var workerActions = map[string]bool{
+	atc.RegisterWorker:           true,
+	atc.HeartbeatWorker:          true,
+	atc.ConnectWorker:            true,
+	atc.LandWorker:               true,
+	atc.RetireWorker:             true,
+	atc.DeleteWorker:             true,
+	atc.ListWorkerBuilds:         true,
+	atc.ReportWorkerContainers:   true,
+	atc.ReportWorkerVolumes:      true,
+	atc.ListDestroyingContainers: true,
+	atc.ListDestroyingVolumes:    true,
+}
+
+type workerToken struct {
+	token string
+	team  string
+}
+
+type workerTokenAccessFactory struct {
+	delegate AccessFactory
+	tokens   []workerToken
+}
+
+// NewWorkerTokenAccessFactory wraps an AccessFactory, additionally granting
+// access for worker actions to requests bearing one of the pre-shared worker
+// tokens. This allows workers to register directly with the ATC without going
+// through the TSA.
+//
+// Global tokens grant system access. Team tokens do not; they are only
+// authorized for their own team, so that workers registered, heartbeated,
+// landed, retired or deleted with them must belong to the team.
+func NewWorkerTokenAccessFactory(
+	delegate AccessFactory,
+	globalTokens []string,
+	teamTokens map[string]string,
+) AccessFactory {
+	tokens := []workerToken{}
+	for _, token := range globalTokens {
+		tokens = append(tokens, workerToken{token: token})
+	}
+
+	for team, token := range teamTokens {
+		tokens = append(tokens, workerToken{token: token, team: team})
+	}
+
+	return &workerTokenAccessFactory{
+		delegate: delegate,
+		tokens:   tokens,
+	}
+}
+
+func (a *workerTokenAccessFactory) Create(r *http.Request, action string) Access {
+	if workerActions[action] {
+		if token, found := a.findToken(r); found {
+			claims := jwt.MapClaims{"worker": true}
+			if token.team == "" {
+				claims["system"] = true
+			} else {
+				claims["teams"] = map[string][]string{token.team: []string{"member"}}
+			}
+
+			return &access{&jwt.Token{Valid: true, Claims: claims}, action}
+		}
+	}
+
+	return a.delegate.Create(r, action)
+}
+
+func (a *workerTokenAccessFactory) findToken(r *http.Request) (workerToken, bool) {
+	ah := r.Header.Get("Authorization")
+	if len(ah) <= 7 || strings.ToUpper(ah[0:6]) != "BEARER" {
+		return workerToken{}, false
+	}
+
+	bearer := []byte(ah[7:])
+
+	for _, token := range a.tokens {
+		if token.token == "" {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare(bearer, []byte(token.token)) == 1 {
+			return token, true
+		}
+	}
+
+	return workerToken{}, false
+}
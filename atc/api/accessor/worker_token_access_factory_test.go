@@ -0,0 +1,132 @@
+package accessor_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerTokenAccessFactory", func() {
+	var (
+		fakeDelegate   *accessorfakes.FakeAccessFactory
+		delegateAccess *accessorfakes.FakeAccess
+
+		accessFactory accessor.AccessFactory
+		req           *http.Request
+		action        string
+
+		access accessor.Access
+	)
+
+	BeforeEach(func() {
+		fakeDelegate = new(accessorfakes.FakeAccessFactory)
+		delegateAccess = new(accessorfakes.FakeAccess)
+		fakeDelegate.CreateReturns(delegateAccess)
+
+		accessFactory = accessor.NewWorkerTokenAccessFactory(
+			fakeDelegate,
+			[]string{"global-token"},
+			map[string]string{"some-team": "team-token"},
+		)
+
+		var err error
+		req, err = http.NewRequest("POST", "localhost:8080", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		action = atc.RegisterWorker
+	})
+
+	JustBeforeEach(func() {
+		access = accessFactory.Create(req, action)
+	})
+
+	Context("when the request bears a global worker token", func() {
+		BeforeEach(func() {
+			req.Header.Add("Authorization", "Bearer global-token")
+		})
+
+		It("grants system access without any teams", func() {
+			Expect(access.IsAuthenticated()).To(BeTrue())
+			Expect(access.IsSystem()).To(BeTrue())
+			Expect(access.IsWorker()).To(BeTrue())
+			Expect(access.IsAdmin()).To(BeFalse())
+			Expect(access.TeamNames()).To(BeEmpty())
+			Expect(fakeDelegate.CreateCallCount()).To(BeZero())
+		})
+
+		Context("when the action is not a worker action", func() {
+			BeforeEach(func() {
+				action = atc.SaveConfig
+			})
+
+			It("delegates", func() {
+				Expect(access).To(Equal(delegateAccess))
+			})
+		})
+	})
+
+	Context("when the request bears a team worker token", func() {
+		BeforeEach(func() {
+			req.Header.Add("Authorization", "Bearer team-token")
+		})
+
+		It("grants worker access scoped to the team", func() {
+			Expect(access.IsAuthenticated()).To(BeTrue())
+			Expect(access.IsWorker()).To(BeTrue())
+			Expect(access.TeamNames()).To(ConsistOf("some-team"))
+			Expect(access.IsAuthorized("some-team")).To(BeTrue())
+			Expect(access.IsAuthorized("other-team")).To(BeFalse())
+		})
+
+		It("does not grant system access", func() {
+			Expect(access.IsSystem()).To(BeFalse())
+			Expect(access.IsAdmin()).To(BeFalse())
+		})
+
+		for _, workerAction := range []string{
+			atc.DeleteWorker,
+			atc.LandWorker,
+			atc.RetireWorker,
+			atc.HeartbeatWorker,
+		} {
+			workerAction := workerAction
+
+			Context("when the action is "+workerAction, func() {
+				BeforeEach(func() {
+					action = workerAction
+				})
+
+				It("is only authorized for the token's team", func() {
+					Expect(access.IsSystem()).To(BeFalse())
+					Expect(access.IsAuthorized("some-team")).To(BeTrue())
+					Expect(access.IsAuthorized("other-team")).To(BeFalse())
+				})
+			})
+		}
+	})
+
+	Context("when the request bears an unknown token", func() {
+		BeforeEach(func() {
+			req.Header.Add("Authorization", "Bearer bogus-token")
+		})
+
+		It("delegates", func() {
+			Expect(access).To(Equal(delegateAccess))
+
+			delegatedReq, delegatedAction := fakeDelegate.CreateArgsForCall(0)
+			Expect(delegatedReq).To(Equal(req))
+			Expect(delegatedAction).To(Equal(atc.RegisterWorker))
+		})
+	})
+
+	Context("when the request has no authorization", func() {
+		It("delegates", func() {
+			Expect(access).To(Equal(delegateAccess))
+		})
+	})
+})
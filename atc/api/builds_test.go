@@ -17,6 +17,8 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine/enginefakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
@@ -1649,6 +1651,177 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/steps/:step_name/artifacts/:artifact_name", func() {
+		var (
+			response *http.Response
+
+			dbContainer     *dbfakes.FakeContainer
+			workerContainer *workerfakes.FakeContainer
+			artifactVolume  *workerfakes.FakeVolume
+		)
+
+		BeforeEach(func() {
+			dbContainer = new(dbfakes.FakeContainer)
+			dbContainer.HandleReturns("some-handle")
+			dbContainer.MetadataReturns(db.ContainerMetadata{
+				Type:             db.ContainerTypeTask,
+				StepName:         "some-task",
+				WorkingDirectory: "/tmp/build/some-dir",
+			})
+
+			otherVolume := new(workerfakes.FakeVolume)
+			artifactVolume = new(workerfakes.FakeVolume)
+			artifactVolume.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-tarball")), nil)
+
+			workerContainer = new(workerfakes.FakeContainer)
+			workerContainer.VolumeMountsReturns([]worker.VolumeMount{
+				{Volume: otherVolume, MountPath: "/tmp/build/some-dir/some-input"},
+				{Volume: artifactVolume, MountPath: "/tmp/build/some-dir/some-output/"},
+			})
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/128/steps/some-task/artifacts/some-output")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when accessing another team's build", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when accessing same team's build", func() {
+				BeforeEach(func() {
+					build.IDReturns(128)
+					build.TeamIDReturns(734)
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+					fakeaccess.IsAuthorizedReturns(true)
+
+					dbTeamFactory.GetByIDReturns(dbTeam)
+				})
+
+				Context("when the step's container still exists", func() {
+					BeforeEach(func() {
+						dbTeam.FindContainersByMetadataReturns([]db.Container{dbContainer}, nil)
+						fakeWorkerClient.FindContainerByHandleReturns(workerContainer, true, nil)
+					})
+
+					It("looks up the containers of the build's step", func() {
+						Expect(dbTeamFactory.GetByIDArgsForCall(0)).To(Equal(734))
+						Expect(dbTeam.FindContainersByMetadataArgsForCall(0)).To(Equal(db.ContainerMetadata{
+							BuildID:  128,
+							StepName: "some-task",
+						}))
+
+						_, teamID, handle := fakeWorkerClient.FindContainerByHandleArgsForCall(0)
+						Expect(teamID).To(Equal(734))
+						Expect(handle).To(Equal("some-handle"))
+					})
+
+					It("streams the artifact's volume", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("some-tarball")))
+						Expect(artifactVolume.StreamOutArgsForCall(0)).To(Equal("."))
+					})
+
+					Context("when the step is a get step", func() {
+						BeforeEach(func() {
+							dbContainer.MetadataReturns(db.ContainerMetadata{
+								Type:             db.ContainerTypeGet,
+								StepName:         "some-task",
+								WorkingDirectory: "/tmp/build/get",
+							})
+
+							workerContainer.VolumeMountsReturns([]worker.VolumeMount{
+								{Volume: artifactVolume, MountPath: "/tmp/build/get"},
+							})
+						})
+
+						It("streams the fetched resource's volume", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("some-tarball")))
+						})
+					})
+
+					Context("when the container has no such artifact", func() {
+						BeforeEach(func() {
+							workerContainer.VolumeMountsReturns(nil)
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+					})
+
+					Context("when streaming out fails", func() {
+						BeforeEach(func() {
+							artifactVolume.StreamOutReturns(nil, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
+				Context("when the container is gone from the worker", func() {
+					BeforeEach(func() {
+						dbTeam.FindContainersByMetadataReturns([]db.Container{dbContainer}, nil)
+						fakeWorkerClient.FindContainerByHandleReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when the step has no containers", func() {
+					BeforeEach(func() {
+						dbTeam.FindContainersByMetadataReturns(nil, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when finding the containers fails", func() {
+					BeforeEach(func() {
+						dbTeam.FindContainersByMetadataReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/plan/:plan_id/output", func() {
 		var (
 			otherTracker *ghttp.Server
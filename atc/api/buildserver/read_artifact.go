@@ -0,0 +1,104 @@
+package buildserver
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// ReadArtifactFromBuildStep streams an artifact out of the volume of one of
+// the build's steps, for as long as the step's container is still around.
+//
+// For task steps the artifact is identified by the output's path relative to
+// the task's working directory, as outputs are mounted by path and the task's
+// config is no longer known once the build has finished. The path is the
+// output's name unless the output sets one. Get steps have a single artifact
+// named after the step.
+func (s *Server) ReadArtifactFromBuildStep(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stepName := r.FormValue(":step_name")
+		artifactName := r.FormValue(":artifact_name")
+
+		logger := s.logger.Session("read-artifact", lager.Data{
+			"build":    build.ID(),
+			"step":     stepName,
+			"artifact": artifactName,
+		})
+
+		team := s.teamFactory.GetByID(build.TeamID())
+
+		containers, err := team.FindContainersByMetadata(db.ContainerMetadata{
+			BuildID:  build.ID(),
+			StepName: stepName,
+		})
+		if err != nil {
+			logger.Error("failed-to-find-containers", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		for _, container := range containers {
+			volume, found, err := s.artifactVolume(logger, build.TeamID(), container, artifactName)
+			if err != nil {
+				logger.Error("failed-to-find-artifact-volume", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !found {
+				continue
+			}
+
+			out, err := volume.StreamOut(".")
+			if err != nil {
+				logger.Error("failed-to-stream-out-artifact", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			defer out.Close()
+
+			w.WriteHeader(http.StatusOK)
+
+			_, err = io.Copy(w, out)
+			if err != nil {
+				logger.Info("failed-to-write-artifact", lager.Data{"error": err.Error()})
+			}
+
+			return
+		}
+
+		logger.Info("artifact-not-found")
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+func (s *Server) artifactVolume(logger lager.Logger, teamID int, container db.Container, artifactName string) (worker.Volume, bool, error) {
+	metadata := container.Metadata()
+
+	mountPath := filepath.Join(metadata.WorkingDirectory, artifactName)
+	if metadata.Type == db.ContainerTypeGet {
+		mountPath = metadata.WorkingDirectory
+	}
+
+	workerContainer, found, err := s.workerClient.FindContainerByHandle(logger, teamID, container.Handle())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	for _, mount := range workerContainer.VolumeMounts() {
+		if filepath.Clean(mount.MountPath) == filepath.Clean(mountPath) {
+			return mount.Volume, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
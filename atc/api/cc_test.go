@@ -77,7 +77,7 @@ var _ = Describe("cc.xml", func() {
 							fakeJob.TeamNameReturns("a-team")
 							fakeJob.NameReturns("some-job")
 
-							fakePipeline.DashboardReturns(db.Dashboard{
+							dbJobFactory.PipelineDashboardReturns(db.Dashboard{
 								{
 									Job: fakeJob,
 								},
@@ -92,7 +92,7 @@ var _ = Describe("cc.xml", func() {
 								succeededBuild.StatusReturns(db.BuildStatusSucceeded)
 								succeededBuild.NameReturns("42")
 								succeededBuild.EndTimeReturns(endTime)
-								fakePipeline.DashboardReturns(db.Dashboard{
+								dbJobFactory.PipelineDashboardReturns(db.Dashboard{
 									{
 										Job:           fakeJob,
 										FinishedBuild: succeededBuild,
@@ -126,7 +126,7 @@ var _ = Describe("cc.xml", func() {
 								abortedBuild.StatusReturns(db.BuildStatusAborted)
 								abortedBuild.NameReturns("42")
 								abortedBuild.EndTimeReturns(endTime)
-								fakePipeline.DashboardReturns(db.Dashboard{
+								dbJobFactory.PipelineDashboardReturns(db.Dashboard{
 									{
 										Job:           fakeJob,
 										FinishedBuild: abortedBuild,
@@ -152,7 +152,7 @@ var _ = Describe("cc.xml", func() {
 								erroredBuild.StatusReturns(db.BuildStatusErrored)
 								erroredBuild.NameReturns("42")
 								erroredBuild.EndTimeReturns(endTime)
-								fakePipeline.DashboardReturns(db.Dashboard{
+								dbJobFactory.PipelineDashboardReturns(db.Dashboard{
 									{
 										Job:           fakeJob,
 										FinishedBuild: erroredBuild,
@@ -178,7 +178,7 @@ var _ = Describe("cc.xml", func() {
 								failedBuild.StatusReturns(db.BuildStatusFailed)
 								failedBuild.NameReturns("42")
 								failedBuild.EndTimeReturns(endTime)
-								fakePipeline.DashboardReturns(db.Dashboard{
+								dbJobFactory.PipelineDashboardReturns(db.Dashboard{
 									{
 										Job:           fakeJob,
 										FinishedBuild: failedBuild,
@@ -207,7 +207,7 @@ var _ = Describe("cc.xml", func() {
 
 								nextBuild := new(dbfakes.FakeBuild)
 
-								fakePipeline.DashboardReturns(db.Dashboard{
+								dbJobFactory.PipelineDashboardReturns(db.Dashboard{
 									{
 										Job:           fakeJob,
 										FinishedBuild: finishedBuild,
@@ -240,7 +240,7 @@ var _ = Describe("cc.xml", func() {
 
 					Context("when no job is found", func() {
 						BeforeEach(func() {
-							fakePipeline.DashboardReturns(db.Dashboard{}, nil)
+							dbJobFactory.PipelineDashboardReturns(db.Dashboard{}, nil)
 						})
 
 						It("returns 200", func() {
@@ -257,7 +257,7 @@ var _ = Describe("cc.xml", func() {
 
 					Context("when finding the jobs fails", func() {
 						BeforeEach(func() {
-							fakePipeline.DashboardReturns(nil, errors.New("failed"))
+							dbJobFactory.PipelineDashboardReturns(nil, errors.New("failed"))
 						})
 
 						It("returns 500", func() {
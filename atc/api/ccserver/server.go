@@ -8,17 +8,20 @@ import (
 type Server struct {
 	logger      lager.Logger
 	teamFactory db.TeamFactory
+	jobFactory  db.JobFactory
 	externalURL string
 }
 
 func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
+	jobFactory db.JobFactory,
 	externalURL string,
 ) *Server {
 	return &Server{
 		logger:      logger,
 		teamFactory: teamFactory,
+		jobFactory:  jobFactory,
 		externalURL: externalURL,
 	}
 }
@@ -794,6 +794,74 @@ jobs:
 								})
 							})
 
+							Context("when the dry_run param is set", func() {
+								BeforeEach(func() {
+									query := request.URL.Query()
+									query.Add(atc.SaveConfigDryRun, "")
+									request.URL.RawQuery = query.Encode()
+
+									existingResource := new(dbfakes.FakeResource)
+									existingResource.NameReturns("some-resource")
+									existingResource.TypeReturns("some-type")
+									existingResource.SourceReturns(atc.Source{"uri": "https://example.com"})
+
+									removedResource := new(dbfakes.FakeResource)
+									removedResource.NameReturns("removed-resource")
+									removedResource.TypeReturns("some-type")
+
+									fakePipeline.ResourcesReturns(db.Resources{existingResource, removedResource}, nil)
+
+									fakeVariables := new(credsfakes.FakeVariables)
+									fakeVariablesFactory.NewVariablesReturns(fakeVariables)
+									fakeVariables.GetReturns(nil, false, nil)
+								})
+
+								It("returns 200", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+								})
+
+								It("returns the changes, including unresolved credentials", func() {
+									Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+										"changes": {
+											"added_jobs": ["some-job"],
+											"removed_resources": ["removed-resource"],
+											"resources_with_changed_source": ["some-resource"],
+											"unresolved_credentials": ["failed to interpolate task config: Expected to find variables: BAR"]
+										}
+									}`))
+								})
+
+								It("does not save anything", func() {
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								})
+
+								Context("when the pipeline does not exist yet", func() {
+									BeforeEach(func() {
+										dbTeam.PipelineReturns(nil, false, nil)
+									})
+
+									It("reports everything as added", func() {
+										Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+											"changes": {
+												"added_jobs": ["some-job"],
+												"added_resources": ["some-resource"],
+												"unresolved_credentials": ["failed to interpolate task config: Expected to find variables: BAR"]
+											}
+										}`))
+									})
+								})
+
+								Context("when finding the pipeline fails", func() {
+									BeforeEach(func() {
+										dbTeam.PipelineReturns(nil, false, errors.New("nope"))
+									})
+
+									It("returns 500", func() {
+										Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+									})
+								})
+							})
+
 						})
 
 						Context("when it's the first time the pipeline has been created", func() {
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/tedsuo/rata"
 )
 
@@ -42,34 +43,13 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	jobs, err := pipeline.Jobs()
-	if err != nil {
-		logger.Error("failed-to-get-jobs", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	resources, err := pipeline.Resources()
+	config, err := pipelineConfig(pipeline)
 	if err != nil {
-		logger.Error("failed-to-get-resources", err)
+		logger.Error("failed-to-get-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	resourceTypes, err := pipeline.ResourceTypes()
-	if err != nil {
-		logger.Error("failed-to-get-resourceTypes", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	config := atc.Config{
-		Groups:        pipeline.Groups(),
-		Resources:     resources.Configs(),
-		ResourceTypes: resourceTypes.Configs(),
-		Jobs:          jobs.Configs(),
-	}
-
 	rawConfig, err := json.Marshal(config)
 	if err != nil {
 		logger.Error("failed-to-marshal-config", err)
@@ -89,3 +69,27 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func pipelineConfig(pipeline db.Pipeline) (atc.Config, error) {
+	jobs, err := pipeline.Jobs()
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	return atc.Config{
+		Groups:        pipeline.Groups(),
+		Resources:     resources.Configs(),
+		ResourceTypes: resourceTypes.Configs(),
+		Jobs:          jobs.Configs(),
+	}, nil
+}
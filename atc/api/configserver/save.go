@@ -52,6 +52,9 @@ func (eke ExtraKeysError) Error() string {
 type SaveConfigResponse struct {
 	Errors   []string      `json:"errors,omitempty"`
 	Warnings []atc.Warning `json:"warnings,omitempty"`
+
+	// Changes is only set for a dry run.
+	Changes *atc.ConfigChanges `json:"changes,omitempty"`
 }
 
 func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
@@ -64,6 +67,11 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		checkCredentials = true
 	}
 
+	dryRun := false
+	if _, exists := query[atc.SaveConfigDryRun]; exists {
+		dryRun = true
+	}
+
 	var version db.ConfigVersion
 	if configVersionStr := r.Header.Get(atc.ConfigVersionHeader); len(configVersionStr) != 0 {
 		_, err := fmt.Sscanf(configVersionStr, "%d", &version)
@@ -121,6 +129,11 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
+	if dryRun {
+		s.dryRunConfig(w, teamName, pipelineName, config, warnings, session)
+		return
+	}
+
 	if checkCredentials {
 		variables := s.variablesFactory.NewVariables(teamName, pipelineName)
 
@@ -167,6 +180,66 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	s.writeSaveConfigResponse(w, SaveConfigResponse{Warnings: warnings}, session)
 }
 
+// dryRunConfig reports what saving the config would change, without saving
+// it. Credentials which can't be resolved are reported rather than rejected.
+func (s *Server) dryRunConfig(w http.ResponseWriter, teamName string, pipelineName string, config atc.Config, warnings []atc.Warning, session lager.Logger) {
+	session.Info("dry-run")
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		session.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		session.Debug("team-not-found")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var existingConfig atc.Config
+
+	pipeline, found, err := team.Pipeline(pipelineName)
+	if err != nil {
+		session.Error("failed-to-find-pipeline", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if found {
+		existingConfig, err = pipelineConfig(pipeline)
+		if err != nil {
+			session.Error("failed-to-get-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	changes := atc.DiffConfigs(existingConfig, config)
+
+	variables := s.variablesFactory.NewVariables(teamName, pipelineName)
+
+	errs := validateCredParams(variables, config, session)
+	if errs != nil {
+		if merr, ok := errs.(*multierror.Error); ok {
+			for _, err := range merr.Errors {
+				changes.UnresolvedCredentials = append(changes.UnresolvedCredentials, err.Error())
+			}
+		} else {
+			changes.UnresolvedCredentials = append(changes.UnresolvedCredentials, errs.Error())
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	s.writeSaveConfigResponse(w, SaveConfigResponse{
+		Warnings: warnings,
+		Changes:  &changes,
+	}, session)
+}
+
 // Simply validate that the credentials exist; don't do anything with the actual secrets
 func validateCredParams(credMgrVars creds.Variables, config atc.Config, session lager.Logger) error {
 	var errs error
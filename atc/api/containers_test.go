@@ -981,6 +981,223 @@ var _ = Describe("Containers API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/containers/:id/files", func() {
+		var (
+			fakeContainer *workerfakes.FakeContainer
+			response      *http.Response
+			path          string
+		)
+
+		BeforeEach(func() {
+			path = "/tmp/some-file"
+
+			fakeContainer = new(workerfakes.FakeContainer)
+			fakeWorkerClient.FindContainerByHandleReturns(fakeContainer, true, nil)
+			dbTeam.IsCheckContainerReturns(false, nil)
+			dbTeam.IsContainerWithinTeamReturns(true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/containers/some-handle/files?path=" + url.QueryEscape(path) + "&user=snoopy")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when streaming out succeeds", func() {
+				BeforeEach(func() {
+					fakeContainer.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-tar-stream")), nil)
+				})
+
+				It("returns 200 with the tar stream", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/x-tar"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("some-tar-stream"))
+				})
+
+				It("streams out the requested path as the requested user", func() {
+					_, _, handle := fakeWorkerClient.FindContainerByHandleArgsForCall(0)
+					Expect(handle).To(Equal("some-handle"))
+
+					Expect(fakeContainer.StreamOutArgsForCall(0)).To(Equal(garden.StreamOutSpec{
+						Path: "/tmp/some-file",
+						User: "snoopy",
+					}))
+				})
+			})
+
+			Context("when no path is given", func() {
+				BeforeEach(func() {
+					path = ""
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the container is a check container and the user is not an admin", func() {
+				BeforeEach(func() {
+					dbTeam.IsCheckContainerReturns(true, nil)
+					fakeaccess.IsAdminReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the container is not within the team", func() {
+				BeforeEach(func() {
+					dbTeam.IsContainerWithinTeamReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the container could not be found on the worker client", func() {
+				BeforeEach(func() {
+					fakeWorkerClient.FindContainerByHandleReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when streaming out fails", func() {
+				BeforeEach(func() {
+					fakeContainer.StreamOutReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/containers/:id/files", func() {
+		var (
+			fakeContainer *workerfakes.FakeContainer
+			response      *http.Response
+			path          string
+			streamedIn    string
+		)
+
+		BeforeEach(func() {
+			path = "/tmp/some-dir"
+			streamedIn = ""
+
+			fakeContainer = new(workerfakes.FakeContainer)
+			fakeContainer.StreamInStub = func(spec garden.StreamInSpec) error {
+				payload, err := ioutil.ReadAll(spec.TarStream)
+				streamedIn = string(payload)
+				return err
+			}
+
+			fakeWorkerClient.FindContainerByHandleReturns(fakeContainer, true, nil)
+			dbTeam.IsCheckContainerReturns(false, nil)
+			dbTeam.IsContainerWithinTeamReturns(true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(
+				"PUT",
+				server.URL+"/api/v1/teams/a-team/containers/some-handle/files?path="+url.QueryEscape(path)+"&user=snoopy",
+				bytes.NewBufferString("some-tar-stream"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header.Set("Content-Type", "application/x-tar")
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not stream anything in", func() {
+				Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			It("streams the request body into the requested path as the requested user", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				Expect(fakeContainer.StreamInCallCount()).To(Equal(1))
+				spec := fakeContainer.StreamInArgsForCall(0)
+				Expect(spec.Path).To(Equal("/tmp/some-dir"))
+				Expect(spec.User).To(Equal("snoopy"))
+				Expect(streamedIn).To(Equal("some-tar-stream"))
+			})
+
+			Context("when no path is given", func() {
+				BeforeEach(func() {
+					path = ""
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the container is not within the team", func() {
+				BeforeEach(func() {
+					dbTeam.IsContainerWithinTeamReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when streaming in fails", func() {
+				BeforeEach(func() {
+					fakeContainer.StreamInReturns(errors.New("nope"))
+					fakeContainer.StreamInStub = nil
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/containers/destroying", func() {
 		BeforeEach(func() {
 			var err error
@@ -0,0 +1,89 @@
+package containerserver
+
+import (
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) StreamOutContainerFiles(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handle := r.FormValue(":id")
+		path := r.FormValue("path")
+
+		hLog := s.logger.Session("stream-out-files", lager.Data{
+			"handle": handle,
+			"path":   path,
+		})
+
+		if path == "" {
+			hLog.Info("missing-path")
+			http.Error(w, "missing path", http.StatusBadRequest)
+			return
+		}
+
+		container, found := s.findInterceptableContainer(hLog, w, r, team, handle)
+		if !found {
+			return
+		}
+
+		reader, err := container.StreamOut(garden.StreamOutSpec{
+			Path: path,
+			User: r.FormValue("user"),
+		})
+		if err != nil {
+			hLog.Error("failed-to-stream-out", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		defer db.Close(reader)
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			hLog.Error("failed-to-write-files", err)
+		}
+	})
+}
+
+func (s *Server) StreamInContainerFiles(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handle := r.FormValue(":id")
+		path := r.URL.Query().Get("path")
+
+		hLog := s.logger.Session("stream-in-files", lager.Data{
+			"handle": handle,
+			"path":   path,
+		})
+
+		if path == "" {
+			hLog.Info("missing-path")
+			http.Error(w, "missing path", http.StatusBadRequest)
+			return
+		}
+
+		container, found := s.findInterceptableContainer(hLog, w, r, team, handle)
+		if !found {
+			return
+		}
+
+		err := container.StreamIn(garden.StreamInSpec{
+			Path:      path,
+			User:      r.URL.Query().Get("user"),
+			TarStream: r.Body,
+		})
+		if err != nil {
+			hLog.Error("failed-to-stream-in", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
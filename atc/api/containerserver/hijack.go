@@ -88,45 +88,8 @@ func (s *Server) HijackContainer(team db.Team) http.Handler {
 			"handle": handle,
 		})
 
-		container, found, err := s.workerClient.FindContainerByHandle(hLog, team.ID(), handle)
-		if err != nil {
-			hLog.Error("failed-to-find-container", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
+		container, found := s.findInterceptableContainer(hLog, w, r, team, handle)
 		if !found {
-			hLog.Info("container-not-found")
-			w.WriteHeader(http.StatusNotFound)
-			return
-		}
-
-		isCheckContainer, err := team.IsCheckContainer(handle)
-		if err != nil {
-			hLog.Error("failed-to-find-container", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
-		if isCheckContainer {
-			acc := accessor.GetAccessor(r)
-			if !acc.IsAdmin() {
-				hLog.Error("user-not-authorized-to-hijack-check-container", err)
-				w.WriteHeader(http.StatusForbidden)
-				return
-			}
-		}
-
-		ok, err := team.IsContainerWithinTeam(handle, isCheckContainer)
-		if err != nil {
-			hLog.Error("failed-to-find-container-within-team", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
-		if !ok {
-			hLog.Error("container-not-found-within-team", err)
-			w.WriteHeader(http.StatusNotFound)
 			return
 		}
 
@@ -157,6 +120,54 @@ func (s *Server) HijackContainer(team db.Team) http.Handler {
 	})
 }
 
+// findInterceptableContainer looks up a container that the requester is
+// allowed to reach into, writing an appropriate status if there isn't one.
+func (s *Server) findInterceptableContainer(logger lager.Logger, w http.ResponseWriter, r *http.Request, team db.Team, handle string) (worker.Container, bool) {
+	container, found, err := s.workerClient.FindContainerByHandle(logger, team.ID(), handle)
+	if err != nil {
+		logger.Error("failed-to-find-container", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Info("container-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	isCheckContainer, err := team.IsCheckContainer(handle)
+	if err != nil {
+		logger.Error("failed-to-find-container", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if isCheckContainer {
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAdmin() {
+			logger.Error("user-not-authorized-to-hijack-check-container", err)
+			w.WriteHeader(http.StatusForbidden)
+			return nil, false
+		}
+	}
+
+	ok, err := team.IsContainerWithinTeam(handle, isCheckContainer)
+	if err != nil {
+		logger.Error("failed-to-find-container-within-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !ok {
+		logger.Error("container-not-found-within-team", err)
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	return container, true
+}
+
 type hijackRequest struct {
 	Container worker.Container
 	Process   atc.HijackProcessSpec
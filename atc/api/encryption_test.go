@@ -0,0 +1,132 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encryption API", func() {
+	Describe("GET /api/v1/encryption/rotation", func() {
+		var (
+			fakeaccess *accessorfakes.FakeAccess
+
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			fakeaccess = new(accessorfakes.FakeAccess)
+		})
+
+		JustBeforeEach(func() {
+			fakeAccessor.CreateReturns(fakeaccess)
+
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/encryption/rotation")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated but not admin", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAdminReturns(true)
+			})
+
+			Context("when getting the progress succeeds", func() {
+				BeforeEach(func() {
+					dbEncryptionKeyRotator.ProgressReturns(db.EncryptionKeyRotationProgress{
+						KeyFingerprint: "some-fingerprint",
+						Tables: []db.EncryptionKeyRotationTableProgress{
+							{
+								Table:       "jobs",
+								LastID:      42,
+								RowsRotated: 40,
+								Completed:   true,
+								UpdatedAt:   time.Unix(1000, 0),
+							},
+							{
+								Table:             "teams",
+								RowsRemaining:     3,
+								RowsUndecryptable: 1,
+							},
+						},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("returns the progress of each table", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"key_fingerprint": "some-fingerprint",
+						"completed": false,
+						"tables": [
+							{
+								"table": "jobs",
+								"last_id": 42,
+								"rows_rotated": 40,
+								"rows_remaining": 0,
+								"rows_undecryptable": 0,
+								"completed": true,
+								"updated_at": 1000
+							},
+							{
+								"table": "teams",
+								"last_id": 0,
+								"rows_rotated": 0,
+								"rows_remaining": 3,
+								"rows_undecryptable": 1,
+								"completed": false
+							}
+						]
+					}`))
+				})
+			})
+
+			Context("when getting the progress fails", func() {
+				BeforeEach(func() {
+					dbEncryptionKeyRotator.ProgressReturns(db.EncryptionKeyRotationProgress{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
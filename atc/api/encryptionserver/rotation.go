@@ -0,0 +1,34 @@
+package encryptionserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/present"
+)
+
+// GetKeyRotation reports how far data has been re-encrypted with the current
+// encryption key. If encryption is not configured, it responds with 404.
+func (s *Server) GetKeyRotation(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-key-rotation")
+
+	if s.rotator == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	progress, err := s.rotator.Progress()
+	if err != nil {
+		logger.Error("failed-to-get-progress", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(present.EncryptionKeyRotation(progress))
+	if err != nil {
+		logger.Error("failed-to-encode-progress", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
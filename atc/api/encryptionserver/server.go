@@ -0,0 +1,21 @@
+package encryptionserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger  lager.Logger
+	rotator db.EncryptionKeyRotator
+}
+
+func NewServer(
+	logger lager.Logger,
+	rotator db.EncryptionKeyRotator,
+) *Server {
+	return &Server{
+		logger:  logger,
+		rotator: rotator,
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"net/http"
+	"net/url"
 	"path/filepath"
 
 	"code.cloudfoundry.org/lager"
@@ -13,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/api/cliserver"
 	"github.com/concourse/concourse/atc/api/configserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/api/encryptionserver"
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
@@ -48,6 +50,7 @@ func NewHandler(
 	destroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
+	dbEncryptionKeyRotator db.EncryptionKeyRotator,
 
 	peerURL string,
 	eventHandlerFactory buildserver.EventHandlerFactory,
@@ -77,6 +80,11 @@ func NewHandler(
 		return nil, err
 	}
 
+	parsedPeerURL, err := url.Parse(peerURL)
+	if err != nil {
+		return nil, err
+	}
+
 	pipelineHandlerFactory := pipelineserver.NewScopedHandlerFactory(dbTeamFactory)
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
@@ -87,14 +95,15 @@ func NewHandler(
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL, engine)
 	configServer := configserver.NewServer(logger, dbTeamFactory, variablesFactory)
-	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
-	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory, workerProvider)
+	ccServer := ccserver.NewServer(logger, dbTeamFactory, dbJobFactory, externalURL)
+	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory, workerProvider, parsedPeerURL.Hostname())
 	logLevelServer := loglevelserver.NewServer(logger, sink)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
 	containerServer := containerserver.NewServer(logger, workerClient, variablesFactory, interceptTimeoutFactory, containerRepository, destroyer)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, credsManagers)
+	encryptionServer := encryptionserver.NewServer(logger, dbEncryptionKeyRotator)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -102,16 +111,17 @@ func NewHandler(
 
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
-		atc.ListBuilds:              http.HandlerFunc(buildServer.ListBuilds),
-		atc.CreateBuild:             teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
-		atc.GetBuild:                buildHandlerFactory.HandlerFor(buildServer.GetBuild),
-		atc.BuildResources:          buildHandlerFactory.HandlerFor(buildServer.BuildResources),
-		atc.AbortBuild:              buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
-		atc.GetBuildPlan:            buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
-		atc.GetBuildPreparation:     buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
-		atc.BuildEvents:             buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
-		atc.SendInputToBuildPlan:    buildHandlerFactory.HandlerFor(buildServer.SendInputToBuildPlan),
-		atc.ReadOutputFromBuildPlan: buildHandlerFactory.HandlerFor(buildServer.ReadOutputFromBuildPlan),
+		atc.ListBuilds:                http.HandlerFunc(buildServer.ListBuilds),
+		atc.CreateBuild:               teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
+		atc.GetBuild:                  buildHandlerFactory.HandlerFor(buildServer.GetBuild),
+		atc.BuildResources:            buildHandlerFactory.HandlerFor(buildServer.BuildResources),
+		atc.AbortBuild:                buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
+		atc.GetBuildPlan:              buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
+		atc.GetBuildPreparation:       buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
+		atc.BuildEvents:               buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
+		atc.SendInputToBuildPlan:      buildHandlerFactory.HandlerFor(buildServer.SendInputToBuildPlan),
+		atc.ReadOutputFromBuildPlan:   buildHandlerFactory.HandlerFor(buildServer.ReadOutputFromBuildPlan),
+		atc.ReadArtifactFromBuildStep: buildHandlerFactory.HandlerFor(buildServer.ReadArtifactFromBuildStep),
 
 		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
 		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
@@ -163,17 +173,23 @@ func NewHandler(
 		atc.ListBuildsWithVersionAsOutput: pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsOutput),
 		atc.GetResourceCausality:          pipelineHandlerFactory.HandlerFor(versionServer.GetCausality),
 
-		atc.ListWorkers:     http.HandlerFunc(workerServer.ListWorkers),
-		atc.RegisterWorker:  http.HandlerFunc(workerServer.RegisterWorker),
-		atc.LandWorker:      http.HandlerFunc(workerServer.LandWorker),
-		atc.RetireWorker:    http.HandlerFunc(workerServer.RetireWorker),
-		atc.PruneWorker:     http.HandlerFunc(workerServer.PruneWorker),
-		atc.HeartbeatWorker: http.HandlerFunc(workerServer.HeartbeatWorker),
-		atc.DeleteWorker:    http.HandlerFunc(workerServer.DeleteWorker),
+		atc.ListWorkers:      http.HandlerFunc(workerServer.ListWorkers),
+		atc.RegisterWorker:   http.HandlerFunc(workerServer.RegisterWorker),
+		atc.LandWorker:       http.HandlerFunc(workerServer.LandWorker),
+		atc.ListWorkerBuilds: http.HandlerFunc(workerServer.ListWorkerBuilds),
+		atc.RetireWorker:     http.HandlerFunc(workerServer.RetireWorker),
+		atc.CordonWorker:     http.HandlerFunc(workerServer.CordonWorker),
+		atc.UncordonWorker:   http.HandlerFunc(workerServer.UncordonWorker),
+		atc.PruneWorker:      http.HandlerFunc(workerServer.PruneWorker),
+		atc.HeartbeatWorker:  http.HandlerFunc(workerServer.HeartbeatWorker),
+		atc.ConnectWorker:    http.HandlerFunc(workerServer.ConnectWorker),
+		atc.DeleteWorker:     http.HandlerFunc(workerServer.DeleteWorker),
 
 		atc.SetLogLevel: http.HandlerFunc(logLevelServer.SetMinLevel),
 		atc.GetLogLevel: http.HandlerFunc(logLevelServer.GetMinLevel),
 
+		atc.GetEncryptionKeyRotation: http.HandlerFunc(encryptionServer.GetKeyRotation),
+
 		atc.DownloadCLI:  http.HandlerFunc(cliServer.Download),
 		atc.GetInfo:      http.HandlerFunc(infoServer.Info),
 		atc.GetInfoCreds: http.HandlerFunc(infoServer.Creds),
@@ -181,6 +197,8 @@ func NewHandler(
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
+		atc.StreamOutContainerFiles:  teamHandlerFactory.HandlerFor(containerServer.StreamOutContainerFiles),
+		atc.StreamInContainerFiles:   teamHandlerFactory.HandlerFor(containerServer.StreamInContainerFiles),
 		atc.ListDestroyingContainers: http.HandlerFunc(containerServer.ListDestroyingContainers),
 		atc.ReportWorkerContainers:   http.HandlerFunc(containerServer.ReportWorkerContainers),
 
@@ -949,7 +949,8 @@ var _ = Describe("Jobs API", func() {
 						TransitionBuild: nil,
 					},
 				}
-				fakePipeline.DashboardReturns(dashboardResponse, nil)
+				dbJobFactory.PipelineDashboardReturns(dashboardResponse, nil)
+				fakePipeline.IDReturns(42)
 			})
 
 			Context("when not authorized", func() {
@@ -998,6 +999,11 @@ var _ = Describe("Jobs API", func() {
 					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
 				})
 
+				It("fetches the dashboard of the pipeline through the job factory", func() {
+					Expect(dbJobFactory.PipelineDashboardCallCount()).To(Equal(1))
+					Expect(dbJobFactory.PipelineDashboardArgsForCall(0)).To(Equal(42))
+				})
+
 				It("returns each job's name and any running and finished builds", func() {
 					body, err := ioutil.ReadAll(response.Body)
 					Expect(err).NotTo(HaveOccurred())
@@ -1084,7 +1090,7 @@ var _ = Describe("Jobs API", func() {
 				Context("when there are no jobs in dashboard", func() {
 					BeforeEach(func() {
 						dashboardResponse = db.Dashboard{}
-						fakePipeline.DashboardReturns(dashboardResponse, nil)
+						dbJobFactory.PipelineDashboardReturns(dashboardResponse, nil)
 					})
 					It("should return an empty array", func() {
 						body, err := ioutil.ReadAll(response.Body)
@@ -1101,7 +1107,7 @@ var _ = Describe("Jobs API", func() {
 							Plan:                 atc.PlanSequence{{Get: "input-1"}, {Put: "output-1"}},
 							DisableManualTrigger: true,
 						})
-						fakePipeline.DashboardReturns(dashboardResponse, nil)
+						dbJobFactory.PipelineDashboardReturns(dashboardResponse, nil)
 					})
 
 					It("returns each job's name, manual trigger state and any running and finished builds", func() {
@@ -1192,7 +1198,7 @@ var _ = Describe("Jobs API", func() {
 				Context("when getting the dashboard fails", func() {
 					Context("with an unknown error", func() {
 						BeforeEach(func() {
-							fakePipeline.DashboardReturns(nil, errors.New("oh no!"))
+							dbJobFactory.PipelineDashboardReturns(nil, errors.New("oh no!"))
 						})
 
 						It("returns 500", func() {
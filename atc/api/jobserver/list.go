@@ -15,7 +15,7 @@ func (s *Server) ListJobs(pipeline db.Pipeline) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		jobs := []atc.Job{}
 
-		dashboard, err := pipeline.Dashboard()
+		dashboard, err := s.jobFactory.PipelineDashboard(pipeline.ID())
 
 		if err != nil {
 			logger.Error("failed-to-get-dashboard", err)
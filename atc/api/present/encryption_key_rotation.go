@@ -0,0 +1,33 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func EncryptionKeyRotation(progress db.EncryptionKeyRotationProgress) atc.EncryptionKeyRotation {
+	rotation := atc.EncryptionKeyRotation{
+		KeyFingerprint: progress.KeyFingerprint,
+		Completed:      progress.Completed(),
+		Tables:         []atc.EncryptionKeyRotationTable{},
+	}
+
+	for _, table := range progress.Tables {
+		presented := atc.EncryptionKeyRotationTable{
+			Table:             table.Table,
+			LastID:            table.LastID,
+			RowsRotated:       table.RowsRotated,
+			RowsRemaining:     table.RowsRemaining,
+			RowsUndecryptable: table.RowsUndecryptable,
+			Completed:         table.Completed,
+		}
+
+		if !table.UpdatedAt.IsZero() {
+			presented.UpdatedAt = table.UpdatedAt.Unix()
+		}
+
+		rotation.Tables = append(rotation.Tables, presented)
+	}
+
+	return rotation
+}
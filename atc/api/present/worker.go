@@ -30,6 +30,10 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
 		Tags:             workerInfo.Tags(),
+		Labels:           workerInfo.Labels(),
+		Health:           workerInfo.Health(),
+		CordonedBy:       workerInfo.CordonedBy(),
+		CordonReason:     workerInfo.CordonReason(),
 		Name:             workerInfo.Name(),
 		Team:             workerInfo.TeamName(),
 		State:            string(workerInfo.State()),
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/worker/tunnel"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -229,6 +232,51 @@ var _ = Describe("Workers API", func() {
 				})
 			})
 
+			Context("when authenticated with a team worker token", func() {
+				BeforeEach(func() {
+					fakeaccess.IsSystemReturns(false)
+					fakeaccess.IsWorkerReturns(true)
+					fakeaccess.TeamNamesReturns([]string{"some-team"})
+					fakeaccess.IsAuthorizedStub = func(team string) bool {
+						return team == "some-team"
+					}
+				})
+
+				Context("when the worker belongs to the team", func() {
+					BeforeEach(func() {
+						worker.Team = "some-team"
+					})
+
+					It("saves the worker for the team", func() {
+						Expect(dbTeam.SaveWorkerCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the worker belongs to another team", func() {
+					BeforeEach(func() {
+						worker.Team = "other-team"
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not save the worker", func() {
+						Expect(dbTeam.SaveWorkerCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the worker is global", func() {
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not save the worker", func() {
+						Expect(dbWorkerFactory.SaveWorkerCallCount()).To(BeZero())
+					})
+				})
+			})
+
 			Context("when payload contains team name", func() {
 				BeforeEach(func() {
 					worker.Team = "some-team"
@@ -550,6 +598,38 @@ var _ = Describe("Workers API", func() {
 			})
 		})
 
+		Context("when authenticated with a worker token for another team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(false)
+				fakeaccess.IsWorkerReturns(true)
+				fakeaccess.IsAuthorizedStub = func(team string) bool {
+					return team == "other-team"
+				}
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not land the worker", func() {
+				Expect(fakeWorker.LandCallCount()).To(BeZero())
+			})
+
+			Context("when the worker is global", func() {
+				BeforeEach(func() {
+					fakeWorker.TeamNameReturns("")
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not land the worker", func() {
+					Expect(fakeWorker.LandCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when not authenticated", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(false)
@@ -565,6 +645,302 @@ var _ = Describe("Workers API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/workers/:worker_name/cordon", func() {
+		var (
+			response   *http.Response
+			workerName string
+			body       io.Reader
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/cordon", body)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+
+			body = bytes.NewBufferString(`{"reason":"replacing disks"}`)
+
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.UserNameReturns("some-user")
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when the request is authorized as the worker's owner", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("cordons the worker with the reason and the requesting user", func() {
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+				Expect(fakeWorker.CordonCallCount()).To(Equal(1))
+
+				reason, user := fakeWorker.CordonArgsForCall(0)
+				Expect(reason).To(Equal("replacing disks"))
+				Expect(user).To(Equal("some-user"))
+			})
+
+			Context("when no reason is given", func() {
+				BeforeEach(func() {
+					body = nil
+				})
+
+				It("cordons the worker without a reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					reason, _ := fakeWorker.CordonArgsForCall(0)
+					Expect(reason).To(BeEmpty())
+				})
+			})
+
+			Context("when the request body is malformed", func() {
+				BeforeEach(func() {
+					body = bytes.NewBufferString(`{`)
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not cordon the worker", func() {
+					Expect(fakeWorker.CordonCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the worker is not running", func() {
+				BeforeEach(func() {
+					fakeWorker.CordonReturns(db.ErrCannotCordonWorker)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when cordoning the worker fails", func() {
+				BeforeEach(func() {
+					fakeWorker.CordonReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the request is authorized as the wrong team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/workers/:worker_name/uncordon", func() {
+		var (
+			response   *http.Response
+			workerName string
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/uncordon", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+
+			fakeaccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when the request is authenticated as system", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("uncordons the worker", func() {
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+				Expect(fakeWorker.UncordonCallCount()).To(Equal(1))
+			})
+
+			Context("when uncordoning the worker fails", func() {
+				BeforeEach(func() {
+					fakeWorker.UncordonReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the request is authorized as the wrong team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/workers/:worker_name/builds", func() {
+		var (
+			response   *http.Response
+			workerName string
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/workers/"+workerName+"/builds", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+			fakeWorker.RunningBuildsReturns([]int{1, 2}, nil)
+
+			fakeaccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when the request is authenticated as system", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+			})
+
+			It("returns the IDs of the running builds on the worker", func() {
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON("[1, 2]"))
+			})
+
+			Context("when listing the builds fails", func() {
+				BeforeEach(func() {
+					fakeWorker.RunningBuildsReturns(nil, errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the request is authorized as the worker's owner", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when the request is authorized as the wrong team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/workers/:worker_name/retire", func() {
 		var (
 			response   *http.Response
@@ -651,6 +1027,38 @@ var _ = Describe("Workers API", func() {
 			})
 		})
 
+		Context("when authenticated with a worker token for another team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(false)
+				fakeaccess.IsWorkerReturns(true)
+				fakeaccess.IsAuthorizedStub = func(team string) bool {
+					return team == "other-team"
+				}
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not retire the worker", func() {
+				Expect(fakeWorker.RetireCallCount()).To(BeZero())
+			})
+
+			Context("when the worker is global", func() {
+				BeforeEach(func() {
+					fakeWorker.TeamNameReturns("")
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not retire the worker", func() {
+					Expect(fakeWorker.RetireCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when not authenticated", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(false)
@@ -784,6 +1192,8 @@ var _ = Describe("Workers API", func() {
 				ActiveContainers: 2,
 			}
 			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsSystemReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
 			dbWorkerFactory.HeartbeatWorkerReturns(fakeWorker, nil)
 		})
 
@@ -876,6 +1286,53 @@ var _ = Describe("Workers API", func() {
 			})
 		})
 
+		Context("when authenticated with a worker token for the worker's team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(false)
+				fakeaccess.IsWorkerReturns(true)
+				fakeaccess.IsAuthorizedStub = func(team string) bool {
+					return team == "some-team"
+				}
+			})
+
+			It("heartbeats the worker", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbWorkerFactory.HeartbeatWorkerCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when authenticated with a worker token for another team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(false)
+				fakeaccess.IsWorkerReturns(true)
+				fakeaccess.IsAuthorizedStub = func(team string) bool {
+					return team == "other-team"
+				}
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not heartbeat the worker", func() {
+				Expect(dbWorkerFactory.HeartbeatWorkerCallCount()).To(BeZero())
+			})
+
+			Context("when the worker is global", func() {
+				BeforeEach(func() {
+					fakeWorker.TeamNameReturns("")
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not heartbeat the worker", func() {
+					Expect(dbWorkerFactory.HeartbeatWorkerCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when not authenticated", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(false)
@@ -891,6 +1348,167 @@ var _ = Describe("Workers API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/workers/:worker_name/connect", func() {
+		var (
+			response *http.Response
+			upgrade  string
+		)
+
+		BeforeEach(func() {
+			upgrade = tunnel.Protocol
+
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsSystemReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/workers/some-worker/connect", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("Connection", "Upgrade")
+			req.Header.Set("Upgrade", upgrade)
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns 101", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusSwitchingProtocols))
+		})
+
+		It("returns the forwarded addresses on the peer host", func() {
+			Expect(response.Header.Get(tunnel.GardenAddrHeader)).To(MatchRegexp(`^127\.0\.0\.1:\d+$`))
+			Expect(response.Header.Get(tunnel.BaggageclaimURLHeader)).To(MatchRegexp(`^http://127\.0\.0\.1:\d+$`))
+		})
+
+		Context("when the worker serves the tunnel", func() {
+			var targetListener net.Listener
+
+			BeforeEach(func() {
+				var err error
+				targetListener, err = net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).NotTo(HaveOccurred())
+
+				go func() {
+					conn, err := targetListener.Accept()
+					if err != nil {
+						return
+					}
+
+					conn.Write([]byte("hello from garden"))
+					conn.Close()
+				}()
+			})
+
+			AfterEach(func() {
+				targetListener.Close()
+			})
+
+			It("forwards connections to the advertised address through the tunnel", func() {
+				tunnelConn, ok := response.Body.(io.ReadWriteCloser)
+				Expect(ok).To(BeTrue())
+
+				defer tunnelConn.Close()
+
+				go (&tunnel.Server{
+					Logger: logger,
+					Targets: map[string]tunnel.Target{
+						tunnel.Garden: {Network: "tcp", Addr: targetListener.Addr().String()},
+					},
+				}).Serve(readWriteConn{tunnelConn})
+
+				conn, err := net.Dial("tcp", response.Header.Get(tunnel.GardenAddrHeader))
+				Expect(err).NotTo(HaveOccurred())
+
+				defer conn.Close()
+
+				Expect(ioutil.ReadAll(conn)).To(Equal([]byte("hello from garden")))
+			})
+		})
+
+		Context("when the request is not an upgrade", func() {
+			BeforeEach(func() {
+				upgrade = ""
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the requester is not the system", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated with a team worker token", func() {
+			var fakeWorker *dbfakes.FakeWorker
+
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(false)
+				fakeaccess.IsWorkerReturns(true)
+				fakeaccess.IsAuthorizedStub = func(team string) bool {
+					return team == "some-team"
+				}
+
+				fakeWorker = new(dbfakes.FakeWorker)
+				fakeWorker.TeamNameReturns("some-team")
+				dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+			})
+
+			Context("when the worker belongs to the team", func() {
+				It("returns 101", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusSwitchingProtocols))
+				})
+			})
+
+			Context("when the worker has not registered yet", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 101", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusSwitchingProtocols))
+				})
+			})
+
+			Context("when the worker belongs to another team", func() {
+				BeforeEach(func() {
+					fakeWorker.TeamNameReturns("other-team")
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the worker is global", func() {
+				BeforeEach(func() {
+					fakeWorker.TeamNameReturns("")
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("DELETE /api/v1/workers/:worker_name", func() {
 		var (
 			response   *http.Response
@@ -985,6 +1603,38 @@ var _ = Describe("Workers API", func() {
 			})
 		})
 
+		Context("when authenticated with a worker token for another team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(false)
+				fakeaccess.IsWorkerReturns(true)
+				fakeaccess.IsAuthorizedStub = func(team string) bool {
+					return team == "other-team"
+				}
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not delete the worker", func() {
+				Expect(fakeWorker.DeleteCallCount()).To(BeZero())
+			})
+
+			Context("when the worker is global", func() {
+				BeforeEach(func() {
+					fakeWorker.TeamNameReturns("")
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not delete the worker", func() {
+					Expect(fakeWorker.DeleteCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when not authenticated", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(false)
@@ -1000,3 +1650,13 @@ var _ = Describe("Workers API", func() {
 		})
 	})
 })
+
+type readWriteConn struct {
+	io.ReadWriteCloser
+}
+
+func (readWriteConn) LocalAddr() net.Addr              { return &net.TCPAddr{} }
+func (readWriteConn) RemoteAddr() net.Addr             { return &net.TCPAddr{} }
+func (readWriteConn) SetDeadline(time.Time) error      { return nil }
+func (readWriteConn) SetReadDeadline(time.Time) error  { return nil }
+func (readWriteConn) SetWriteDeadline(time.Time) error { return nil }
@@ -0,0 +1,42 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+)
+
+func (s *Server) ListWorkerBuilds(w http.ResponseWriter, r *http.Request) {
+	workerName := r.FormValue(":worker_name")
+
+	logger := s.logger.Session("list-worker-builds", lager.Data{
+		"worker-name": workerName,
+	})
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	buildIDs, err := worker.RunningBuilds()
+	if err != nil {
+		logger.Error("failed-to-list-running-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(buildIDs)
+	if err != nil {
+		logger.Error("failed-to-encode-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
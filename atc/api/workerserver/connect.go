@@ -0,0 +1,147 @@
+package workerserver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/worker/tunnel"
+)
+
+const tunnelPingInterval = 10 * time.Second
+
+// ConnectWorker upgrades the request to a tunnel through which the worker's
+// Garden and Baggageclaim servers are reached, for workers registering
+// directly with the ATC rather than through the TSA.
+//
+// The tunnel is forwarded from a pair of listeners on this ATC, whose
+// addresses are returned in the upgrade response for the worker to register
+// with.
+func (s *Server) ConnectWorker(w http.ResponseWriter, r *http.Request) {
+	workerName := r.FormValue(":worker_name")
+
+	logger := s.logger.Session("connect-worker", lager.Data{
+		"worker-name": workerName,
+	})
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsSystem() && !acc.IsWorker() {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	// team worker tokens may not take over the tunnel of another team's
+	// worker, nor of a global one
+	if !acc.IsSystem() {
+		worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+		if err != nil {
+			logger.Error("failed-to-get-worker", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if found && (worker.TeamName() == "" || !acc.IsAuthorized(worker.TeamName())) {
+			logger.Info("worker-not-in-token-team", lager.Data{
+				"token-teams": acc.TeamNames(),
+				"worker-team": worker.TeamName(),
+			})
+
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}
+
+	if !strings.EqualFold(r.Header.Get("Upgrade"), tunnel.Protocol) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "expected upgrade to %s", tunnel.Protocol)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		logger.Info("response-not-hijackable")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	gardenListener, err := net.Listen("tcp", "0.0.0.0:0")
+	if err != nil {
+		logger.Error("failed-to-listen-for-garden", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	defer gardenListener.Close()
+
+	baggageclaimListener, err := net.Listen("tcp", "0.0.0.0:0")
+	if err != nil {
+		logger.Error("failed-to-listen-for-baggageclaim", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	defer baggageclaimListener.Close()
+
+	gardenAddr := net.JoinHostPort(s.forwardHost, port(gardenListener))
+	baggageclaimURL := "http://" + net.JoinHostPort(s.forwardHost, port(baggageclaimListener))
+
+	conn, buf, err := hijacker.Hijack()
+	if err != nil {
+		logger.Error("failed-to-hijack", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	defer conn.Close()
+
+	_, err = fmt.Fprintf(buf,
+		"HTTP/1.1 101 Switching Protocols\r\n"+
+			"Upgrade: %s\r\n"+
+			"Connection: Upgrade\r\n"+
+			"%s: %s\r\n"+
+			"%s: %s\r\n"+
+			"\r\n",
+		tunnel.Protocol,
+		tunnel.GardenAddrHeader, gardenAddr,
+		tunnel.BaggageclaimURLHeader, baggageclaimURL,
+	)
+	if err == nil {
+		err = buf.Flush()
+	}
+
+	if err != nil {
+		logger.Error("failed-to-write-upgrade-response", err)
+		return
+	}
+
+	client, err := tunnel.NewClient(logger, tunnel.BufferedConn{
+		Conn:   conn,
+		Reader: buf.Reader,
+	})
+	if err != nil {
+		logger.Error("failed-to-establish-tunnel", err)
+		return
+	}
+
+	defer client.Close()
+
+	logger.Info("connected", lager.Data{
+		"garden-addr":      gardenAddr,
+		"baggageclaim-url": baggageclaimURL,
+	})
+
+	go client.Forward(gardenListener, tunnel.Garden)
+	go client.Forward(baggageclaimListener, tunnel.Baggageclaim)
+
+	err = client.Wait(r.Context(), tunnelPingInterval)
+	logger.Info("disconnected", lager.Data{"reason": err.Error()})
+}
+
+func port(listener net.Listener) string {
+	_, port, _ := net.SplitHostPort(listener.Addr().String())
+	return port
+}
@@ -0,0 +1,92 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) CordonWorker(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("cordoning-worker")
+	workerName := r.FormValue(":worker_name")
+	acc := accessor.GetAccessor(r)
+
+	var request atc.CordonWorkerRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil && err != io.EOF {
+		logger.Error("failed-to-decode-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-finding-worker-to-cordon", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.Cordon(request.Reason, acc.UserName())
+	if err == db.ErrWorkerNotPresent {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err == db.ErrCannotCordonWorker {
+		logger.Error("failed-to-cordon-non-running-worker", err)
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	if err != nil {
+		logger.Error("failed-to-cordon-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) UncordonWorker(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("uncordoning-worker")
+	workerName := r.FormValue(":worker_name")
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-finding-worker-to-uncordon", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.Uncordon()
+	if err == db.ErrWorkerNotPresent {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		logger.Error("failed-to-uncordon-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
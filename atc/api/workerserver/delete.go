@@ -3,6 +3,7 @@ package workerserver
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/api/accessor"
 )
 
@@ -25,13 +26,18 @@ func (s *Server) DeleteWorker(w http.ResponseWriter, r *http.Request) {
 		teamAuthorized = acc.IsAuthorized(teamName)
 	}
 
-	if found && (acc.IsAdmin() || acc.IsSystem() || teamAuthorized) {
-		err := worker.Delete()
-		if err != nil {
-			logger.Error("failed-to-delete-worker", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
+	if !acc.IsAdmin() && !acc.IsSystem() && !teamAuthorized {
+		logger.Info("not-authorized-to-delete-worker", lager.Data{"worker-team": teamName})
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	err = worker.Delete()
+	if err != nil {
+		logger.Error("failed-to-delete-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
 	}
+
 	w.WriteHeader(http.StatusOK)
 }
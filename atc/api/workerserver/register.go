@@ -25,7 +25,7 @@ func (s *Server) RegisterWorker(w http.ResponseWriter, r *http.Request) {
 	var registration atc.Worker
 
 	acc := accessor.GetAccessor(r)
-	if !acc.IsSystem() {
+	if !acc.IsSystem() && !acc.IsWorker() {
 		w.WriteHeader(http.StatusForbidden)
 		return
 	}
@@ -55,6 +55,17 @@ func (s *Server) RegisterWorker(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// team worker tokens may only register that team's workers
+	if !acc.IsSystem() && (registration.Team == "" || !acc.IsAuthorized(registration.Team)) {
+		logger.Info("worker-not-in-token-team", lager.Data{
+			"token-teams": acc.TeamNames(),
+			"worker-team": registration.Team,
+		})
+
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
 	if registration.Name == "" {
 		registration.Name = registration.GardenAddr
 	}
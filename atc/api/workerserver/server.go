@@ -12,6 +12,8 @@ type Server struct {
 	teamFactory     db.TeamFactory
 	dbWorkerFactory db.WorkerFactory
 	workerProvider  worker.WorkerProvider
+
+	forwardHost string
 }
 
 func NewServer(
@@ -19,12 +21,13 @@ func NewServer(
 	teamFactory db.TeamFactory,
 	dbWorkerFactory db.WorkerFactory,
 	workerProvider worker.WorkerProvider,
-
+	forwardHost string,
 ) *Server {
 	return &Server{
 		logger:          logger,
 		teamFactory:     teamFactory,
 		dbWorkerFactory: dbWorkerFactory,
 		workerProvider:  workerProvider,
+		forwardHost:     forwardHost,
 	}
 }
@@ -0,0 +1,103 @@
+package archive
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . Archiver
+
+// An Archiver writes a finished build's complete event stream to a sink, as
+// gzipped newline-delimited JSON, and records the archive's location on the
+// build.
+type Archiver interface {
+	Archive(lager.Logger, db.Build) error
+}
+
+type archiver struct {
+	sink Sink
+}
+
+func NewArchiver(sink Sink) Archiver {
+	return &archiver{
+		sink: sink,
+	}
+}
+
+func (a *archiver) Archive(logger lager.Logger, build db.Build) error {
+	logger = logger.Session("archive", lager.Data{
+		"build": build.ID(),
+	})
+
+	if build.LogArchiveLocation() != "" {
+		return nil
+	}
+
+	events, err := build.Events(0)
+	if err != nil {
+		logger.Error("failed-to-get-build-events", err)
+		return err
+	}
+
+	defer db.Close(events)
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(writeEvents(writer, events))
+	}()
+
+	location, err := a.sink.Write(archiveKey(build), reader)
+
+	// unblock the writer in case the sink returned early
+	_ = reader.Close()
+
+	if err != nil {
+		logger.Error("failed-to-write-archive", err)
+		return err
+	}
+
+	err = build.SetLogArchiveLocation(location)
+	if err != nil {
+		logger.Error("failed-to-set-log-archive-location", err)
+		return err
+	}
+
+	logger.Debug("archived", lager.Data{
+		"location": location,
+	})
+
+	return nil
+}
+
+func writeEvents(w io.Writer, events db.EventSource) error {
+	gz := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gz)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			return err
+		}
+
+		err = encoder.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return gz.Close()
+}
+
+func archiveKey(build db.Build) string {
+	return fmt.Sprintf("builds/%d.ndjson.gz", build.ID())
+}
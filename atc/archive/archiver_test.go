@@ -0,0 +1,221 @@
+package archive_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/archive"
+	"github.com/concourse/concourse/atc/archive/archivefakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archiver", func() {
+	var (
+		fakeSink        *archivefakes.FakeSink
+		fakeBuild       *dbfakes.FakeBuild
+		fakeEventSource *dbfakes.FakeEventSource
+
+		archived []byte
+		events   []event.Envelope
+
+		archiver archive.Archiver
+		err      error
+	)
+
+	BeforeEach(func() {
+		fakeSink = new(archivefakes.FakeSink)
+		fakeSink.WriteStub = func(key string, data io.Reader) (string, error) {
+			var err error
+			archived, err = ioutil.ReadAll(data)
+			if err != nil {
+				return "", err
+			}
+
+			return "file:///archive/" + key, nil
+		}
+
+		fakeSink.OpenStub = func(string) (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(archived)), nil
+		}
+
+		events = []event.Envelope{
+			envelope(event.Log{Payload: "hello"}),
+			envelope(event.Log{Payload: "world"}),
+			envelope(event.Status{Status: atc.StatusSucceeded}),
+		}
+
+		fakeEventSource = new(dbfakes.FakeEventSource)
+		fakeEventSource.NextStub = func() (event.Envelope, error) {
+			call := fakeEventSource.NextCallCount() - 1
+			if call < len(events) {
+				return events[call], nil
+			}
+
+			return event.Envelope{}, db.ErrEndOfBuildEventStream
+		}
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.EventsReturns(fakeEventSource, nil)
+
+		archiver = archive.NewArchiver(fakeSink)
+	})
+
+	JustBeforeEach(func() {
+		err = archiver.Archive(lagertest.NewTestLogger("test"), fakeBuild)
+	})
+
+	It("writes the build's events to the sink", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeBuild.EventsCallCount()).To(Equal(1))
+		Expect(fakeBuild.EventsArgsForCall(0)).To(BeZero())
+
+		Expect(fakeSink.WriteCallCount()).To(Equal(1))
+		key, _ := fakeSink.WriteArgsForCall(0)
+		Expect(key).To(Equal("builds/42.ndjson.gz"))
+
+		Expect(fakeEventSource.CloseCallCount()).To(Equal(1))
+	})
+
+	It("records the archive location on the build", func() {
+		Expect(fakeBuild.SetLogArchiveLocationCallCount()).To(Equal(1))
+		Expect(fakeBuild.SetLogArchiveLocationArgsForCall(0)).To(Equal("file:///archive/builds/42.ndjson.gz"))
+	})
+
+	It("archives events which can be streamed back", func() {
+		source, err := archive.NewEventSource(fakeSink, "file:///archive/builds/42.ndjson.gz", 0)
+		Expect(err).ToNot(HaveOccurred())
+		defer source.Close()
+
+		for _, expected := range events {
+			ev, err := source.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev).To(Equal(expected))
+		}
+
+		_, err = source.Next()
+		Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+	})
+
+	It("archives events which can be streamed back from an event ID", func() {
+		source, err := archive.NewEventSource(fakeSink, "file:///archive/builds/42.ndjson.gz", 2)
+		Expect(err).ToNot(HaveOccurred())
+		defer source.Close()
+
+		ev, err := source.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ev).To(Equal(events[2]))
+
+		_, err = source.Next()
+		Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+	})
+
+	Context("when the build has already been archived", func() {
+		BeforeEach(func() {
+			fakeBuild.LogArchiveLocationReturns("file:///archive/builds/42.ndjson.gz")
+		})
+
+		It("does not archive it again", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeSink.WriteCallCount()).To(BeZero())
+			Expect(fakeBuild.SetLogArchiveLocationCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when reading the events fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeEventSource.NextStub = nil
+			fakeEventSource.NextReturns(event.Envelope{}, disaster)
+		})
+
+		It("returns the error without recording a location", func() {
+			Expect(err).To(Equal(disaster))
+			Expect(fakeBuild.SetLogArchiveLocationCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when writing to the sink fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeSink.WriteStub = nil
+			fakeSink.WriteReturns("", disaster)
+		})
+
+		It("returns the error without recording a location", func() {
+			Expect(err).To(Equal(disaster))
+			Expect(fakeBuild.SetLogArchiveLocationCallCount()).To(BeZero())
+		})
+	})
+})
+
+var _ = Describe("NewBuild", func() {
+	var (
+		fakeSink  *archivefakes.FakeSink
+		fakeBuild *dbfakes.FakeBuild
+
+		build db.Build
+	)
+
+	BeforeEach(func() {
+		fakeSink = new(archivefakes.FakeSink)
+		fakeBuild = new(dbfakes.FakeBuild)
+
+		build = archive.NewBuild(fakeBuild, fakeSink)
+	})
+
+	Context("when the build has not been archived", func() {
+		It("reads events from the database", func() {
+			fakeEventSource := new(dbfakes.FakeEventSource)
+			fakeBuild.EventsReturns(fakeEventSource, nil)
+
+			source, err := build.Events(3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source).To(Equal(fakeEventSource))
+			Expect(fakeBuild.EventsArgsForCall(0)).To(Equal(uint(3)))
+
+			Expect(fakeSink.OpenCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the build has been archived", func() {
+		BeforeEach(func() {
+			fakeBuild.LogArchiveLocationReturns("file:///archive/builds/42.ndjson.gz")
+			fakeSink.OpenReturns(nil, errors.New("nope"))
+		})
+
+		It("reads events from the archive", func() {
+			_, err := build.Events(0)
+			Expect(err).To(MatchError("nope"))
+
+			Expect(fakeSink.OpenCallCount()).To(Equal(1))
+			Expect(fakeSink.OpenArgsForCall(0)).To(Equal("file:///archive/builds/42.ndjson.gz"))
+			Expect(fakeBuild.EventsCallCount()).To(BeZero())
+		})
+	})
+})
+
+func envelope(ev atc.Event) event.Envelope {
+	payload, err := json.Marshal(ev)
+	Expect(err).ToNot(HaveOccurred())
+
+	data := json.RawMessage(payload)
+
+	return event.Envelope{
+		Data:    &data,
+		Event:   ev.EventType(),
+		Version: ev.Version(),
+	}
+}
@@ -0,0 +1,30 @@
+package archive
+
+import (
+	"github.com/concourse/concourse/atc/db"
+)
+
+type archivedBuild struct {
+	db.Build
+
+	sink Sink
+}
+
+// NewBuild wraps a build so that its events are read from the sink once they
+// have been archived, so that they can still be streamed after they have been
+// reaped from the database.
+func NewBuild(build db.Build, sink Sink) db.Build {
+	return &archivedBuild{
+		Build: build,
+		sink:  sink,
+	}
+}
+
+func (build *archivedBuild) Events(from uint) (db.EventSource, error) {
+	location := build.LogArchiveLocation()
+	if location == "" {
+		return build.Build.Events(from)
+	}
+
+	return NewEventSource(build.sink, location, from)
+}
@@ -0,0 +1,70 @@
+package archive
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// Config configures where build logs are archived before they are reaped.
+type Config struct {
+	Directory string `long:"directory" description:"Directory in which to archive build logs before they are reaped."`
+
+	S3 struct {
+		Bucket          string `long:"bucket"        description:"S3 bucket in which to archive build logs before they are reaped."`
+		Prefix          string `long:"prefix"        description:"Prefix of the archived build logs' object keys."`
+		Region          string `long:"region"        description:"AWS region of the bucket."`
+		Endpoint        string `long:"endpoint"      description:"Endpoint of an S3-compatible object store, if not using AWS."`
+		AccessKeyID     string `long:"access-key"    description:"AWS access key ID."`
+		SecretAccessKey string `long:"secret-key"    description:"AWS secret access key."`
+		SessionToken    string `long:"session-token" description:"AWS session token."`
+	} `group:"S3" namespace:"s3"`
+}
+
+func (config Config) IsConfigured() bool {
+	return config.Directory != "" || config.S3.Bucket != ""
+}
+
+func (config Config) Validate() error {
+	if config.Directory != "" && config.S3.Bucket != "" {
+		return errors.New("only one of --build-log-archive-directory and --build-log-archive-s3-bucket may be specified")
+	}
+
+	if config.S3.AccessKeyID != "" && config.S3.SecretAccessKey == "" {
+		return errors.New("--build-log-archive-s3-secret-key must be specified along with --build-log-archive-s3-access-key")
+	}
+
+	return nil
+}
+
+// NewSink constructs the configured sink, or returns nil if archival is not
+// configured.
+func (config Config) NewSink() (Sink, error) {
+	if config.Directory != "" {
+		return NewFileSink(config.Directory), nil
+	}
+
+	if config.S3.Bucket == "" {
+		return nil, nil
+	}
+
+	awsConfig := &aws.Config{Region: &config.S3.Region}
+	if config.S3.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.S3.Endpoint)
+		awsConfig.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	if config.S3.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.S3.AccessKeyID, config.S3.SecretAccessKey, config.S3.SessionToken)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewS3Sink(s3.New(sess), config.S3.Bucket, config.S3.Prefix), nil
+}
@@ -0,0 +1,70 @@
+package archive
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+type eventSource struct {
+	archive io.ReadCloser
+	gz      *gzip.Reader
+	decoder *json.Decoder
+}
+
+// NewEventSource reads the events archived at the given location, starting
+// from the given event ID.
+func NewEventSource(sink Sink, location string, from uint) (db.EventSource, error) {
+	archive, err := sink.Open(location)
+	if err != nil {
+		return nil, err
+	}
+
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		_ = archive.Close()
+		return nil, err
+	}
+
+	source := &eventSource{
+		archive: archive,
+		gz:      gz,
+		decoder: json.NewDecoder(gz),
+	}
+
+	for i := uint(0); i < from; i++ {
+		_, err := source.Next()
+		if err == db.ErrEndOfBuildEventStream {
+			break
+		}
+
+		if err != nil {
+			_ = source.Close()
+			return nil, err
+		}
+	}
+
+	return source, nil
+}
+
+func (source *eventSource) Next() (event.Envelope, error) {
+	var ev event.Envelope
+	err := source.decoder.Decode(&ev)
+	if err != nil {
+		if err == io.EOF {
+			return event.Envelope{}, db.ErrEndOfBuildEventStream
+		}
+
+		return event.Envelope{}, err
+	}
+
+	return ev, nil
+}
+
+func (source *eventSource) Close() error {
+	_ = source.gz.Close()
+	return source.archive.Close()
+}
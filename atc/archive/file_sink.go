@@ -0,0 +1,78 @@
+package archive
+
+import (
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// FileSink stores archived build logs in a directory on the local
+// filesystem. Locations are file:// URLs.
+type FileSink struct {
+	dir string
+}
+
+func NewFileSink(dir string) *FileSink {
+	return &FileSink{
+		dir: dir,
+	}
+}
+
+func (sink *FileSink) Write(key string, data io.Reader) (string, error) {
+	path := filepath.Join(sink.dir, filepath.FromSlash(key))
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return "", err
+	}
+
+	// write to a temporary file first so that an archive is never left
+	// half-written
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".archive-")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = io.Copy(tmp, data)
+	if err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return "", err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", err
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", err
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	location := url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}
+
+	return location.String(), nil
+}
+
+func (sink *FileSink) Open(location string) (io.ReadCloser, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "file" {
+		return nil, UnsupportedLocationError{Location: location}
+	}
+
+	return os.Open(filepath.FromSlash(u.Path))
+}
@@ -0,0 +1,61 @@
+package archive_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/archive"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileSink", func() {
+	var (
+		dir  string
+		sink *archive.FileSink
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "file-sink")
+		Expect(err).ToNot(HaveOccurred())
+
+		sink = archive.NewFileSink(dir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("writes data which can be opened again", func() {
+		location, err := sink.Write("builds/1.ndjson.gz", strings.NewReader("some-data"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(location).To(HavePrefix("file://"))
+
+		Expect(filepath.Join(dir, "builds", "1.ndjson.gz")).To(BeARegularFile())
+
+		reader, err := sink.Open(location)
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+
+		data, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("some-data"))
+	})
+
+	It("does not leave temporary files behind", func() {
+		_, err := sink.Write("builds/1.ndjson.gz", strings.NewReader("some-data"))
+		Expect(err).ToNot(HaveOccurred())
+
+		files, err := ioutil.ReadDir(filepath.Join(dir, "builds"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+	})
+
+	It("fails to open locations which are not files", func() {
+		_, err := sink.Open("s3://some-bucket/builds/1.ndjson.gz")
+		Expect(err).To(Equal(archive.UnsupportedLocationError{Location: "s3://some-bucket/builds/1.ndjson.gz"}))
+	})
+})
@@ -0,0 +1,75 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// S3Sink stores archived build logs in an S3 bucket, under an optional
+// prefix. Locations are s3:// URLs.
+type S3Sink struct {
+	client s3iface.S3API
+	bucket string
+	prefix string
+}
+
+func NewS3Sink(client s3iface.S3API, bucket string, prefix string) *S3Sink {
+	return &S3Sink{
+		client: client,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+func (sink *S3Sink) Write(key string, data io.Reader) (string, error) {
+	// the S3 API needs to be able to seek through the body
+	body, err := ioutil.ReadAll(data)
+	if err != nil {
+		return "", err
+	}
+
+	objectKey := path.Join(sink.prefix, key)
+
+	_, err = sink.client.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(sink.bucket),
+		Key:         aws.String(objectKey),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/gzip"),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	location := url.URL{Scheme: "s3", Host: sink.bucket, Path: "/" + objectKey}
+
+	return location.String(), nil
+}
+
+func (sink *S3Sink) Open(location string) (io.ReadCloser, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "s3" {
+		return nil, UnsupportedLocationError{Location: location}
+	}
+
+	output, err := sink.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(u.Host),
+		Key:    aws.String(strings.TrimPrefix(u.Path, "/")),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Body, nil
+}
@@ -0,0 +1,27 @@
+package archive
+
+import (
+	"fmt"
+	"io"
+)
+
+//go:generate counterfeiter . Sink
+
+// A Sink stores archived build logs.
+type Sink interface {
+	// Write stores the data under the given key, returning the location from
+	// which it can be opened again.
+	Write(key string, data io.Reader) (string, error)
+
+	// Open returns the data stored at a location previously returned by
+	// Write.
+	Open(location string) (io.ReadCloser, error)
+}
+
+type UnsupportedLocationError struct {
+	Location string
+}
+
+func (err UnsupportedLocationError) Error() string {
+	return fmt.Sprintf("unsupported archive location: %s", err.Location)
+}
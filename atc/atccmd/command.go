@@ -23,11 +23,13 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/archive"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/encryption/kms"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
@@ -61,7 +63,10 @@ import (
 	_ "github.com/concourse/concourse/atc/metric/emitter"
 
 	// dynamically registered credential managers
+	_ "github.com/concourse/concourse/atc/creds/azurekeyvault"
 	_ "github.com/concourse/concourse/atc/creds/credhub"
+	_ "github.com/concourse/concourse/atc/creds/file"
+	_ "github.com/concourse/concourse/atc/creds/gcpsecretmanager"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
@@ -91,11 +96,27 @@ type RunCommand struct {
 
 	Postgres flag.PostgresConfig `group:"PostgreSQL Configuration" namespace:"postgres"`
 
+	PostgresReadReplicas struct {
+		DataSources        []string      `long:"data-source"          description:"Connection string of a read replica of the database, e.g. 'host=replica-1 user=concourse dbname=atc sslmode=disable'. Staleness-tolerant API reads are served from the replicas. Can be specified multiple times."`
+		MaxLag             time.Duration `long:"max-lag"              default:"5s"  description:"Maximum time a replica may lag behind the primary before reads go to the primary instead."`
+		LagCheckInterval   time.Duration `long:"lag-check-interval"   default:"5s"  description:"Interval on which to check how far each replica lags behind the primary."`
+		MaxOpenConnections int           `long:"max-open-connections" default:"32"  description:"Maximum number of open connections to each replica."`
+	} `group:"PostgreSQL Read Replicas" namespace:"postgres-read-replica"`
+
 	CredentialManagement creds.CredentialManagementConfig `group:"Credential Management"`
 	CredentialManagers   creds.Managers
 
-	EncryptionKey    flag.Cipher `long:"encryption-key"     description:"A 16 or 32 length key used to encrypt sensitive information before storing it in the database."`
-	OldEncryptionKey flag.Cipher `long:"old-encryption-key" description:"Encryption key previously used for encrypting sensitive information. If provided without a new key, data is encrypted. If provided with a new key, data is re-encrypted."`
+	EncryptionKey    flag.Cipher   `long:"encryption-key"     description:"A 16 or 32 length key used to encrypt sensitive information before storing it in the database."`
+	OldEncryptionKey []flag.Cipher `long:"old-encryption-key" description:"Encryption key previously used for encrypting sensitive information. If provided without a new key, data is decrypted. If provided with a new key, data is re-encrypted in the background. Can be specified multiple times."`
+
+	EncryptionKMS kms.Config `group:"Encryption KMS" namespace:"encryption-kms"`
+
+	BuildLogArchive archive.Config `group:"Build Log Archive" namespace:"build-log-archive"`
+
+	EncryptionKeyRotation struct {
+		Interval  time.Duration `long:"interval"   default:"10s" description:"Interval on which to re-encrypt a batch of data when rotating encryption keys."`
+		BatchSize int           `long:"batch-size" default:"500" description:"Number of rows per table to re-encrypt on each interval when rotating encryption keys."`
+	} `group:"Encryption Key Rotation" namespace:"encryption-key-rotation"`
 
 	DebugBindIP   flag.IP `long:"debug-bind-ip"   default:"127.0.0.1" description:"IP address on which to listen for the pprof debugger endpoints."`
 	DebugBindPort uint16  `long:"debug-bind-port" default:"8079"      description:"Port on which to listen for the pprof debugger endpoints."`
@@ -123,6 +144,11 @@ type RunCommand struct {
 		ResourceTypes   map[string]string `long:"resource"         description:"A resource type to advertise for the worker. Can be specified multiple times." value-name:"TYPE:IMAGE"`
 	} `group:"Static Worker (optional)" namespace:"worker"`
 
+	WorkerRegistration struct {
+		Tokens     []string          `long:"token"      description:"Pre-shared token with which workers may register directly with the ATC, rather than through the TSA. Can be specified multiple times."`
+		TeamTokens map[string]string `long:"team-token" description:"Pre-shared token with which a team's workers may register directly with the ATC. Can be specified multiple times." value-name:"NAME:TOKEN"`
+	} `group:"Direct Worker Registration" namespace:"worker-registration"`
+
 	Metrics struct {
 		HostName            string            `long:"metrics-host-name" description:"Host string to attach to emitted metrics."`
 		Attributes          map[string]string `long:"metrics-attribute" description:"A key-value attribute to attach to emitted metrics. Can be specified multiple times." value-name:"NAME:VALUE"`
@@ -140,6 +166,24 @@ type RunCommand struct {
 
 		OneOffBuildGracePeriod time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
 		MissingGracePeriod     time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
+
+		DryRun bool `long:"dry-run" description:"Log and emit metrics for what would be garbage-collected, without destroying anything."`
+
+		BuildInterval                      time.Duration `long:"build-collector-interval" description:"Interval on which to collect builds. Defaults to the GC interval."`
+		WorkerInterval                     time.Duration `long:"worker-collector-interval" description:"Interval on which to collect workers. Defaults to the GC interval."`
+		ResourceCacheUseInterval           time.Duration `long:"resource-cache-use-collector-interval" description:"Interval on which to collect resource cache uses. Defaults to the GC interval."`
+		ResourceConfigInterval             time.Duration `long:"resource-config-collector-interval" description:"Interval on which to collect resource configs. Defaults to the GC interval."`
+		ResourceCacheInterval              time.Duration `long:"resource-cache-collector-interval" description:"Interval on which to collect resource caches. Defaults to the GC interval."`
+		VolumeInterval                     time.Duration `long:"volume-collector-interval" description:"Interval on which to collect volumes. Defaults to the GC interval."`
+		ContainerInterval                  time.Duration `long:"container-collector-interval" description:"Interval on which to collect containers. Defaults to the GC interval."`
+		ResourceConfigCheckSessionInterval time.Duration `long:"check-session-collector-interval" description:"Interval on which to collect resource config check sessions. Defaults to the GC interval."`
+		BuildLogInterval                   time.Duration `long:"build-log-collector-interval" default:"30s" description:"Interval on which to reap build logs."`
+
+		ContainerBatchSize int `long:"container-batch-size" default:"0" description:"Maximum number of orphaned containers to destroy per run. 0 means unlimited."`
+		VolumeBatchSize    int `long:"volume-batch-size" default:"0" description:"Maximum number of orphaned volumes to destroy per run. 0 means unlimited."`
+		BuildLogBatchSize  int `long:"build-log-batch-size" default:"500" description:"Number of builds whose logs are reaped per job, per run."`
+
+		ResourceCacheRetention map[string]int `long:"resource-cache-retention" value-name:"TEAM:VERSIONS" description:"Keep the caches of a team's resources for their latest N versions, even if they are no longer in use. Can be specified multiple times."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
@@ -149,6 +193,9 @@ type RunCommand struct {
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
 	MaxBuildLogsToRetain     uint64 `long:"max-build-logs-to-retain" description:"Maximum build logs to retain, 0 means not specified. Will override values configured in jobs"`
 
+	DefaultDaysToRetainBuildLogs uint64 `long:"default-days-to-retain-build-logs" description:"Default days to retain build logs, 0 means unlimited"`
+	MaxDaysToRetainBuildLogs     uint64 `long:"max-days-to-retain-build-logs" description:"Maximum days to retain build logs, 0 means not specified. Will override values configured in jobs"`
+
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
 	DefaultMemoryLimit *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
 
@@ -178,6 +225,7 @@ var HelpError = errors.New("must specify one of `--current-db-version`, `--suppo
 type Migration struct {
 	Postgres           flag.PostgresConfig `group:"PostgreSQL Configuration" namespace:"postgres"`
 	EncryptionKey      flag.Cipher         `long:"encryption-key"     description:"A 16 or 32 length key used to encrypt sensitive information before storing it in the database."`
+	EncryptionKMS      kms.Config          `group:"Encryption KMS" namespace:"encryption-kms"`
 	CurrentDBVersion   bool                `long:"current-db-version" description:"Print the current database version and exit"`
 	SupportedDBVersion bool                `long:"supported-db-version" description:"Print the max supported database version and exit"`
 	MigrateDBToVersion int                 `long:"migrate-db-to-version" description:"Migrate to the specified database version and exit"`
@@ -233,14 +281,16 @@ func (cmd *Migration) supportedDBVersion() error {
 func (cmd *Migration) migrateDBToVersion() error {
 	version := cmd.MigrateDBToVersion
 
-	var newKey *encryption.Key
-	if cmd.EncryptionKey.AEAD != nil {
-		newKey = encryption.NewKey(cmd.EncryptionKey.AEAD)
-	}
-
 	var strategy encryption.Strategy
-	if newKey != nil {
-		strategy = newKey
+	if cmd.EncryptionKMS.IsConfigured() {
+		envelope, err := cmd.EncryptionKMS.NewStrategy()
+		if err != nil {
+			return err
+		}
+
+		strategy = envelope
+	} else if cmd.EncryptionKey.AEAD != nil {
+		strategy = encryption.NewKey(cmd.EncryptionKey.AEAD)
 	} else {
 		strategy = encryption.NewNoEncryption()
 	}
@@ -384,12 +434,22 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 
 	lockFactory := lock.NewLockFactory(lockConn, metric.LogLockAcquired, metric.LogLockReleased)
 
-	apiConn, err := cmd.constructDBConn(retryingDriverName, logger, 32, "api", lockFactory)
+	newKey, err := cmd.newKey()
 	if err != nil {
 		return nil, err
 	}
 
-	backendConn, err := cmd.constructDBConn(retryingDriverName, logger, 32, "backend", lockFactory)
+	apiConn, err := cmd.constructDBConn(retryingDriverName, logger, 32, "api", newKey, lockFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	backendConn, err := cmd.constructDBConn(retryingDriverName, logger, 32, "backend", newKey, lockFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	apiReadConn, err := cmd.constructReplicaConn(retryingDriverName, logger, apiConn)
 	if err != nil {
 		return nil, err
 	}
@@ -399,7 +459,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
-	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory)
+	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, apiReadConn, backendConn, storage, lockFactory, cmd.keyRing(newKey))
 	if err != nil {
 		return nil, err
 	}
@@ -426,7 +486,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	}
 
 	onExit := func() {
-		for _, closer := range []Closer{lockConn, apiConn, backendConn, storage} {
+		for _, closer := range []Closer{lockConn, apiReadConn, backendConn, storage} {
 			closer.Close()
 		}
 	}
@@ -438,9 +498,11 @@ func (cmd *RunCommand) constructMembers(
 	logger lager.Logger,
 	reconfigurableSink *lager.ReconfigurableSink,
 	apiConn db.Conn,
+	apiReadConn db.Conn,
 	backendConn db.Conn,
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
+	keyRing *encryption.KeyRing,
 ) ([]grouper.Member, error) {
 	if cmd.TelemetryOptIn {
 		url := fmt.Sprintf("http://telemetry.concourse-ci.org/?version=%s", concourse.Version)
@@ -452,12 +514,12 @@ func (cmd *RunCommand) constructMembers(
 		}()
 	}
 
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, apiReadConn, storage, lockFactory, keyRing)
 	if err != nil {
 		return nil, err
 	}
 
-	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory)
+	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory, keyRing)
 	if err != nil {
 		return nil, err
 	}
@@ -469,8 +531,10 @@ func (cmd *RunCommand) constructAPIMembers(
 	logger lager.Logger,
 	reconfigurableSink *lager.ReconfigurableSink,
 	dbConn db.Conn,
+	dbReadConn db.Conn,
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
+	keyRing *encryption.KeyRing,
 ) ([]grouper.Member, error) {
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
 
@@ -571,26 +635,42 @@ func (cmd *RunCommand) constructAPIMembers(
 	drain := make(chan struct{})
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
-	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
 	dbResourceFactory := db.NewResourceFactory(dbConn, lockFactory)
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
-	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
+
+	// listing jobs and builds tolerates slightly stale data, so it may be
+	// served from a read replica
+	dbReadJobFactory := db.NewJobFactory(dbReadConn, lockFactory)
+	dbReadBuildFactory := db.NewBuildFactory(dbReadConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
+
+	accessFactory := accessor.NewWorkerTokenAccessFactory(
+		accessor.NewAccessFactory(authHandler.PublicKey()),
+		cmd.WorkerRegistration.Tokens,
+		cmd.WorkerRegistration.TeamTokens,
+	)
+
+	var dbEncryptionKeyRotator db.EncryptionKeyRotator
+	if keyRing != nil {
+		dbEncryptionKeyRotator = db.NewEncryptionKeyRotator(dbConn, keyRing, cmd.EncryptionKeyRotation.BatchSize)
+	}
 
 	apiHandler, err := cmd.constructAPIHandler(
 		logger,
 		reconfigurableSink,
 		teamFactory,
 		dbPipelineFactory,
-		dbJobFactory,
+		dbReadJobFactory,
 		dbResourceFactory,
 		dbWorkerFactory,
 		dbVolumeRepository,
 		dbContainerRepository,
 		gcContainerDestroyer,
 		dbBuildFactory,
+		dbReadBuildFactory,
 		dbResourceConfigFactory,
+		dbEncryptionKeyRotator,
 		engine,
 		workerClient,
 		workerProvider,
@@ -683,6 +763,7 @@ func (cmd *RunCommand) constructBackendMembers(
 	logger lager.Logger,
 	dbConn db.Conn,
 	lockFactory lock.LockFactory,
+	keyRing *encryption.KeyRing,
 ) ([]grouper.Member, error) {
 
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
@@ -761,6 +842,17 @@ func (cmd *RunCommand) constructBackendMembers(
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
 	bus := dbConn.Bus()
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
+
+	var buildLogArchiver archive.Archiver
+	if cmd.BuildLogArchive.IsConfigured() {
+		sink, err := cmd.BuildLogArchive.NewSink()
+		if err != nil {
+			return nil, err
+		}
+
+		buildLogArchiver = archive.NewArchiver(sink)
+	}
+
 	members := []grouper.Member{
 		{Name: "drainer", Runner: drainer{
 			logger: logger.Session("drain"),
@@ -796,28 +888,16 @@ func (cmd *RunCommand) constructBackendMembers(
 		}},
 		{Name: "collector", Runner: lockrunner.NewRunner(
 			logger.Session("collector"),
-			gc.NewCollector(
-				gc.NewBuildCollector(dbBuildFactory),
-				gc.NewWorkerCollector(dbWorkerLifecycle),
-				gc.NewResourceCacheUseCollector(dbResourceCacheLifecycle),
-				gc.NewResourceConfigCollector(dbResourceConfigFactory),
-				gc.NewResourceCacheCollector(dbResourceCacheLifecycle),
-				gc.NewVolumeCollector(
-					dbVolumeRepository,
-					cmd.GC.MissingGracePeriod,
-				),
-				gc.NewContainerCollector(
-					dbContainerRepository,
-					gc.NewWorkerJobRunner(
-						logger.Session("container-collector-worker-job-runner"),
-						workerProvider,
-						time.Minute,
-					),
-					cmd.GC.MissingGracePeriod,
-				),
-				gc.NewResourceConfigCheckSessionCollector(
-					resourceConfigCheckSessionLifecycle,
-				),
+			cmd.gcCollector(
+				logger,
+				dbBuildFactory,
+				dbWorkerLifecycle,
+				dbResourceCacheLifecycle,
+				dbResourceConfigFactory,
+				dbVolumeRepository,
+				dbContainerRepository,
+				workerProvider,
+				resourceConfigCheckSessionLifecycle,
 			),
 			"collector",
 			lockFactory,
@@ -829,20 +909,37 @@ func (cmd *RunCommand) constructBackendMembers(
 			logger.Session("build-log-collector"),
 			gc.NewBuildLogCollector(
 				dbPipelineFactory,
-				500,
+				cmd.GC.BuildLogBatchSize,
 				gc.NewBuildLogRetentionCalculator(
 					cmd.DefaultBuildLogsToRetain,
 					cmd.MaxBuildLogsToRetain,
+					cmd.DefaultDaysToRetainBuildLogs,
+					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
+				buildLogArchiver,
+				cmd.GC.DryRun,
 			),
 			"build-reaper",
 			lockFactory,
 			clock.NewClock(),
-			30*time.Second,
+			cmd.GC.BuildLogInterval,
 		)},
 	}
 
+	if keyRing != nil && len(cmd.oldKeys()) > 0 {
+		members = append(members, grouper.Member{
+			Name: "encryption-key-rotator", Runner: lockrunner.NewRunner(
+				logger.Session("encryption-key-rotator"),
+				db.NewEncryptionKeyRotator(dbConn, keyRing, cmd.EncryptionKeyRotation.BatchSize),
+				"encryption-key-rotator",
+				lockFactory,
+				clock.NewClock(),
+				cmd.EncryptionKeyRotation.Interval,
+			)},
+		)
+	}
+
 	//Syslog Drainer Configuration
 	if syslogDrainConfigured {
 		members = append(members, grouper.Member{
@@ -869,6 +966,95 @@ func (cmd *RunCommand) constructBackendMembers(
 	return members, nil
 }
 
+// gcCollector constructs the collectors run on every GC interval, each on its
+// own schedule. In dry-run mode collectors which cannot report what they
+// would destroy are disabled.
+func (cmd *RunCommand) gcCollector(
+	logger lager.Logger,
+	buildFactory db.BuildFactory,
+	workerLifecycle db.WorkerLifecycle,
+	resourceCacheLifecycle db.ResourceCacheLifecycle,
+	resourceConfigFactory db.ResourceConfigFactory,
+	volumeRepository db.VolumeRepository,
+	containerRepository db.ContainerRepository,
+	workerProvider worker.WorkerProvider,
+	checkSessionLifecycle db.ResourceConfigCheckSessionLifecycle,
+) gc.Collector {
+	scheduled := func(name string, collector gc.Collector, interval time.Duration) gc.Collector {
+		return gc.NewScheduledCollector(name, collector, interval, clock.NewClock())
+	}
+
+	dryRunnable := func(name string, collector gc.Collector) gc.Collector {
+		if cmd.GC.DryRun {
+			return gc.NewDisabledCollector(name)
+		}
+
+		return collector
+	}
+
+	return gc.NewCollector(
+		scheduled(
+			"builds",
+			dryRunnable("builds", gc.NewBuildCollector(buildFactory)),
+			cmd.GC.BuildInterval,
+		),
+		scheduled(
+			"workers",
+			dryRunnable("workers", gc.NewWorkerCollector(workerLifecycle)),
+			cmd.GC.WorkerInterval,
+		),
+		scheduled(
+			"resource-cache-uses",
+			dryRunnable("resource-cache-uses", gc.NewResourceCacheUseCollector(resourceCacheLifecycle)),
+			cmd.GC.ResourceCacheUseInterval,
+		),
+		scheduled(
+			"resource-configs",
+			dryRunnable("resource-configs", gc.NewResourceConfigCollector(resourceConfigFactory)),
+			cmd.GC.ResourceConfigInterval,
+		),
+		scheduled(
+			"resource-caches",
+			gc.NewResourceCacheCollector(
+				resourceCacheLifecycle,
+				db.ResourceCacheRetention(cmd.GC.ResourceCacheRetention),
+				cmd.GC.DryRun,
+			),
+			cmd.GC.ResourceCacheInterval,
+		),
+		scheduled(
+			"volumes",
+			gc.NewVolumeCollector(
+				volumeRepository,
+				cmd.GC.MissingGracePeriod,
+				cmd.GC.VolumeBatchSize,
+				cmd.GC.DryRun,
+			),
+			cmd.GC.VolumeInterval,
+		),
+		scheduled(
+			"containers",
+			gc.NewContainerCollector(
+				containerRepository,
+				gc.NewWorkerJobRunner(
+					logger.Session("container-collector-worker-job-runner"),
+					workerProvider,
+					time.Minute,
+				),
+				cmd.GC.MissingGracePeriod,
+				cmd.GC.ContainerBatchSize,
+				cmd.GC.DryRun,
+			),
+			cmd.GC.ContainerInterval,
+		),
+		scheduled(
+			"resource-config-check-sessions",
+			dryRunnable("resource-config-check-sessions", gc.NewResourceConfigCheckSessionCollector(checkSessionLifecycle)),
+			cmd.GC.ResourceConfigCheckSessionInterval,
+		),
+	)
+}
+
 func workerVersion() (version.Version, error) {
 	return version.NewVersionFromString(concourse.WorkerVersion)
 }
@@ -903,23 +1089,58 @@ func (cmd *RunCommand) variablesFactory(logger lager.Logger) (creds.VariablesFac
 
 		break
 	}
-	return creds.NewRetryableVariablesFactory(variablesFactory, cmd.CredentialManagement.RetryConfig), nil
+	variablesFactory = creds.NewRetryableVariablesFactory(variablesFactory, cmd.CredentialManagement.RetryConfig)
+
+	if cmd.CredentialManagement.CacheConfig.Enabled {
+		logger.Info("secret-cache-enabled", lager.Data{
+			"duration":          cmd.CredentialManagement.CacheConfig.Duration.String(),
+			"duration-notfound": cmd.CredentialManagement.CacheConfig.DurationNotFound.String(),
+			"max-size":          cmd.CredentialManagement.CacheConfig.MaxSize,
+		})
+
+		variablesFactory = creds.NewCachedVariablesFactory(
+			variablesFactory,
+			cmd.CredentialManagement.CacheConfig,
+			clock.NewClock(),
+			&metric.CredentialCacheHits,
+			&metric.CredentialCacheMisses,
+		)
+	}
+
+	return variablesFactory, nil
 }
 
-func (cmd *RunCommand) newKey() *encryption.Key {
-	var newKey *encryption.Key
+func (cmd *RunCommand) newKey() (encryption.Cipher, error) {
+	if cmd.EncryptionKMS.IsConfigured() {
+		envelope, err := cmd.EncryptionKMS.NewStrategy()
+		if err != nil {
+			return nil, err
+		}
+		return envelope, nil
+	}
+
 	if cmd.EncryptionKey.AEAD != nil {
-		newKey = encryption.NewKey(cmd.EncryptionKey.AEAD)
+		return encryption.NewKey(cmd.EncryptionKey.AEAD), nil
+	}
+
+	return nil, nil
+}
+
+func (cmd *RunCommand) oldKeys() []*encryption.Key {
+	var oldKeys []*encryption.Key
+	for _, oldKey := range cmd.OldEncryptionKey {
+		if oldKey.AEAD != nil {
+			oldKeys = append(oldKeys, encryption.NewKey(oldKey.AEAD))
+		}
 	}
-	return newKey
+	return oldKeys
 }
 
-func (cmd *RunCommand) oldKey() *encryption.Key {
-	var oldKey *encryption.Key
-	if cmd.OldEncryptionKey.AEAD != nil {
-		oldKey = encryption.NewKey(cmd.OldEncryptionKey.AEAD)
+func (cmd *RunCommand) keyRing(newKey encryption.Cipher) *encryption.KeyRing {
+	if newKey == nil {
+		return nil
 	}
-	return oldKey
+	return encryption.NewKeyRing(newKey, cmd.oldKeys()...)
 }
 
 func webHandler(logger lager.Logger) (http.Handler, error) {
@@ -1089,9 +1310,45 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	if cmd.EncryptionKMS.IsConfigured() {
+		if cmd.EncryptionKey.AEAD != nil {
+			errs = multierror.Append(
+				errs,
+				errors.New("must specify only one of --encryption-key or --encryption-kms-provider"),
+			)
+		}
+
+		err := cmd.EncryptionKMS.Validate()
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	err := cmd.BuildLogArchive.Validate()
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
 	return errs.ErrorOrNil()
 }
 
+// eventHandlerFactory streams build events from the build log archive once
+// they have been archived, if archival is configured.
+func (cmd *RunCommand) eventHandlerFactory() (buildserver.EventHandlerFactory, error) {
+	if !cmd.BuildLogArchive.IsConfigured() {
+		return buildserver.NewEventHandler, nil
+	}
+
+	sink, err := cmd.BuildLogArchive.NewSink()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(logger lager.Logger, build db.Build) http.Handler {
+		return buildserver.NewEventHandler(logger, archive.NewBuild(build, sink))
+	}, nil
+}
+
 func (cmd *RunCommand) nonTLSBindAddr() string {
 	return fmt.Sprintf("%s:%d", cmd.BindIP, cmd.BindPort)
 }
@@ -1118,9 +1375,10 @@ func (cmd *RunCommand) constructDBConn(
 	logger lager.Logger,
 	maxConn int,
 	connectionName string,
+	newKey encryption.Cipher,
 	lockFactory lock.LockFactory,
 ) (db.Conn, error) {
-	dbConn, err := db.Open(logger.Session("db"), driverName, cmd.Postgres.ConnectionString(), cmd.newKey(), cmd.oldKey(), connectionName, lockFactory)
+	dbConn, err := db.Open(logger.Session("db"), driverName, cmd.Postgres.ConnectionString(), newKey, cmd.oldKeys(), connectionName, lockFactory)
 	if err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %s", err)
 	}
@@ -1140,6 +1398,41 @@ func (cmd *RunCommand) constructDBConn(
 	return dbConn, nil
 }
 
+func (cmd *RunCommand) constructReplicaConn(
+	driverName string,
+	logger lager.Logger,
+	primary db.Conn,
+) (db.Conn, error) {
+	if len(cmd.PostgresReadReplicas.DataSources) == 0 {
+		return primary, nil
+	}
+
+	var replicas []*sql.DB
+	for _, dataSource := range cmd.PostgresReadReplicas.DataSources {
+		replica, err := sql.Open(driverName, dataSource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open read replica: %s", err)
+		}
+
+		replica.SetMaxOpenConns(cmd.PostgresReadReplicas.MaxOpenConnections)
+
+		replicas = append(replicas, replica)
+	}
+
+	replicaConn := db.NewReplicaConn(
+		logger.Session("replica-conn"),
+		primary,
+		replicas,
+		cmd.PostgresReadReplicas.MaxLag,
+		cmd.PostgresReadReplicas.LagCheckInterval,
+		clock.NewClock(),
+	)
+
+	metric.DatabaseReplicas = append(metric.DatabaseReplicas, replicaConn)
+
+	return replicaConn, nil
+}
+
 type Closer interface {
 	Close() error
 }
@@ -1274,7 +1567,9 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbContainerRepository db.ContainerRepository,
 	gcContainerDestroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
+	dbReadBuildFactory db.BuildFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	dbEncryptionKeyRotator db.EncryptionKeyRotator,
 	engine engine.Engine,
 	workerClient worker.Client,
 	workerProvider worker.WorkerProvider,
@@ -1286,6 +1581,11 @@ func (cmd *RunCommand) constructAPIHandler(
 	accessFactory accessor.AccessFactory,
 ) (http.Handler, error) {
 
+	eventHandlerFactory, err := cmd.eventHandlerFactory()
+	if err != nil {
+		return nil, err
+	}
+
 	checkPipelineAccessHandlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
 	checkBuildReadAccessHandlerFactory := auth.NewCheckBuildReadAccessHandlerFactory(dbBuildFactory)
 	checkBuildWriteAccessHandlerFactory := auth.NewCheckBuildWriteAccessHandlerFactory(dbBuildFactory)
@@ -1316,11 +1616,12 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbVolumeRepository,
 		dbContainerRepository,
 		gcContainerDestroyer,
-		dbBuildFactory,
+		dbReadBuildFactory,
 		resourceConfigFactory,
+		dbEncryptionKeyRotator,
 
 		cmd.PeerURLOrDefault().String(),
-		buildserver.NewEventHandler,
+		eventHandlerFactory,
 		drain,
 
 		engine,
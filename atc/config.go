@@ -59,6 +59,8 @@ type ResourceConfig struct {
 	CheckTimeout string  `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
 	Tags         Tags    `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
 	Version      Version `yaml:"version,omitempty" json:"version" mapstructure:"version"`
+
+	WorkerSelector WorkerSelector `yaml:"worker_selector,omitempty" json:"worker_selector,omitempty" mapstructure:"worker_selector"`
 }
 
 type ResourceType struct {
@@ -362,6 +364,9 @@ type PlanConfig struct {
 	// used by any step to specify which workers are eligible to run the step
 	Tags Tags `yaml:"tags,omitempty" json:"tags,omitempty" mapstructure:"tags"`
 
+	// used by any step to select eligible workers by their labels
+	WorkerSelector WorkerSelector `yaml:"worker_selector,omitempty" json:"worker_selector,omitempty" mapstructure:"worker_selector"`
+
 	// used by any step to run something when the build is aborted during execution of the step
 	Abort *PlanConfig `yaml:"on_abort,omitempty" json:"on_abort,omitempty" mapstructure:"on_abort"`
 
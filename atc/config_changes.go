@@ -0,0 +1,95 @@
+package atc
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// ConfigChanges summarizes the consequences of replacing a pipeline's config,
+// as opposed to the textual differences between the two configs.
+type ConfigChanges struct {
+	AddedJobs   []string `json:"added_jobs,omitempty"`
+	RemovedJobs []string `json:"removed_jobs,omitempty"`
+
+	AddedResources   []string `json:"added_resources,omitempty"`
+	RemovedResources []string `json:"removed_resources,omitempty"`
+
+	// ResourcesWithChangedSource lose their version history, as the versions
+	// are no longer known to belong to the resource.
+	ResourcesWithChangedSource []string `json:"resources_with_changed_source,omitempty"`
+
+	JobsWithChangedInputs []string `json:"jobs_with_changed_inputs,omitempty"`
+
+	UnresolvedCredentials []string `json:"unresolved_credentials,omitempty"`
+}
+
+// DiffConfigs determines the changes made by replacing oldConfig with
+// newConfig. Credentials are not resolved; UnresolvedCredentials is left for
+// the caller to fill in.
+func DiffConfigs(oldConfig Config, newConfig Config) ConfigChanges {
+	var changes ConfigChanges
+
+	for _, job := range newConfig.Jobs {
+		oldJob, found := oldConfig.Jobs.Lookup(job.Name)
+		if !found {
+			changes.AddedJobs = append(changes.AddedJobs, job.Name)
+			continue
+		}
+
+		if !equivalent(oldJob.Inputs(), job.Inputs()) {
+			changes.JobsWithChangedInputs = append(changes.JobsWithChangedInputs, job.Name)
+		}
+	}
+
+	for _, job := range oldConfig.Jobs {
+		if _, found := newConfig.Jobs.Lookup(job.Name); !found {
+			changes.RemovedJobs = append(changes.RemovedJobs, job.Name)
+		}
+	}
+
+	for _, resource := range newConfig.Resources {
+		oldResource, found := oldConfig.Resources.Lookup(resource.Name)
+		if !found {
+			changes.AddedResources = append(changes.AddedResources, resource.Name)
+			continue
+		}
+
+		if oldResource.Type != resource.Type || !equivalent(oldResource.Source, resource.Source) {
+			changes.ResourcesWithChangedSource = append(changes.ResourcesWithChangedSource, resource.Name)
+		}
+	}
+
+	for _, resource := range oldConfig.Resources {
+		if _, found := newConfig.Resources.Lookup(resource.Name); !found {
+			changes.RemovedResources = append(changes.RemovedResources, resource.Name)
+		}
+	}
+
+	return changes
+}
+
+// Breaking is true if applying the changes loses history, or would leave the
+// pipeline unable to run.
+func (changes ConfigChanges) Breaking() bool {
+	return len(changes.RemovedJobs) > 0 ||
+		len(changes.RemovedResources) > 0 ||
+		len(changes.ResourcesWithChangedSource) > 0 ||
+		len(changes.UnresolvedCredentials) > 0
+}
+
+// equivalent compares values by their JSON encoding, as a config loaded from
+// the database and one freshly decoded from YAML may represent the same
+// numbers and maps with different types.
+func equivalent(a interface{}, b interface{}) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return reflect.DeepEqual(a, b)
+	}
+
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return reflect.DeepEqual(a, b)
+	}
+
+	return string(aJSON) == string(bJSON)
+}
@@ -0,0 +1,90 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConfigChanges", func() {
+	var oldConfig atc.Config
+
+	BeforeEach(func() {
+		oldConfig = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git", Source: atc.Source{"uri": "https://example.com", "depth": 1}},
+				{Name: "removed-resource", Type: "git"},
+			},
+			Jobs: atc.JobConfigs{
+				{
+					Name: "some-job",
+					Plan: atc.PlanSequence{
+						{Get: "some-resource"},
+					},
+				},
+				{
+					Name: "removed-job",
+				},
+			},
+		}
+	})
+
+	Describe("DiffConfigs", func() {
+		It("reports no changes for an equivalent config", func() {
+			newConfig := oldConfig
+			newConfig.Resources = atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git", Source: atc.Source{"uri": "https://example.com", "depth": float64(1)}},
+				{Name: "removed-resource", Type: "git"},
+			}
+
+			Expect(atc.DiffConfigs(oldConfig, newConfig)).To(Equal(atc.ConfigChanges{}))
+		})
+
+		It("reports added and removed jobs and resources, changed sources and changed inputs", func() {
+			newConfig := atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "some-resource", Type: "git", Source: atc.Source{"uri": "https://example.com/other"}},
+					{Name: "added-resource", Type: "git"},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						Plan: atc.PlanSequence{
+							{Get: "some-resource", Trigger: true},
+						},
+					},
+					{
+						Name: "added-job",
+					},
+				},
+			}
+
+			Expect(atc.DiffConfigs(oldConfig, newConfig)).To(Equal(atc.ConfigChanges{
+				AddedJobs:                  []string{"added-job"},
+				RemovedJobs:                []string{"removed-job"},
+				AddedResources:             []string{"added-resource"},
+				RemovedResources:           []string{"removed-resource"},
+				ResourcesWithChangedSource: []string{"some-resource"},
+				JobsWithChangedInputs:      []string{"some-job"},
+			}))
+		})
+	})
+
+	Describe("Breaking", func() {
+		It("is false for additions and changed inputs", func() {
+			Expect(atc.ConfigChanges{
+				AddedJobs:             []string{"some-job"},
+				AddedResources:        []string{"some-resource"},
+				JobsWithChangedInputs: []string{"some-job"},
+			}.Breaking()).To(BeFalse())
+		})
+
+		It("is true for removals, changed sources and unresolved credentials", func() {
+			Expect(atc.ConfigChanges{RemovedJobs: []string{"some-job"}}.Breaking()).To(BeTrue())
+			Expect(atc.ConfigChanges{RemovedResources: []string{"some-resource"}}.Breaking()).To(BeTrue())
+			Expect(atc.ConfigChanges{ResourcesWithChangedSource: []string{"some-resource"}}.Breaking()).To(BeTrue())
+			Expect(atc.ConfigChanges{UnresolvedCredentials: []string{"some-error"}}.Breaking()).To(BeTrue())
+		})
+	})
+})
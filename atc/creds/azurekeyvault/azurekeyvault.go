@@ -0,0 +1,80 @@
+package azurekeyvault
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"code.cloudfoundry.org/lager"
+	varTemplate "github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+//go:generate counterfeiter . SecretGetter
+
+// A SecretGetter reads a secret by name, reporting whether it was found.
+type SecretGetter interface {
+	GetSecret(name string) (string, bool, error)
+}
+
+type AzureKeyVault struct {
+	log             lager.Logger
+	client          SecretGetter
+	TeamName        string
+	PipelineName    string
+	SecretTemplates []*template.Template
+}
+
+func NewAzureKeyVault(log lager.Logger, client SecretGetter, teamName string, pipelineName string, secretTemplates []*template.Template) *AzureKeyVault {
+	return &AzureKeyVault{
+		log:             log,
+		client:          client,
+		TeamName:        teamName,
+		PipelineName:    pipelineName,
+		SecretTemplates: secretTemplates,
+	}
+}
+
+func (a *AzureKeyVault) buildSecretName(nameTemplate *template.Template, secret string) (string, error) {
+	var buf bytes.Buffer
+	err := nameTemplate.Execute(&buf, &Secret{
+		Team:     a.TeamName,
+		Pipeline: a.PipelineName,
+		Secret:   secret,
+	})
+	return buf.String(), err
+}
+
+func (a *AzureKeyVault) Get(varDef varTemplate.VariableDefinition) (interface{}, bool, error) {
+	for _, st := range a.SecretTemplates {
+		// Key Vault secret names cannot contain slashes, so templates which
+		// mention the pipeline are skipped entirely when there isn't one
+		if a.PipelineName == "" && strings.Contains(st.Root.String(), ".Pipeline") {
+			continue
+		}
+
+		secretName, err := a.buildSecretName(st, varDef.Name)
+		if err != nil {
+			a.log.Error("build-secret-name", err, lager.Data{"template": st.Name(), "secret": varDef.Name})
+			return nil, false, err
+		}
+
+		value, found, err := a.client.GetSecret(secretName)
+		if err != nil {
+			a.log.Error("get-secret", err, lager.Data{
+				"template": st.Name(), "secret": varDef.Name, "secretName": secretName,
+			})
+			return nil, false, err
+		}
+
+		if found {
+			return value, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (a *AzureKeyVault) List() ([]varTemplate.VariableDefinition, error) {
+	// not implemented, see vault implementation
+	return []varTemplate.VariableDefinition{}, nil
+}
@@ -0,0 +1,26 @@
+package azurekeyvault
+
+import (
+	"text/template"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type azureKeyVaultFactory struct {
+	log             lager.Logger
+	client          SecretGetter
+	secretTemplates []*template.Template
+}
+
+func NewAzureKeyVaultFactory(log lager.Logger, client SecretGetter, secretTemplates []*template.Template) *azureKeyVaultFactory {
+	return &azureKeyVaultFactory{
+		log:             log,
+		client:          client,
+		secretTemplates: secretTemplates,
+	}
+}
+
+func (factory *azureKeyVaultFactory) NewVariables(teamName string, pipelineName string) creds.Variables {
+	return NewAzureKeyVault(factory.log, factory.client, teamName, pipelineName, factory.secretTemplates)
+}
@@ -0,0 +1,13 @@
+package azurekeyvault_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestAzureKeyVault(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Azure Key Vault Creds Suite")
+}
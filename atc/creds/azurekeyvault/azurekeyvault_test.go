@@ -0,0 +1,86 @@
+package azurekeyvault_test
+
+import (
+	"errors"
+	"text/template"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	varTemplate "github.com/cloudfoundry/bosh-cli/director/template"
+
+	. "github.com/concourse/concourse/atc/creds/azurekeyvault"
+	"github.com/concourse/concourse/atc/creds/azurekeyvault/azurekeyvaultfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AzureKeyVault", func() {
+	var fakeClient *azurekeyvaultfakes.FakeSecretGetter
+	var pipelineName string
+	var keyVault *AzureKeyVault
+
+	BeforeEach(func() {
+		fakeClient = new(azurekeyvaultfakes.FakeSecretGetter)
+		pipelineName = "some-pipeline"
+	})
+
+	JustBeforeEach(func() {
+		t1 := template.Must(template.New("pipeline").Parse(DefaultPipelineSecretTemplate))
+		t2 := template.Must(template.New("team").Parse(DefaultTeamSecretTemplate))
+		keyVault = NewAzureKeyVault(lagertest.NewTestLogger("test"), fakeClient, "some-team", pipelineName, []*template.Template{t1, t2})
+	})
+
+	get := func() (interface{}, bool, error) {
+		return keyVault.Get(varTemplate.VariableDefinition{Name: "some-var"})
+	}
+
+	It("prefers the pipeline-scoped secret", func() {
+		fakeClient.GetSecretReturns("pipeline-value", true, nil)
+
+		value, found, err := get()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("pipeline-value"))
+
+		Expect(fakeClient.GetSecretCallCount()).To(Equal(1))
+		Expect(fakeClient.GetSecretArgsForCall(0)).To(Equal("concourse-some-team-some-pipeline-some-var"))
+	})
+
+	It("falls back to the team-scoped secret", func() {
+		fakeClient.GetSecretReturnsOnCall(1, "team-value", true, nil)
+
+		value, found, err := get()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("team-value"))
+
+		Expect(fakeClient.GetSecretCallCount()).To(Equal(2))
+		Expect(fakeClient.GetSecretArgsForCall(1)).To(Equal("concourse-some-team-some-var"))
+	})
+
+	It("returns not found when neither exist", func() {
+		_, found, err := get()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("returns errors from the client", func() {
+		fakeClient.GetSecretReturns("", false, errors.New("nope"))
+
+		_, _, err := get()
+		Expect(err).To(MatchError("nope"))
+	})
+
+	Context("without a pipeline", func() {
+		BeforeEach(func() {
+			pipelineName = ""
+		})
+
+		It("only looks up the team-scoped secret", func() {
+			_, _, err := get()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.GetSecretCallCount()).To(Equal(1))
+			Expect(fakeClient.GetSecretArgsForCall(0)).To(Equal("concourse-some-team-some-var"))
+		})
+	})
+})
@@ -0,0 +1,89 @@
+package azurekeyvault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const apiVersion = "7.0"
+
+// Client reads secrets from a single Azure Key Vault using its REST API,
+// authenticating as a service principal.
+type Client struct {
+	httpClient *http.Client
+	vaultURL   string
+}
+
+func NewClient(vaultURL string, tokenURL string, clientID string, clientSecret string, resource string) *Client {
+	config := clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+		Scopes:       []string{strings.TrimRight(resource, "/") + "/.default"},
+	}
+
+	return &Client{
+		httpClient: config.Client(context.Background()),
+		vaultURL:   strings.TrimRight(vaultURL, "/"),
+	}
+}
+
+type secretBundle struct {
+	Value string `json:"value"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// GetSecret returns the current version of the named secret. Secrets which
+// do not exist are reported as not found rather than as an error.
+func (client *Client) GetSecret(name string) (string, bool, error) {
+	secretURL := fmt.Sprintf("%s/secrets/%s?api-version=%s", client.vaultURL, url.PathEscape(name), apiVersion)
+
+	response, err := client.httpClient.Get(secretURL)
+	if err != nil {
+		if retrieveErr, ok := err.(*url.Error); ok {
+			if _, ok := retrieveErr.Err.(*oauth2.RetrieveError); ok {
+				return "", false, fmt.Errorf("failed to authenticate: %s", retrieveErr.Err)
+			}
+		}
+
+		return "", false, err
+	}
+
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var bundle secretBundle
+		err = json.NewDecoder(response.Body).Decode(&bundle)
+		if err != nil {
+			return "", false, err
+		}
+
+		return bundle.Value, true, nil
+
+	case http.StatusNotFound:
+		return "", false, nil
+
+	default:
+		var errResponse errorResponse
+		err = json.NewDecoder(response.Body).Decode(&errResponse)
+		if err == nil && errResponse.Error.Code != "" {
+			return "", false, fmt.Errorf("%s: %s", errResponse.Error.Code, errResponse.Error.Message)
+		}
+
+		return "", false, fmt.Errorf("unexpected response: %s", response.Status)
+	}
+}
@@ -0,0 +1,111 @@
+package azurekeyvault_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc/creds/azurekeyvault"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		authServer  *ghttp.Server
+		vaultServer *ghttp.Server
+
+		client *azurekeyvault.Client
+	)
+
+	BeforeEach(func() {
+		authServer = ghttp.NewServer()
+		vaultServer = ghttp.NewServer()
+
+		authServer.RouteToHandler("POST", "/some-tenant/oauth2/v2.0/token", ghttp.CombineHandlers(
+			ghttp.VerifyFormKV("grant_type", "client_credentials"),
+			ghttp.VerifyFormKV("scope", "https://vault.azure.net/.default"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+				"access_token": "some-token",
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			}),
+		))
+
+		client = azurekeyvault.NewClient(
+			vaultServer.URL(),
+			authServer.URL()+"/some-tenant/oauth2/v2.0/token",
+			"some-client",
+			"some-secret",
+			"https://vault.azure.net",
+		)
+	})
+
+	AfterEach(func() {
+		authServer.Close()
+		vaultServer.Close()
+	})
+
+	Context("when the secret exists", func() {
+		BeforeEach(func() {
+			vaultServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/secrets/some-secret", "api-version=7.0"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{
+					"value": "some-value",
+				}),
+			))
+		})
+
+		It("returns its value", func() {
+			value, found, err := client.GetSecret("some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+		})
+	})
+
+	Context("when the secret does not exist", func() {
+		BeforeEach(func() {
+			vaultServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/secrets/some-secret"),
+				ghttp.RespondWithJSONEncoded(http.StatusNotFound, map[string]interface{}{
+					"error": map[string]string{"code": "SecretNotFound", "message": "not found"},
+				}),
+			))
+		})
+
+		It("is not found", func() {
+			_, found, err := client.GetSecret("some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when access is denied", func() {
+		BeforeEach(func() {
+			vaultServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/secrets/some-secret"),
+				ghttp.RespondWithJSONEncoded(http.StatusForbidden, map[string]interface{}{
+					"error": map[string]string{"code": "Forbidden", "message": "access denied"},
+				}),
+			))
+		})
+
+		It("returns the error", func() {
+			_, _, err := client.GetSecret("some-secret")
+			Expect(err).To(MatchError("Forbidden: access denied"))
+		})
+	})
+
+	Context("when authentication fails", func() {
+		BeforeEach(func() {
+			authServer.RouteToHandler("POST", "/some-tenant/oauth2/v2.0/token", ghttp.RespondWith(http.StatusUnauthorized, `{"error":"invalid_client"}`))
+		})
+
+		It("returns an error", func() {
+			_, _, err := client.GetSecret("some-secret")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to authenticate"))
+		})
+	})
+})
@@ -0,0 +1,146 @@
+package azurekeyvault
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+const DefaultPipelineSecretTemplate = "concourse-{{.Team}}-{{.Pipeline}}-{{.Secret}}"
+const DefaultTeamSecretTemplate = "concourse-{{.Team}}-{{.Secret}}"
+
+type AzureKeyVaultManager struct {
+	VaultURL     string `long:"vault-url" description:"URL of the Azure Key Vault, e.g. https://my-vault.vault.azure.net"`
+	TenantID     string `long:"tenant-id" description:"Azure Active Directory tenant ID of the service principal"`
+	ClientID     string `long:"client-id" description:"Client ID of the service principal"`
+	ClientSecret string `long:"client-secret" description:"Client secret of the service principal"`
+
+	AuthorityURL string `long:"authority-url" default:"https://login.microsoftonline.com" description:"Azure Active Directory authority used to obtain access tokens"`
+	Resource     string `long:"resource" default:"https://vault.azure.net" description:"Resource to request access tokens for"`
+
+	PipelineSecretTemplate string `long:"pipeline-secret-template" description:"Azure Key Vault secret name template used for pipeline specific secrets" default:"concourse-{{.Team}}-{{.Pipeline}}-{{.Secret}}"`
+	TeamSecretTemplate     string `long:"team-secret-template" description:"Azure Key Vault secret name template used for team specific secrets" default:"concourse-{{.Team}}-{{.Secret}}"`
+
+	Client *Client
+}
+
+type Secret struct {
+	Team     string
+	Pipeline string
+	Secret   string
+}
+
+func buildSecretTemplate(name, tmpl string) (*template.Template, error) {
+	t, err := template.
+		New(name).
+		Option("missingkey=error").
+		Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	if parse.IsEmptyTree(t.Root) {
+		return nil, errors.New("secret template should not be empty")
+	}
+	return t, nil
+}
+
+func (manager *AzureKeyVaultManager) tokenURL() string {
+	return strings.TrimRight(manager.AuthorityURL, "/") + "/" + manager.TenantID + "/oauth2/v2.0/token"
+}
+
+func (manager *AzureKeyVaultManager) Init(log lager.Logger) error {
+	manager.Client = NewClient(manager.VaultURL, manager.tokenURL(), manager.ClientID, manager.ClientSecret, manager.Resource)
+	return nil
+}
+
+func (manager *AzureKeyVaultManager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "GetSecret",
+	}
+
+	_, _, err := manager.Client.GetSecret("concourse-health-check")
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status": "UP",
+	}
+
+	return health, nil
+}
+
+func (manager *AzureKeyVaultManager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"vault_url":                manager.VaultURL,
+		"tenant_id":                manager.TenantID,
+		"client_id":                manager.ClientID,
+		"pipeline_secret_template": manager.PipelineSecretTemplate,
+		"team_secret_template":     manager.TeamSecretTemplate,
+		"health":                   health,
+	})
+}
+
+func (manager *AzureKeyVaultManager) IsConfigured() bool {
+	return manager.VaultURL != ""
+}
+
+func (manager *AzureKeyVaultManager) Validate() error {
+	// Make sure that the template is valid
+	pipelineSecretTemplate, err := buildSecretTemplate("pipeline-secret-template", manager.PipelineSecretTemplate)
+	if err != nil {
+		return err
+	}
+	teamSecretTemplate, err := buildSecretTemplate("team-secret-template", manager.TeamSecretTemplate)
+	if err != nil {
+		return err
+	}
+	// Execute the templates on dummy data to verify that it does not expect additional data
+	dummy := Secret{Team: "team", Pipeline: "pipeline", Secret: "secret"}
+	if err = pipelineSecretTemplate.Execute(ioutil.Discard, &dummy); err != nil {
+		return err
+	}
+	if err = teamSecretTemplate.Execute(ioutil.Discard, &dummy); err != nil {
+		return err
+	}
+
+	if manager.TenantID == "" {
+		return errors.New("must provide tenant id")
+	}
+
+	if manager.ClientID == "" {
+		return errors.New("must provide client id")
+	}
+
+	if manager.ClientSecret == "" {
+		return errors.New("must provide client secret")
+	}
+
+	return nil
+}
+
+func (manager *AzureKeyVaultManager) NewVariablesFactory(log lager.Logger) (creds.VariablesFactory, error) {
+	pipelineSecretTemplate, err := buildSecretTemplate("pipeline-secret-template", manager.PipelineSecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	teamSecretTemplate, err := buildSecretTemplate("team-secret-template", manager.TeamSecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAzureKeyVaultFactory(log, manager.Client, []*template.Template{pipelineSecretTemplate, teamSecretTemplate}), nil
+}
@@ -0,0 +1,27 @@
+package azurekeyvault
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type azureKeyVaultManagerFactory struct{}
+
+func init() {
+	creds.Register("azurekeyvault", NewAzureKeyVaultManagerFactory())
+}
+
+func NewAzureKeyVaultManagerFactory() creds.ManagerFactory {
+	return &azureKeyVaultManagerFactory{}
+}
+
+func (factory *azureKeyVaultManagerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &AzureKeyVaultManager{}
+	subGroup, err := group.AddGroup("Azure Key Vault Credential Management", "", manager)
+	if err != nil {
+		panic(err)
+	}
+
+	subGroup.Namespace = "azure-keyvault"
+	return manager
+}
@@ -0,0 +1,73 @@
+package azurekeyvault_test
+
+import (
+	"github.com/concourse/concourse/atc/creds/azurekeyvault"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AzureKeyVaultManager", func() {
+	var manager azurekeyvault.AzureKeyVaultManager
+
+	Describe("IsConfigured()", func() {
+		JustBeforeEach(func() {
+			_, err := flags.ParseArgs(&manager, []string{})
+			Expect(err).To(BeNil())
+		})
+
+		It("fails on empty AzureKeyVaultManager", func() {
+			Expect(manager.IsConfigured()).To(BeFalse())
+		})
+
+		It("passes if VaultURL is set", func() {
+			manager.VaultURL = "https://some-vault.vault.azure.net"
+			Expect(manager.IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Describe("Validate()", func() {
+		BeforeEach(func() {
+			manager = azurekeyvault.AzureKeyVaultManager{
+				VaultURL:     "https://some-vault.vault.azure.net",
+				TenantID:     "some-tenant",
+				ClientID:     "some-client",
+				ClientSecret: "some-secret",
+			}
+			_, err := flags.ParseArgs(&manager, []string{})
+			Expect(err).To(BeNil())
+			Expect(manager.PipelineSecretTemplate).To(Equal(azurekeyvault.DefaultPipelineSecretTemplate))
+			Expect(manager.TeamSecretTemplate).To(Equal(azurekeyvault.DefaultTeamSecretTemplate))
+		})
+
+		It("passes on default parameters", func() {
+			Expect(manager.Validate()).To(BeNil())
+		})
+
+		It("fails without a tenant id", func() {
+			manager.TenantID = ""
+			Expect(manager.Validate()).To(MatchError("must provide tenant id"))
+		})
+
+		It("fails without a client id", func() {
+			manager.ClientID = ""
+			Expect(manager.Validate()).To(MatchError("must provide client id"))
+		})
+
+		It("fails without a client secret", func() {
+			manager.ClientSecret = ""
+			Expect(manager.Validate()).To(MatchError("must provide client secret"))
+		})
+
+		It("fails on empty pipeline secret template", func() {
+			manager.PipelineSecretTemplate = ""
+			Expect(manager.Validate()).ToNot(BeNil())
+		})
+
+		It("fails on pipeline secret template with unknown fields", func() {
+			manager.PipelineSecretTemplate = "{{.Team}}-{{.Unknown}}"
+			Expect(manager.Validate()).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,180 @@
+package creds
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+type SecretCacheConfig struct {
+	Enabled          bool          `long:"secret-cache-enabled" description:"Enable in-memory cache for secrets fetched from the credential manager."`
+	Duration         time.Duration `long:"secret-cache-duration" default:"1m" description:"How long secrets are cached for when the cache is enabled."`
+	DurationNotFound time.Duration `long:"secret-cache-duration-notfound" default:"10s" description:"How long the absence of a secret is cached for when the cache is enabled."`
+	MaxSize          int           `long:"secret-cache-max-size" default:"1000" description:"Maximum number of secrets to keep in the cache. The least recently used secrets are evicted first."`
+}
+
+// A Counter is incremented on every cache hit or miss, so that they can be
+// emitted as metrics.
+type Counter interface {
+	Inc()
+}
+
+type cacheKey struct {
+	teamName     string
+	pipelineName string
+	varName      string
+}
+
+type cacheEntry struct {
+	key      cacheKey
+	value    interface{}
+	found    bool
+	deadline time.Time
+}
+
+// CachedVariablesFactory wraps a VariablesFactory, caching the results of
+// every lookup for all teams and pipelines. Secrets which were found are
+// cached for the configured duration, and secrets which were not found are
+// cached for the (typically shorter) not-found duration. Errors are never
+// cached.
+type CachedVariablesFactory struct {
+	factory VariablesFactory
+	config  SecretCacheConfig
+	clock   clock.Clock
+
+	hits   Counter
+	misses Counter
+
+	lock    sync.Mutex
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+}
+
+type CachedVariables struct {
+	variables    Variables
+	factory      *CachedVariablesFactory
+	teamName     string
+	pipelineName string
+}
+
+func NewCachedVariablesFactory(factory VariablesFactory, config SecretCacheConfig, clock clock.Clock, hits Counter, misses Counter) *CachedVariablesFactory {
+	return &CachedVariablesFactory{
+		factory: factory,
+		config:  config,
+		clock:   clock,
+
+		hits:   hits,
+		misses: misses,
+
+		entries: map[cacheKey]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+func (cvf *CachedVariablesFactory) NewVariables(teamName string, pipelineName string) Variables {
+	return CachedVariables{
+		variables:    cvf.factory.NewVariables(teamName, pipelineName),
+		factory:      cvf,
+		teamName:     teamName,
+		pipelineName: pipelineName,
+	}
+}
+
+// Len returns the number of entries currently in the cache, including any
+// which have expired but not yet been evicted.
+func (cvf *CachedVariablesFactory) Len() int {
+	cvf.lock.Lock()
+	defer cvf.lock.Unlock()
+
+	return cvf.lru.Len()
+}
+
+func (cvf *CachedVariablesFactory) get(key cacheKey) (*cacheEntry, bool) {
+	cvf.lock.Lock()
+	defer cvf.lock.Unlock()
+
+	elem, ok := cvf.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if !cvf.clock.Now().Before(entry.deadline) {
+		cvf.remove(elem)
+		return nil, false
+	}
+
+	cvf.lru.MoveToFront(elem)
+
+	return entry, true
+}
+
+func (cvf *CachedVariablesFactory) put(key cacheKey, value interface{}, found bool) {
+	ttl := cvf.config.Duration
+	if !found {
+		ttl = cvf.config.DurationNotFound
+	}
+
+	if ttl <= 0 || cvf.config.MaxSize <= 0 {
+		return
+	}
+
+	cvf.lock.Lock()
+	defer cvf.lock.Unlock()
+
+	entry := &cacheEntry{
+		key:      key,
+		value:    value,
+		found:    found,
+		deadline: cvf.clock.Now().Add(ttl),
+	}
+
+	if elem, ok := cvf.entries[key]; ok {
+		elem.Value = entry
+		cvf.lru.MoveToFront(elem)
+		return
+	}
+
+	for cvf.lru.Len() >= cvf.config.MaxSize {
+		cvf.remove(cvf.lru.Back())
+	}
+
+	cvf.entries[key] = cvf.lru.PushFront(entry)
+}
+
+func (cvf *CachedVariablesFactory) remove(elem *list.Element) {
+	cvf.lru.Remove(elem)
+	delete(cvf.entries, elem.Value.(*cacheEntry).key)
+}
+
+func (cv CachedVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	key := cacheKey{
+		teamName:     cv.teamName,
+		pipelineName: cv.pipelineName,
+		varName:      varDef.Name,
+	}
+
+	entry, ok := cv.factory.get(key)
+	if ok {
+		cv.factory.hits.Inc()
+		return entry.value, entry.found, nil
+	}
+
+	cv.factory.misses.Inc()
+
+	value, found, err := cv.variables.Get(varDef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	cv.factory.put(key, value, found)
+
+	return value, found, nil
+}
+
+func (cv CachedVariables) List() ([]template.VariableDefinition, error) {
+	return cv.variables.List()
+}
@@ -0,0 +1,183 @@
+package creds_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/atc/metric"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CachedVariablesFactory", func() {
+	var (
+		fakeVariablesFactory *credsfakes.FakeVariablesFactory
+		fakeVariables        *credsfakes.FakeVariables
+		fakeClock            *fakeclock.FakeClock
+		config               creds.SecretCacheConfig
+
+		hits   metric.Meter
+		misses metric.Meter
+
+		factory   *creds.CachedVariablesFactory
+		variables creds.Variables
+	)
+
+	BeforeEach(func() {
+		fakeVariables = new(credsfakes.FakeVariables)
+		fakeVariablesFactory = new(credsfakes.FakeVariablesFactory)
+		fakeVariablesFactory.NewVariablesReturns(fakeVariables)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		config = creds.SecretCacheConfig{
+			Enabled:          true,
+			Duration:         time.Minute,
+			DurationNotFound: 10 * time.Second,
+			MaxSize:          2,
+		}
+
+		hits = 0
+		misses = 0
+	})
+
+	JustBeforeEach(func() {
+		factory = creds.NewCachedVariablesFactory(fakeVariablesFactory, config, fakeClock, &hits, &misses)
+		variables = factory.NewVariables("some-team", "some-pipeline")
+	})
+
+	get := func(name string) (interface{}, bool, error) {
+		return variables.Get(template.VariableDefinition{Name: name})
+	}
+
+	Context("when the secret is found", func() {
+		BeforeEach(func() {
+			fakeVariables.GetReturns("some-value", true, nil)
+		})
+
+		It("only fetches it once", func() {
+			value, found, err := get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+
+			value, found, err = get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+
+			Expect(fakeVariables.GetCallCount()).To(Equal(1))
+			Expect(hits.Delta()).To(Equal(1))
+			Expect(misses.Delta()).To(Equal(1))
+		})
+
+		It("caches per team and pipeline", func() {
+			_, _, err := get("foo")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = factory.NewVariables("other-team", "some-pipeline").Get(template.VariableDefinition{Name: "foo"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeVariables.GetCallCount()).To(Equal(2))
+		})
+
+		It("fetches it again once the duration has elapsed", func() {
+			_, _, err := get("foo")
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClock.Increment(59 * time.Second)
+
+			_, _, err = get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeVariables.GetCallCount()).To(Equal(1))
+
+			fakeClock.Increment(time.Second)
+
+			_, _, err = get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeVariables.GetCallCount()).To(Equal(2))
+		})
+
+		It("evicts the least recently used secret when full", func() {
+			_, _, err := get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = get("bar")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = get("baz")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(factory.Len()).To(Equal(2))
+			Expect(fakeVariables.GetCallCount()).To(Equal(3))
+
+			_, _, err = get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeVariables.GetCallCount()).To(Equal(3))
+
+			_, _, err = get("bar")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeVariables.GetCallCount()).To(Equal(4))
+		})
+	})
+
+	Context("when the secret is not found", func() {
+		BeforeEach(func() {
+			fakeVariables.GetReturns(nil, false, nil)
+		})
+
+		It("caches the absence for the not-found duration", func() {
+			_, found, err := get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+			Expect(fakeVariables.GetCallCount()).To(Equal(1))
+
+			fakeClock.Increment(10 * time.Second)
+
+			_, _, err = get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeVariables.GetCallCount()).To(Equal(2))
+		})
+
+		Context("when the not-found duration is zero", func() {
+			BeforeEach(func() {
+				config.DurationNotFound = 0
+			})
+
+			It("does not cache the absence", func() {
+				_, _, err := get("foo")
+				Expect(err).ToNot(HaveOccurred())
+				_, _, err = get("foo")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeVariables.GetCallCount()).To(Equal(2))
+			})
+		})
+	})
+
+	Context("when fetching the secret fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeVariables.GetReturns(nil, false, disaster)
+		})
+
+		It("does not cache the error", func() {
+			_, _, err := get("foo")
+			Expect(err).To(Equal(disaster))
+
+			_, _, err = get("foo")
+			Expect(err).To(Equal(disaster))
+
+			Expect(fakeVariables.GetCallCount()).To(Equal(2))
+			Expect(factory.Len()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package file
+
+import (
+	"path"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// File looks up secrets in a Store using the same paths as the vault
+// credential manager: first PREFIX/TEAM/PIPELINE/KEY, then PREFIX/TEAM/KEY.
+type File struct {
+	Store *Store
+
+	PathPrefix   string
+	TeamName     string
+	PipelineName string
+}
+
+func (f File) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	var secret interface{}
+	var found bool
+
+	if f.PipelineName != "" {
+		secret, found = f.Store.Get(f.path(f.TeamName, f.PipelineName, varDef.Name))
+	}
+
+	if !found {
+		secret, found = f.Store.Get(f.path(f.TeamName, varDef.Name))
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	fields, ok := secret.(map[interface{}]interface{})
+	if !ok {
+		return secret, true, nil
+	}
+
+	val, found := fields["value"]
+	if found {
+		return val, true, nil
+	}
+
+	return fields, true, nil
+}
+
+func (f File) path(segments ...string) string {
+	return path.Join(append([]string{"/", f.PathPrefix}, segments...)...)
+}
+
+func (f File) List() ([]template.VariableDefinition, error) {
+	return []template.VariableDefinition{}, nil
+}
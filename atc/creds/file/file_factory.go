@@ -0,0 +1,26 @@
+package file
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type fileFactory struct {
+	store  *Store
+	prefix string
+}
+
+func NewFileFactory(store *Store, prefix string) *fileFactory {
+	return &fileFactory{
+		store:  store,
+		prefix: prefix,
+	}
+}
+
+func (factory *fileFactory) NewVariables(teamName string, pipelineName string) creds.Variables {
+	return &File{
+		Store:        factory.store,
+		PathPrefix:   factory.prefix,
+		TeamName:     teamName,
+		PipelineName: pipelineName,
+	}
+}
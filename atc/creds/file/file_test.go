@@ -0,0 +1,207 @@
+package file_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds/file"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("File", func() {
+	var (
+		tmpDir string
+		store  *file.Store
+		f      *file.File
+	)
+
+	writeFile := func(name string, contents string) {
+		p := filepath.Join(tmpDir, name)
+		Expect(os.MkdirAll(filepath.Dir(p), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(p, []byte(contents), 0644)).To(Succeed())
+	}
+
+	get := func(name string) (interface{}, bool) {
+		value, found, err := f.Get(template.VariableDefinition{Name: name})
+		Expect(err).ToNot(HaveOccurred())
+		return value, found
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "file-creds")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		f = &file.File{
+			Store:        store,
+			PathPrefix:   "/concourse",
+			TeamName:     "some-team",
+			PipelineName: "some-pipeline",
+		}
+	})
+
+	Context("with a YAML file", func() {
+		BeforeEach(func() {
+			writeFile("secrets.yml", `
+/concourse/some-team/some-pipeline/foo: pipeline-foo
+/concourse/some-team/foo: team-foo
+/concourse/some-team/bar: team-bar
+/concourse/some-team/creds:
+  username: admin
+  password: hunter2
+/concourse/some-team/wrapped:
+  value: unwrapped
+`)
+
+			store = file.NewStore(filepath.Join(tmpDir, "secrets.yml"))
+
+			reloaded, err := store.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reloaded).To(BeTrue())
+		})
+
+		It("prefers the pipeline-scoped secret", func() {
+			value, found := get("foo")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("pipeline-foo"))
+		})
+
+		It("falls back to the team-scoped secret", func() {
+			value, found := get("bar")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("team-bar"))
+		})
+
+		It("returns secrets with multiple fields", func() {
+			value, found := get("creds")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[interface{}]interface{}{
+				"username": "admin",
+				"password": "hunter2",
+			}))
+		})
+
+		It("unwraps secrets with a single 'value' field", func() {
+			value, found := get("wrapped")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("unwrapped"))
+		})
+
+		It("does not find missing secrets", func() {
+			_, found := get("missing")
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not reload if nothing changed", func() {
+			reloaded, err := store.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reloaded).To(BeFalse())
+		})
+
+		Context("when the file changes", func() {
+			BeforeEach(func() {
+				writeFile("secrets.yml", "/concourse/some-team/foo: new-team-foo\n")
+				later := time.Now().Add(time.Minute)
+				Expect(os.Chtimes(filepath.Join(tmpDir, "secrets.yml"), later, later)).To(Succeed())
+
+				reloaded, err := store.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reloaded).To(BeTrue())
+			})
+
+			It("returns the new secrets", func() {
+				value, found := get("foo")
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("new-team-foo"))
+
+				_, found = get("bar")
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the file becomes invalid", func() {
+			BeforeEach(func() {
+				writeFile("secrets.yml", "{")
+				later := time.Now().Add(time.Minute)
+				Expect(os.Chtimes(filepath.Join(tmpDir, "secrets.yml"), later, later)).To(Succeed())
+
+				_, err := store.Reload()
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("keeps the previous secrets", func() {
+				value, found := get("bar")
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("team-bar"))
+			})
+
+			It("reports the error", func() {
+				Expect(store.Err()).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("with a directory tree", func() {
+		BeforeEach(func() {
+			writeFile("concourse/some-team/some-pipeline/foo", "pipeline-foo\n")
+			writeFile("concourse/some-team/bar", "team-bar\n")
+			writeFile("concourse/some-team/creds", "username: admin\npassword: hunter2\n")
+			writeFile("concourse/some-team/.hidden", "hidden\n")
+			writeFile("concourse/.git/some-team/baz", "ignored\n")
+
+			store = file.NewStore(tmpDir)
+
+			_, err := store.Reload()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("loads each file as a secret", func() {
+			value, found := get("foo")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("pipeline-foo"))
+
+			value, found = get("bar")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("team-bar"))
+		})
+
+		It("loads YAML maps as secrets with multiple fields", func() {
+			value, found := get("creds")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[interface{}]interface{}{
+				"username": "admin",
+				"password": "hunter2",
+			}))
+		})
+
+		It("ignores hidden files and directories", func() {
+			Expect(store.Len()).To(Equal(3))
+		})
+
+		Context("when a file is added", func() {
+			BeforeEach(func() {
+				writeFile("concourse/some-team/baz", "team-baz")
+
+				reloaded, err := store.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reloaded).To(BeTrue())
+			})
+
+			It("returns the new secret", func() {
+				value, found := get("baz")
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("team-baz"))
+			})
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package file
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type FileManager struct {
+	Path           string        `long:"path" description:"Path to a YAML file or directory tree of secrets. Intended for local and test setups."`
+	PathPrefix     string        `long:"path-prefix" default:"/concourse" description:"Path under which to namespace credential lookup."`
+	ReloadInterval time.Duration `long:"reload-interval" default:"5s" description:"How often to check the secrets for changes."`
+
+	Store *Store
+}
+
+func (manager *FileManager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"path":            manager.Path,
+		"path_prefix":     manager.PathPrefix,
+		"reload_interval": manager.ReloadInterval.String(),
+		"health":          health,
+	})
+}
+
+func (manager *FileManager) Init(log lager.Logger) error {
+	manager.Store = NewStore(manager.Path)
+
+	_, err := manager.Store.Reload()
+	if err != nil {
+		log.Error("failed-to-load-secrets", err)
+		return err
+	}
+
+	return nil
+}
+
+func (manager *FileManager) IsConfigured() bool {
+	return manager.Path != ""
+}
+
+func (manager *FileManager) Validate() error {
+	_, err := os.Stat(manager.Path)
+	if err != nil {
+		return err
+	}
+
+	if manager.ReloadInterval <= 0 {
+		return errors.New("reload interval must be greater than zero")
+	}
+
+	return nil
+}
+
+func (manager *FileManager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "Load",
+	}
+
+	if manager.Store == nil {
+		return health, nil
+	}
+
+	err := manager.Store.Err()
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]interface{}{
+		"status":  "UP",
+		"secrets": manager.Store.Len(),
+	}
+
+	return health, nil
+}
+
+func (manager *FileManager) NewVariablesFactory(log lager.Logger) (creds.VariablesFactory, error) {
+	go manager.Store.Watch(log.Session("watch"), manager.ReloadInterval)
+
+	return NewFileFactory(manager.Store, manager.PathPrefix), nil
+}
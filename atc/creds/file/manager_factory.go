@@ -0,0 +1,29 @@
+package file
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type fileManagerFactory struct{}
+
+func init() {
+	creds.Register("file", NewFileManagerFactory())
+}
+
+func NewFileManagerFactory() creds.ManagerFactory {
+	return &fileManagerFactory{}
+}
+
+func (factory *fileManagerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &FileManager{}
+
+	subGroup, err := group.AddGroup("File Credential Management", "", manager)
+	if err != nil {
+		panic(err)
+	}
+
+	subGroup.Namespace = "file-creds"
+
+	return manager
+}
@@ -0,0 +1,241 @@
+package file
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// A Store holds the secrets loaded from either a single YAML file or a
+// directory tree.
+//
+// A YAML file maps each secret's full path to its value:
+//
+//	/concourse/main/some-pipeline/foo: bar
+//	/concourse/main/some-creds:
+//	  username: admin
+//	  password: hunter2
+//
+// In a directory tree each file is a secret, named by its path relative to
+// the root. If a file's contents are a YAML map it is treated as a secret
+// with multiple fields; otherwise its contents are used as-is, without the
+// trailing newline. Files and directories starting with '.' are ignored.
+type Store struct {
+	path string
+
+	lock        sync.RWMutex
+	secrets     map[string]interface{}
+	fingerprint string
+	err         error
+}
+
+func NewStore(path string) *Store {
+	return &Store{
+		path:    path,
+		secrets: map[string]interface{}{},
+	}
+}
+
+// Get returns the secret stored at the given path.
+func (store *Store) Get(secretPath string) (interface{}, bool) {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	secret, found := store.secrets[path.Clean(secretPath)]
+	return secret, found
+}
+
+// Len returns the number of secrets currently loaded.
+func (store *Store) Len() int {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	return len(store.secrets)
+}
+
+// Err returns the error from the most recent reload, if it failed.
+func (store *Store) Err() error {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	return store.err
+}
+
+// Reload loads the secrets again if the file or any file in the directory
+// tree has changed since they were last loaded. If loading fails the
+// previously loaded secrets are kept.
+func (store *Store) Reload() (bool, error) {
+	fingerprint, err := store.currentFingerprint()
+	if err == nil && fingerprint == store.loadedFingerprint() {
+		return false, nil
+	}
+
+	var secrets map[string]interface{}
+	if err == nil {
+		secrets, err = store.load()
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	store.err = err
+	if err != nil {
+		return false, err
+	}
+
+	store.secrets = secrets
+	store.fingerprint = fingerprint
+
+	return true, nil
+}
+
+// Watch reloads the secrets on every interval, forever.
+func (store *Store) Watch(logger lager.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		reloaded, err := store.Reload()
+		if err != nil {
+			logger.Error("failed-to-reload-secrets", err)
+			continue
+		}
+
+		if reloaded {
+			logger.Info("reloaded-secrets", lager.Data{"secrets": store.Len()})
+		}
+	}
+}
+
+func (store *Store) loadedFingerprint() string {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	return store.fingerprint
+}
+
+func (store *Store) currentFingerprint() (string, error) {
+	hash := sha256.New()
+
+	err := store.walk(func(name string, info os.FileInfo) error {
+		_, err := fmt.Fprintf(hash, "%s:%d:%d\n", name, info.Size(), info.ModTime().UnixNano())
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+func (store *Store) load() (map[string]interface{}, error) {
+	info, err := os.Stat(store.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return store.loadFile()
+	}
+
+	secrets := map[string]interface{}{}
+
+	err = store.walk(func(name string, info os.FileInfo) error {
+		contents, err := ioutil.ReadFile(filepath.Join(store.path, name))
+		if err != nil {
+			return err
+		}
+
+		var fields map[interface{}]interface{}
+		if yaml.Unmarshal(contents, &fields) == nil && len(fields) > 0 {
+			secrets[secretPath(name)] = fields
+		} else {
+			secrets[secretPath(name)] = strings.TrimSuffix(string(contents), "\n")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+func (store *Store) loadFile() (map[string]interface{}, error) {
+	contents, err := ioutil.ReadFile(store.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	err = yaml.Unmarshal(contents, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", store.path, err)
+	}
+
+	secrets := map[string]interface{}{}
+	for name, secret := range raw {
+		secrets[secretPath(name)] = secret
+	}
+
+	return secrets, nil
+}
+
+// walk calls walkFn with the path relative to the root of every regular file
+// to be loaded. If the store's path is a file, it is the only file walked.
+func (store *Store) walk(walkFn func(string, os.FileInfo) error) error {
+	info, err := os.Stat(store.path)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return walkFn("", info)
+	}
+
+	return filepath.Walk(store.path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p != store.path && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			info, err = os.Stat(p)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(store.path, p)
+		if err != nil {
+			return err
+		}
+
+		return walkFn(filepath.ToSlash(rel), info)
+	})
+}
+
+func secretPath(name string) string {
+	return path.Clean("/" + name)
+}
@@ -0,0 +1,194 @@
+package gcpsecretmanager
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+const defaultMetadataURL = "http://metadata.google.internal"
+
+// Client reads secrets from GCP Secret Manager using its REST API.
+type Client struct {
+	httpClient *http.Client
+	endpoint   string
+	project    string
+}
+
+// NewClient authenticates using the given service account key, or using the
+// default service account from the instance metadata server if the key is
+// empty.
+func NewClient(endpoint string, project string, serviceAccountKey []byte, metadataURL string) (*Client, error) {
+	var tokenSource oauth2.TokenSource
+	if len(serviceAccountKey) > 0 {
+		config, err := jwtConfigFromJSON(serviceAccountKey)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenSource = config.TokenSource(context.Background())
+	} else {
+		tokenSource = oauth2.ReuseTokenSource(nil, metadataTokenSource{
+			metadataURL: strings.TrimRight(metadataURL, "/"),
+		})
+	}
+
+	return &Client{
+		httpClient: oauth2.NewClient(context.Background(), tokenSource),
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		project:    project,
+	}, nil
+}
+
+type accessSecretVersionResponse struct {
+	Payload struct {
+		Data string `json:"data"`
+	} `json:"payload"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// GetSecret returns the latest version of the named secret. Secrets which
+// do not exist are reported as not found rather than as an error.
+func (client *Client) GetSecret(name string) (string, bool, error) {
+	secretURL := fmt.Sprintf(
+		"%s/v1/projects/%s/secrets/%s/versions/latest:access",
+		client.endpoint,
+		url.PathEscape(client.project),
+		url.PathEscape(name),
+	)
+
+	response, err := client.httpClient.Get(secretURL)
+	if err != nil {
+		return "", false, err
+	}
+
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var access accessSecretVersionResponse
+		err = json.NewDecoder(response.Body).Decode(&access)
+		if err != nil {
+			return "", false, err
+		}
+
+		data, err := base64.StdEncoding.DecodeString(access.Payload.Data)
+		if err != nil {
+			return "", false, err
+		}
+
+		return string(data), true, nil
+
+	case http.StatusNotFound:
+		return "", false, nil
+
+	default:
+		var errResponse errorResponse
+		err = json.NewDecoder(response.Body).Decode(&errResponse)
+		if err == nil && errResponse.Error.Status != "" {
+			return "", false, fmt.Errorf("%s: %s", errResponse.Error.Status, errResponse.Error.Message)
+		}
+
+		return "", false, fmt.Errorf("unexpected response: %s", response.Status)
+	}
+}
+
+type serviceAccountKey struct {
+	Type         string `json:"type"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyID string `json:"private_key_id"`
+	TokenURI     string `json:"token_uri"`
+}
+
+func jwtConfigFromJSON(keyJSON []byte) (*jwt.Config, error) {
+	var key serviceAccountKey
+	err := json.Unmarshal(keyJSON, &key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service account key: %s", err)
+	}
+
+	if key.Type != "service_account" {
+		return nil, fmt.Errorf("unsupported credentials type '%s', expected 'service_account'", key.Type)
+	}
+
+	tokenURI := key.TokenURI
+	if tokenURI == "" {
+		tokenURI = "https://oauth2.googleapis.com/token"
+	}
+
+	return &jwt.Config{
+		Email:        key.ClientEmail,
+		PrivateKey:   []byte(key.PrivateKey),
+		PrivateKeyID: key.PrivateKeyID,
+		Scopes:       []string{cloudPlatformScope},
+		TokenURL:     tokenURI,
+	}, nil
+}
+
+// metadataTokenSource fetches access tokens for the instance's default
+// service account from the GCE metadata server.
+type metadataTokenSource struct {
+	metadataURL string
+}
+
+type metadataToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+func (source metadataTokenSource) Token() (*oauth2.Token, error) {
+	request, err := http.NewRequest("GET", source.metadataURL+"/computeMetadata/v1/instance/service-accounts/default/token", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Metadata-Flavor", "Google")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(response.Body)
+		return nil, fmt.Errorf("failed to fetch token from metadata server: %s: %s", response.Status, body)
+	}
+
+	var token metadataToken
+	err = json.NewDecoder(response.Body).Decode(&token)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, errors.New("metadata server returned an empty access token")
+	}
+
+	return &oauth2.Token{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Expiry:      time.Now().Add(time.Duration(token.ExpiresIn) * time.Second),
+	}, nil
+}
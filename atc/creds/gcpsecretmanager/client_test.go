@@ -0,0 +1,117 @@
+package gcpsecretmanager_test
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/creds/gcpsecretmanager"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		metadataServer *ghttp.Server
+		apiServer      *ghttp.Server
+
+		client *gcpsecretmanager.Client
+	)
+
+	BeforeEach(func() {
+		metadataServer = ghttp.NewServer()
+		apiServer = ghttp.NewServer()
+
+		metadataServer.RouteToHandler("GET", "/computeMetadata/v1/instance/service-accounts/default/token", ghttp.CombineHandlers(
+			ghttp.VerifyHeaderKV("Metadata-Flavor", "Google"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+				"access_token": "some-token",
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			}),
+		))
+
+		var err error
+		client, err = gcpsecretmanager.NewClient(apiServer.URL(), "some-project", nil, metadataServer.URL())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		metadataServer.Close()
+		apiServer.Close()
+	})
+
+	Context("when the secret exists", func() {
+		BeforeEach(func() {
+			apiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v1/projects/some-project/secrets/some-secret/versions/latest:access"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+					"name": "projects/some-project/secrets/some-secret/versions/1",
+					"payload": map[string]string{
+						"data": base64.StdEncoding.EncodeToString([]byte("some-value")),
+					},
+				}),
+			))
+		})
+
+		It("returns the decoded payload", func() {
+			value, found, err := client.GetSecret("some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+		})
+	})
+
+	Context("when the secret does not exist", func() {
+		BeforeEach(func() {
+			apiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v1/projects/some-project/secrets/some-secret/versions/latest:access"),
+				ghttp.RespondWithJSONEncoded(http.StatusNotFound, map[string]interface{}{
+					"error": map[string]interface{}{"code": 404, "status": "NOT_FOUND", "message": "not found"},
+				}),
+			))
+		})
+
+		It("is not found", func() {
+			_, found, err := client.GetSecret("some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when permission is denied", func() {
+		BeforeEach(func() {
+			apiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v1/projects/some-project/secrets/some-secret/versions/latest:access"),
+				ghttp.RespondWithJSONEncoded(http.StatusForbidden, map[string]interface{}{
+					"error": map[string]interface{}{"code": 403, "status": "PERMISSION_DENIED", "message": "denied"},
+				}),
+			))
+		})
+
+		It("returns the error", func() {
+			_, _, err := client.GetSecret("some-secret")
+			Expect(err).To(MatchError("PERMISSION_DENIED: denied"))
+		})
+	})
+
+	Context("when the metadata server cannot provide a token", func() {
+		BeforeEach(func() {
+			metadataServer.RouteToHandler("GET", "/computeMetadata/v1/instance/service-accounts/default/token", ghttp.RespondWith(http.StatusNotFound, "no service account"))
+		})
+
+		It("returns an error", func() {
+			_, _, err := client.GetSecret("some-secret")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no service account"))
+		})
+	})
+
+	Context("with an invalid service account key", func() {
+		It("fails to create the client", func() {
+			_, err := gcpsecretmanager.NewClient(apiServer.URL(), "some-project", []byte(`{"type":"authorized_user"}`), "")
+			Expect(err).To(MatchError("unsupported credentials type 'authorized_user', expected 'service_account'"))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package gcpsecretmanager
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"code.cloudfoundry.org/lager"
+	varTemplate "github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+//go:generate counterfeiter . SecretGetter
+
+// A SecretGetter reads a secret by name, reporting whether it was found.
+type SecretGetter interface {
+	GetSecret(name string) (string, bool, error)
+}
+
+type SecretManager struct {
+	log             lager.Logger
+	client          SecretGetter
+	TeamName        string
+	PipelineName    string
+	SecretTemplates []*template.Template
+}
+
+func NewSecretManager(log lager.Logger, client SecretGetter, teamName string, pipelineName string, secretTemplates []*template.Template) *SecretManager {
+	return &SecretManager{
+		log:             log,
+		client:          client,
+		TeamName:        teamName,
+		PipelineName:    pipelineName,
+		SecretTemplates: secretTemplates,
+	}
+}
+
+func (s *SecretManager) buildSecretID(nameTemplate *template.Template, secret string) (string, error) {
+	var buf bytes.Buffer
+	err := nameTemplate.Execute(&buf, &Secret{
+		Team:     s.TeamName,
+		Pipeline: s.PipelineName,
+		Secret:   secret,
+	})
+	return buf.String(), err
+}
+
+func (s *SecretManager) Get(varDef varTemplate.VariableDefinition) (interface{}, bool, error) {
+	for _, st := range s.SecretTemplates {
+		// Secret Manager secret IDs cannot contain slashes, so templates which
+		// mention the pipeline are skipped entirely when there isn't one
+		if s.PipelineName == "" && strings.Contains(st.Root.String(), ".Pipeline") {
+			continue
+		}
+
+		secretID, err := s.buildSecretID(st, varDef.Name)
+		if err != nil {
+			s.log.Error("build-secret-id", err, lager.Data{"template": st.Name(), "secret": varDef.Name})
+			return nil, false, err
+		}
+
+		value, found, err := s.client.GetSecret(secretID)
+		if err != nil {
+			s.log.Error("get-secret", err, lager.Data{
+				"template": st.Name(), "secret": varDef.Name, "secretId": secretID,
+			})
+			return nil, false, err
+		}
+
+		if found {
+			return value, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (s *SecretManager) List() ([]varTemplate.VariableDefinition, error) {
+	// not implemented, see vault implementation
+	return []varTemplate.VariableDefinition{}, nil
+}
@@ -0,0 +1,26 @@
+package gcpsecretmanager
+
+import (
+	"text/template"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type secretManagerFactory struct {
+	log             lager.Logger
+	client          SecretGetter
+	secretTemplates []*template.Template
+}
+
+func NewSecretManagerFactory(log lager.Logger, client SecretGetter, secretTemplates []*template.Template) *secretManagerFactory {
+	return &secretManagerFactory{
+		log:             log,
+		client:          client,
+		secretTemplates: secretTemplates,
+	}
+}
+
+func (factory *secretManagerFactory) NewVariables(teamName string, pipelineName string) creds.Variables {
+	return NewSecretManager(factory.log, factory.client, teamName, pipelineName, factory.secretTemplates)
+}
@@ -0,0 +1,13 @@
+package gcpsecretmanager_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestGcpSecretManager(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GCP Secret Manager Creds Suite")
+}
@@ -0,0 +1,154 @@
+package gcpsecretmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"text/template"
+	"text/template/parse"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+const DefaultPipelineSecretTemplate = "concourse-{{.Team}}-{{.Pipeline}}-{{.Secret}}"
+const DefaultTeamSecretTemplate = "concourse-{{.Team}}-{{.Secret}}"
+
+type Manager struct {
+	Project         string `long:"project" description:"GCP project containing the secrets"`
+	CredentialsFile string `long:"credentials-file" description:"Path to a service account key file. If not specified, the instance's default service account is used."`
+
+	Endpoint    string `long:"endpoint" default:"https://secretmanager.googleapis.com" description:"Secret Manager API endpoint"`
+	MetadataURL string `long:"metadata-url" default:"http://metadata.google.internal" description:"Metadata server used to obtain access tokens for the default service account"`
+
+	PipelineSecretTemplate string `long:"pipeline-secret-template" description:"GCP Secret Manager secret ID template used for pipeline specific secrets" default:"concourse-{{.Team}}-{{.Pipeline}}-{{.Secret}}"`
+	TeamSecretTemplate     string `long:"team-secret-template" description:"GCP Secret Manager secret ID template used for team specific secrets" default:"concourse-{{.Team}}-{{.Secret}}"`
+
+	Client *Client
+}
+
+type Secret struct {
+	Team     string
+	Pipeline string
+	Secret   string
+}
+
+func buildSecretTemplate(name, tmpl string) (*template.Template, error) {
+	t, err := template.
+		New(name).
+		Option("missingkey=error").
+		Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	if parse.IsEmptyTree(t.Root) {
+		return nil, errors.New("secret template should not be empty")
+	}
+	return t, nil
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	var key []byte
+	if manager.CredentialsFile != "" {
+		var err error
+		key, err = ioutil.ReadFile(manager.CredentialsFile)
+		if err != nil {
+			log.Error("failed-to-read-credentials-file", err)
+			return err
+		}
+	}
+
+	client, err := NewClient(manager.Endpoint, manager.Project, key, manager.MetadataURL)
+	if err != nil {
+		log.Error("failed-to-create-client", err)
+		return err
+	}
+
+	manager.Client = client
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "AccessSecretVersion",
+	}
+
+	_, _, err := manager.Client.GetSecret("concourse-health-check")
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status": "UP",
+	}
+
+	return health, nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"project":                  manager.Project,
+		"pipeline_secret_template": manager.PipelineSecretTemplate,
+		"team_secret_template":     manager.TeamSecretTemplate,
+		"health":                   health,
+	})
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Project != ""
+}
+
+func (manager *Manager) Validate() error {
+	// Make sure that the template is valid
+	pipelineSecretTemplate, err := buildSecretTemplate("pipeline-secret-template", manager.PipelineSecretTemplate)
+	if err != nil {
+		return err
+	}
+	teamSecretTemplate, err := buildSecretTemplate("team-secret-template", manager.TeamSecretTemplate)
+	if err != nil {
+		return err
+	}
+	// Execute the templates on dummy data to verify that it does not expect additional data
+	dummy := Secret{Team: "team", Pipeline: "pipeline", Secret: "secret"}
+	if err = pipelineSecretTemplate.Execute(ioutil.Discard, &dummy); err != nil {
+		return err
+	}
+	if err = teamSecretTemplate.Execute(ioutil.Discard, &dummy); err != nil {
+		return err
+	}
+
+	if manager.CredentialsFile != "" {
+		key, err := ioutil.ReadFile(manager.CredentialsFile)
+		if err != nil {
+			return err
+		}
+
+		_, err = jwtConfigFromJSON(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (manager *Manager) NewVariablesFactory(log lager.Logger) (creds.VariablesFactory, error) {
+	pipelineSecretTemplate, err := buildSecretTemplate("pipeline-secret-template", manager.PipelineSecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	teamSecretTemplate, err := buildSecretTemplate("team-secret-template", manager.TeamSecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSecretManagerFactory(log, manager.Client, []*template.Template{pipelineSecretTemplate, teamSecretTemplate}), nil
+}
@@ -0,0 +1,83 @@
+package gcpsecretmanager_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/concourse/concourse/atc/creds/gcpsecretmanager"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var manager gcpsecretmanager.Manager
+
+	Describe("IsConfigured()", func() {
+		JustBeforeEach(func() {
+			_, err := flags.ParseArgs(&manager, []string{})
+			Expect(err).To(BeNil())
+		})
+
+		It("fails on empty Manager", func() {
+			Expect(manager.IsConfigured()).To(BeFalse())
+		})
+
+		It("passes if Project is set", func() {
+			manager.Project = "some-project"
+			Expect(manager.IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Describe("Validate()", func() {
+		BeforeEach(func() {
+			manager = gcpsecretmanager.Manager{Project: "some-project"}
+			_, err := flags.ParseArgs(&manager, []string{})
+			Expect(err).To(BeNil())
+			Expect(manager.PipelineSecretTemplate).To(Equal(gcpsecretmanager.DefaultPipelineSecretTemplate))
+			Expect(manager.TeamSecretTemplate).To(Equal(gcpsecretmanager.DefaultTeamSecretTemplate))
+		})
+
+		It("passes on default parameters", func() {
+			Expect(manager.Validate()).To(BeNil())
+		})
+
+		It("fails on pipeline secret template with unknown fields", func() {
+			manager.PipelineSecretTemplate = "{{.Team}}-{{.Unknown}}"
+			Expect(manager.Validate()).ToNot(BeNil())
+		})
+
+		Context("with a credentials file", func() {
+			var credentialsFile string
+
+			BeforeEach(func() {
+				f, err := ioutil.TempFile("", "gcp-credentials")
+				Expect(err).ToNot(HaveOccurred())
+				credentialsFile = f.Name()
+				Expect(f.Close()).To(Succeed())
+
+				manager.CredentialsFile = credentialsFile
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(credentialsFile)).To(Succeed())
+			})
+
+			It("passes with a service account key", func() {
+				Expect(ioutil.WriteFile(credentialsFile, []byte(`{"type":"service_account","client_email":"ci@some-project.iam.gserviceaccount.com"}`), 0600)).To(Succeed())
+				Expect(manager.Validate()).To(BeNil())
+			})
+
+			It("fails with malformed JSON", func() {
+				Expect(ioutil.WriteFile(credentialsFile, []byte(`{`), 0600)).To(Succeed())
+				Expect(manager.Validate()).ToNot(BeNil())
+			})
+
+			It("fails when the file does not exist", func() {
+				manager.CredentialsFile = credentialsFile + "-missing"
+				Expect(manager.Validate()).ToNot(BeNil())
+			})
+		})
+	})
+})
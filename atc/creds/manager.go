@@ -22,6 +22,7 @@ type Managers map[string]Manager
 
 type CredentialManagementConfig struct {
 	RetryConfig SecretRetryConfig
+	CacheConfig SecretCacheConfig
 }
 
 type HealthResponse struct {
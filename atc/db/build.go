@@ -43,7 +43,7 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained").
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained, b.log_archive_location").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
@@ -91,6 +91,7 @@ type Build interface {
 
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
+	SaveEvents(events []atc.Event) error
 
 	SaveOutput(lager.Logger, string, atc.Source, creds.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	UseInputs(inputs []BuildInput) error
@@ -107,6 +108,9 @@ type Build interface {
 
 	IsDrained() bool
 	SetDrained(bool) error
+
+	LogArchiveLocation() string
+	SetLogArchiveLocation(string) error
 }
 
 type build struct {
@@ -138,6 +142,8 @@ type build struct {
 	conn        Conn
 	lockFactory lock.LockFactory
 	drained     bool
+
+	logArchiveLocation string
 }
 
 var ErrBuildDisappeared = errors.New("build disappeared from db")
@@ -171,6 +177,7 @@ func (b *build) Status() BuildStatus          { return b.status }
 func (b *build) Tracker() string              { return b.trackedBy }
 func (b *build) IsScheduled() bool            { return b.scheduled }
 func (b *build) IsDrained() bool              { return b.drained }
+func (b *build) LogArchiveLocation() string   { return b.logArchiveLocation }
 
 func (b *build) IsRunning() bool {
 	switch b.status {
@@ -415,6 +422,23 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+// SetLogArchiveLocation records where the build's events have been archived,
+// so that they can still be read once they are reaped.
+func (b *build) SetLogArchiveLocation(location string) error {
+	_, err := psql.Update("builds").
+		Set("log_archive_location", location).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	b.logArchiveLocation = location
+
+	return nil
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -749,14 +773,9 @@ func (b *build) Events(from uint) (EventSource, error) {
 		return nil, err
 	}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
-	}
-
 	return newBuildEventSource(
 		b.id,
-		table,
+		buildEventsTable(b.teamID, b.pipelineID),
 		b.conn,
 		notifier,
 		from,
@@ -784,6 +803,33 @@ func (b *build) SaveEvent(event atc.Event) error {
 	return b.conn.Bus().Notify(buildEventsChannel(b.id))
 }
 
+// SaveEvents saves the events in a single insert, notifying subscribers to
+// the build's events once.
+func (b *build) SaveEvents(events []atc.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = b.saveEvents(tx, events)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return b.conn.Bus().Notify(buildEventsChannel(b.id))
+}
+
 func (b *build) SaveOutput(
 	logger lager.Logger,
 	resourceType string,
@@ -1059,13 +1105,13 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		jobID, pipelineID                                                    sql.NullInt64
 		engine, engineMetadata, jobName, pipelineName, publicPlan, trackedBy sql.NullString
 		startTime, endTime, reapTime                                         pq.NullTime
-		nonce                                                                sql.NullString
+		nonce, logArchiveLocation                                            sql.NullString
 		drained                                                              bool
 
 		status string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained, &logArchiveLocation)
 	if err != nil {
 		return err
 	}
@@ -1081,6 +1127,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.reapTime = reapTime.Time
 	b.trackedBy = trackedBy.String
 	b.drained = drained
+	b.logArchiveLocation = logArchiveLocation.String
 
 	var (
 		noncense                *string
@@ -1108,20 +1155,23 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 }
 
 func (b *build) saveEvent(tx Tx, event atc.Event) error {
-	payload, err := json.Marshal(event)
-	if err != nil {
-		return err
-	}
+	return b.saveEvents(tx, []atc.Event{event})
+}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
+func (b *build) saveEvents(tx Tx, events []atc.Event) error {
+	insert := psql.Insert(buildEventsPartition(b.teamID, b.pipelineID, b.id)).
+		Columns("event_id", "build_id", "type", "version", "payload")
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		insert = insert.Values(sq.Expr("nextval('"+buildEventSeq(b.id)+"')"), b.id, string(event.EventType()), string(event.Version()), payload)
 	}
-	_, err = psql.Insert(table).
-		Columns("event_id", "build_id", "type", "version", "payload").
-		Values(sq.Expr("nextval('"+buildEventSeq(b.id)+"')"), b.id, string(event.EventType()), string(event.Version()), payload).
-		RunWith(tx).
-		Exec()
+
+	_, err := insert.RunWith(tx).Exec()
 	return err
 }
 
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// buildEventPartitionSize is the number of build IDs whose events are stored
+// in each partition of a pipeline's or team's build events table. It must
+// match build_event_partition_size() in the migrations, which creates each
+// partition when its first build is created.
+const buildEventPartitionSize = 100000
+
+// buildEventsTable is the table from which a build's events are read. It
+// includes all of its partitions.
+func buildEventsTable(teamID int, pipelineID int) string {
+	if pipelineID != 0 {
+		return fmt.Sprintf("pipeline_build_events_%d", pipelineID)
+	}
+
+	return fmt.Sprintf("team_build_events_%d", teamID)
+}
+
+// buildEventsPartition is the table in which a build's events are saved.
+func buildEventsPartition(teamID int, pipelineID int, buildID int) string {
+	return fmt.Sprintf("%s_p%d", buildEventsTable(teamID, pipelineID), buildID/buildEventPartitionSize)
+}
+
+// dropReapedBuildEventPartitions drops each partition of a pipeline's build
+// events in which every build has been reaped, as long as no more builds can
+// be created in it.
+func dropReapedBuildEventPartitions(tx Tx, pipelineID int) ([]string, error) {
+	table := buildEventsTable(0, pipelineID)
+
+	rows, err := tx.Query(`
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = to_regclass($1)
+	`, table)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := map[string]int{}
+	for rows.Next() {
+		var partition string
+		err = rows.Scan(&partition)
+		if err != nil {
+			Close(rows)
+			return nil, err
+		}
+
+		bucket, err := strconv.Atoi(strings.TrimPrefix(partition, table+"_p"))
+		if err != nil {
+			// not one of ours
+			continue
+		}
+
+		partitions[partition] = bucket
+	}
+
+	Close(rows)
+
+	if len(partitions) == 0 {
+		return nil, nil
+	}
+
+	var maxBuildID int
+	err = psql.Select("COALESCE(MAX(id), 0)").
+		From("builds").
+		RunWith(tx).
+		QueryRow().
+		Scan(&maxBuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	dropped := []string{}
+	for partition, bucket := range partitions {
+		start := bucket * buildEventPartitionSize
+		end := start + buildEventPartitionSize
+
+		// leave a partition's worth of builds as a margin for builds which are
+		// still being created
+		if maxBuildID < end+buildEventPartitionSize {
+			continue
+		}
+
+		var unreaped bool
+		err = tx.QueryRow(`
+			SELECT EXISTS (
+				SELECT 1
+				FROM builds
+				WHERE pipeline_id = $1
+				AND id >= $2
+				AND id < $3
+				AND reap_time IS NULL
+			)
+		`, pipelineID, start, end).Scan(&unreaped)
+		if err != nil {
+			return nil, err
+		}
+
+		if unreaped {
+			continue
+		}
+
+		_, err = tx.Exec(`DROP TABLE ` + partition)
+		if err != nil {
+			return nil, err
+		}
+
+		dropped = append(dropped, partition)
+	}
+
+	return dropped, nil
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"sync"
@@ -87,6 +88,11 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 
 	var batchSize = cap(source.events)
 
+	// once an event has been read, page by event ID rather than by offset so
+	// that long builds don't rescan all of their earlier events
+	var lastEventID int64
+	var seeked bool
+
 	for {
 		select {
 		case <-source.stop:
@@ -97,26 +103,47 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 		}
 
 		completed := false
+		reaped := false
 
 		err := source.conn.QueryRow(`
-			SELECT builds.completed
+			SELECT builds.completed, COALESCE(builds.reap_time >= builds.end_time, false)
 			FROM builds
 			WHERE builds.id = $1
-		`, source.buildID).Scan(&completed)
+		`, source.buildID).Scan(&completed, &reaped)
 		if err != nil {
 			source.err = err
 			close(source.events)
 			return
 		}
 
-		rows, err := source.conn.Query(`
-			SELECT type, version, payload
-			FROM `+source.table+`
-			WHERE build_id = $1
-			ORDER BY event_id ASC
-			OFFSET $2
-			LIMIT $3
-		`, source.buildID, cursor, batchSize)
+		// the events of a reaped build are left in its partition until the
+		// whole partition can be dropped, so they must not be read
+		if completed && reaped {
+			source.err = ErrEndOfBuildEventStream
+			close(source.events)
+			return
+		}
+
+		var rows *sql.Rows
+		if seeked {
+			rows, err = source.conn.Query(`
+				SELECT event_id, type, version, payload
+				FROM `+source.table+`
+				WHERE build_id = $1
+				AND event_id > $2
+				ORDER BY event_id ASC
+				LIMIT $3
+			`, source.buildID, lastEventID, batchSize)
+		} else {
+			rows, err = source.conn.Query(`
+				SELECT event_id, type, version, payload
+				FROM `+source.table+`
+				WHERE build_id = $1
+				ORDER BY event_id ASC
+				OFFSET $2
+				LIMIT $3
+			`, source.buildID, cursor, batchSize)
+		}
 		if err != nil {
 			source.err = err
 			close(source.events)
@@ -131,7 +158,7 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 			cursor++
 
 			var t, v, p string
-			err := rows.Scan(&t, &v, &p)
+			err := rows.Scan(&lastEventID, &t, &v, &p)
 			if err != nil {
 				_ = rows.Close()
 
@@ -140,6 +167,8 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 				return
 			}
 
+			seeked = true
+
 			data := json.RawMessage(p)
 
 			ev := event.Envelope{
@@ -66,6 +66,27 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("LogArchiveLocation", func() {
+		It("is empty in the beginning", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.LogArchiveLocation()).To(BeEmpty())
+		})
+
+		It("is set after archiving and a reload", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetLogArchiveLocation("file:///some/archive.ndjson.gz")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.LogArchiveLocation()).To(Equal("file:///some/archive.ndjson.gz"))
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.LogArchiveLocation()).To(Equal("file:///some/archive.ndjson.gz"))
+		})
+	})
+
 	Describe("Start", func() {
 		var build db.Build
 		var plan atc.Plan
@@ -342,6 +363,49 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("SaveEvents", func() {
+		It("saves the events in order and notifies subscribers", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := build.Events(0)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(events)
+
+			err = build.SaveEvents([]atc.Event{
+				event.Log{Payload: "some "},
+				event.Log{Payload: "log"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(events.Next()).To(Equal(envelope(event.Log{
+				Payload: "some ",
+			})))
+
+			Expect(events.Next()).To(Equal(envelope(event.Log{
+				Payload: "log",
+			})))
+		})
+
+		It("saves the events in the build's partition", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveEvents([]atc.Event{event.Log{Payload: "some log"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			var count int
+			err = dbConn.QueryRow(fmt.Sprintf(
+				"SELECT COUNT(*) FROM ONLY team_build_events_%d_p%d WHERE build_id = $1",
+				team.ID(),
+				build.ID()/100000,
+			), build.ID()).Scan(&count)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+	})
+
 	Describe("SaveOutput", func() {
 		var pipeline db.Pipeline
 		var job db.Job
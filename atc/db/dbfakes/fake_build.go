@@ -201,6 +201,16 @@ type FakeBuild struct {
 	jobNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LogArchiveLocationStub        func() string
+	logArchiveLocationMutex       sync.RWMutex
+	logArchiveLocationArgsForCall []struct {
+	}
+	logArchiveLocationReturns struct {
+		result1 string
+	}
+	logArchiveLocationReturnsOnCall map[int]struct {
+		result1 string
+	}
 	MarkAsAbortedStub        func() error
 	markAsAbortedMutex       sync.RWMutex
 	markAsAbortedArgsForCall []struct {
@@ -326,6 +336,17 @@ type FakeBuild struct {
 	saveEventReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveEventsStub        func([]atc.Event) error
+	saveEventsMutex       sync.RWMutex
+	saveEventsArgsForCall []struct {
+		arg1 []atc.Event
+	}
+	saveEventsReturns struct {
+		result1 error
+	}
+	saveEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveImageResourceVersionStub        func(db.UsedResourceCache) error
 	saveImageResourceVersionMutex       sync.RWMutex
 	saveImageResourceVersionArgsForCall []struct {
@@ -389,6 +410,17 @@ type FakeBuild struct {
 	setInterceptibleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetLogArchiveLocationStub        func(string) error
+	setLogArchiveLocationMutex       sync.RWMutex
+	setLogArchiveLocationArgsForCall []struct {
+		arg1 string
+	}
+	setLogArchiveLocationReturns struct {
+		result1 error
+	}
+	setLogArchiveLocationReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartStub        func(string, string, atc.Plan) (bool, error)
 	startMutex       sync.RWMutex
 	startArgsForCall []struct {
@@ -1415,6 +1447,58 @@ func (fake *FakeBuild) JobNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) LogArchiveLocation() string {
+	fake.logArchiveLocationMutex.Lock()
+	ret, specificReturn := fake.logArchiveLocationReturnsOnCall[len(fake.logArchiveLocationArgsForCall)]
+	fake.logArchiveLocationArgsForCall = append(fake.logArchiveLocationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LogArchiveLocation", []interface{}{})
+	fake.logArchiveLocationMutex.Unlock()
+	if fake.LogArchiveLocationStub != nil {
+		return fake.LogArchiveLocationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.logArchiveLocationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) LogArchiveLocationCallCount() int {
+	fake.logArchiveLocationMutex.RLock()
+	defer fake.logArchiveLocationMutex.RUnlock()
+	return len(fake.logArchiveLocationArgsForCall)
+}
+
+func (fake *FakeBuild) LogArchiveLocationCalls(stub func() string) {
+	fake.logArchiveLocationMutex.Lock()
+	defer fake.logArchiveLocationMutex.Unlock()
+	fake.LogArchiveLocationStub = stub
+}
+
+func (fake *FakeBuild) LogArchiveLocationReturns(result1 string) {
+	fake.logArchiveLocationMutex.Lock()
+	defer fake.logArchiveLocationMutex.Unlock()
+	fake.LogArchiveLocationStub = nil
+	fake.logArchiveLocationReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) LogArchiveLocationReturnsOnCall(i int, result1 string) {
+	fake.logArchiveLocationMutex.Lock()
+	defer fake.logArchiveLocationMutex.Unlock()
+	fake.LogArchiveLocationStub = nil
+	if fake.logArchiveLocationReturnsOnCall == nil {
+		fake.logArchiveLocationReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.logArchiveLocationReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) MarkAsAborted() error {
 	fake.markAsAbortedMutex.Lock()
 	ret, specificReturn := fake.markAsAbortedReturnsOnCall[len(fake.markAsAbortedArgsForCall)]
@@ -2016,6 +2100,71 @@ func (fake *FakeBuild) SaveEventReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SaveEvents(arg1 []atc.Event) error {
+	var arg1Copy []atc.Event
+	if arg1 != nil {
+		arg1Copy = make([]atc.Event, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.saveEventsMutex.Lock()
+	ret, specificReturn := fake.saveEventsReturnsOnCall[len(fake.saveEventsArgsForCall)]
+	fake.saveEventsArgsForCall = append(fake.saveEventsArgsForCall, struct {
+		arg1 []atc.Event
+	}{arg1Copy})
+	fake.recordInvocation("SaveEvents", []interface{}{arg1Copy})
+	fake.saveEventsMutex.Unlock()
+	if fake.SaveEventsStub != nil {
+		return fake.SaveEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveEventsCallCount() int {
+	fake.saveEventsMutex.RLock()
+	defer fake.saveEventsMutex.RUnlock()
+	return len(fake.saveEventsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveEventsCalls(stub func([]atc.Event) error) {
+	fake.saveEventsMutex.Lock()
+	defer fake.saveEventsMutex.Unlock()
+	fake.SaveEventsStub = stub
+}
+
+func (fake *FakeBuild) SaveEventsArgsForCall(i int) []atc.Event {
+	fake.saveEventsMutex.RLock()
+	defer fake.saveEventsMutex.RUnlock()
+	argsForCall := fake.saveEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveEventsReturns(result1 error) {
+	fake.saveEventsMutex.Lock()
+	defer fake.saveEventsMutex.Unlock()
+	fake.SaveEventsStub = nil
+	fake.saveEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveEventsReturnsOnCall(i int, result1 error) {
+	fake.saveEventsMutex.Lock()
+	defer fake.saveEventsMutex.Unlock()
+	fake.SaveEventsStub = nil
+	if fake.saveEventsReturnsOnCall == nil {
+		fake.saveEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SaveImageResourceVersion(arg1 db.UsedResourceCache) error {
 	fake.saveImageResourceVersionMutex.Lock()
 	ret, specificReturn := fake.saveImageResourceVersionReturnsOnCall[len(fake.saveImageResourceVersionArgsForCall)]
@@ -2318,6 +2467,66 @@ func (fake *FakeBuild) SetInterceptibleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetLogArchiveLocation(arg1 string) error {
+	fake.setLogArchiveLocationMutex.Lock()
+	ret, specificReturn := fake.setLogArchiveLocationReturnsOnCall[len(fake.setLogArchiveLocationArgsForCall)]
+	fake.setLogArchiveLocationArgsForCall = append(fake.setLogArchiveLocationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetLogArchiveLocation", []interface{}{arg1})
+	fake.setLogArchiveLocationMutex.Unlock()
+	if fake.SetLogArchiveLocationStub != nil {
+		return fake.SetLogArchiveLocationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setLogArchiveLocationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetLogArchiveLocationCallCount() int {
+	fake.setLogArchiveLocationMutex.RLock()
+	defer fake.setLogArchiveLocationMutex.RUnlock()
+	return len(fake.setLogArchiveLocationArgsForCall)
+}
+
+func (fake *FakeBuild) SetLogArchiveLocationCalls(stub func(string) error) {
+	fake.setLogArchiveLocationMutex.Lock()
+	defer fake.setLogArchiveLocationMutex.Unlock()
+	fake.SetLogArchiveLocationStub = stub
+}
+
+func (fake *FakeBuild) SetLogArchiveLocationArgsForCall(i int) string {
+	fake.setLogArchiveLocationMutex.RLock()
+	defer fake.setLogArchiveLocationMutex.RUnlock()
+	argsForCall := fake.setLogArchiveLocationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetLogArchiveLocationReturns(result1 error) {
+	fake.setLogArchiveLocationMutex.Lock()
+	defer fake.setLogArchiveLocationMutex.Unlock()
+	fake.SetLogArchiveLocationStub = nil
+	fake.setLogArchiveLocationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetLogArchiveLocationReturnsOnCall(i int, result1 error) {
+	fake.setLogArchiveLocationMutex.Lock()
+	defer fake.setLogArchiveLocationMutex.Unlock()
+	fake.SetLogArchiveLocationStub = nil
+	if fake.setLogArchiveLocationReturnsOnCall == nil {
+		fake.setLogArchiveLocationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setLogArchiveLocationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Start(arg1 string, arg2 string, arg3 atc.Plan) (bool, error) {
 	fake.startMutex.Lock()
 	ret, specificReturn := fake.startReturnsOnCall[len(fake.startArgsForCall)]
@@ -2805,6 +3014,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobIDMutex.RUnlock()
 	fake.jobNameMutex.RLock()
 	defer fake.jobNameMutex.RUnlock()
+	fake.logArchiveLocationMutex.RLock()
+	defer fake.logArchiveLocationMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -2827,6 +3038,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.resourcesMutex.RUnlock()
 	fake.saveEventMutex.RLock()
 	defer fake.saveEventMutex.RUnlock()
+	fake.saveEventsMutex.RLock()
+	defer fake.saveEventsMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
 	defer fake.saveImageResourceVersionMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
@@ -2837,6 +3050,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
 	defer fake.setInterceptibleMutex.RUnlock()
+	fake.setLogArchiveLocationMutex.RLock()
+	defer fake.setLogArchiveLocationMutex.RUnlock()
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
 	fake.startTimeMutex.RLock()
@@ -0,0 +1,180 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	context "context"
+	sync "sync"
+
+	db "github.com/concourse/concourse/atc/db"
+)
+
+type FakeEncryptionKeyRotator struct {
+	ProgressStub        func() (db.EncryptionKeyRotationProgress, error)
+	progressMutex       sync.RWMutex
+	progressArgsForCall []struct {
+	}
+	progressReturns struct {
+		result1 db.EncryptionKeyRotationProgress
+		result2 error
+	}
+	progressReturnsOnCall map[int]struct {
+		result1 db.EncryptionKeyRotationProgress
+		result2 error
+	}
+	RunStub        func(context.Context) error
+	runMutex       sync.RWMutex
+	runArgsForCall []struct {
+		arg1 context.Context
+	}
+	runReturns struct {
+		result1 error
+	}
+	runReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEncryptionKeyRotator) Progress() (db.EncryptionKeyRotationProgress, error) {
+	fake.progressMutex.Lock()
+	ret, specificReturn := fake.progressReturnsOnCall[len(fake.progressArgsForCall)]
+	fake.progressArgsForCall = append(fake.progressArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Progress", []interface{}{})
+	fake.progressMutex.Unlock()
+	if fake.ProgressStub != nil {
+		return fake.ProgressStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.progressReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEncryptionKeyRotator) ProgressCallCount() int {
+	fake.progressMutex.RLock()
+	defer fake.progressMutex.RUnlock()
+	return len(fake.progressArgsForCall)
+}
+
+func (fake *FakeEncryptionKeyRotator) ProgressCalls(stub func() (db.EncryptionKeyRotationProgress, error)) {
+	fake.progressMutex.Lock()
+	defer fake.progressMutex.Unlock()
+	fake.ProgressStub = stub
+}
+
+func (fake *FakeEncryptionKeyRotator) ProgressReturns(result1 db.EncryptionKeyRotationProgress, result2 error) {
+	fake.progressMutex.Lock()
+	defer fake.progressMutex.Unlock()
+	fake.ProgressStub = nil
+	fake.progressReturns = struct {
+		result1 db.EncryptionKeyRotationProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionKeyRotator) ProgressReturnsOnCall(i int, result1 db.EncryptionKeyRotationProgress, result2 error) {
+	fake.progressMutex.Lock()
+	defer fake.progressMutex.Unlock()
+	fake.ProgressStub = nil
+	if fake.progressReturnsOnCall == nil {
+		fake.progressReturnsOnCall = make(map[int]struct {
+			result1 db.EncryptionKeyRotationProgress
+			result2 error
+		})
+	}
+	fake.progressReturnsOnCall[i] = struct {
+		result1 db.EncryptionKeyRotationProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionKeyRotator) Run(arg1 context.Context) error {
+	fake.runMutex.Lock()
+	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
+	fake.runArgsForCall = append(fake.runArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("Run", []interface{}{arg1})
+	fake.runMutex.Unlock()
+	if fake.RunStub != nil {
+		return fake.RunStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.runReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeEncryptionKeyRotator) RunCallCount() int {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return len(fake.runArgsForCall)
+}
+
+func (fake *FakeEncryptionKeyRotator) RunCalls(stub func(context.Context) error) {
+	fake.runMutex.Lock()
+	defer fake.runMutex.Unlock()
+	fake.RunStub = stub
+}
+
+func (fake *FakeEncryptionKeyRotator) RunArgsForCall(i int) context.Context {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	argsForCall := fake.runArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEncryptionKeyRotator) RunReturns(result1 error) {
+	fake.runMutex.Lock()
+	defer fake.runMutex.Unlock()
+	fake.RunStub = nil
+	fake.runReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEncryptionKeyRotator) RunReturnsOnCall(i int, result1 error) {
+	fake.runMutex.Lock()
+	defer fake.runMutex.Unlock()
+	fake.RunStub = nil
+	if fake.runReturnsOnCall == nil {
+		fake.runReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEncryptionKeyRotator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.progressMutex.RLock()
+	defer fake.progressMutex.RUnlock()
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEncryptionKeyRotator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.EncryptionKeyRotator = new(FakeEncryptionKeyRotator)
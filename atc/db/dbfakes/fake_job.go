@@ -211,6 +211,19 @@ type FakeJob struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	LatestSucceededBuildIDsStub        func(int) ([]int, error)
+	latestSucceededBuildIDsMutex       sync.RWMutex
+	latestSucceededBuildIDsArgsForCall []struct {
+		arg1 int
+	}
+	latestSucceededBuildIDsReturns struct {
+		result1 []int
+		result2 error
+	}
+	latestSucceededBuildIDsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -346,6 +359,20 @@ type FakeJob struct {
 	unpauseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UnreapedSucceededBuildsStub        func(int, int) ([]db.Build, error)
+	unreapedSucceededBuildsMutex       sync.RWMutex
+	unreapedSucceededBuildsArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	unreapedSucceededBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	unreapedSucceededBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	UpdateFirstLoggedBuildIDStub        func(int) error
 	updateFirstLoggedBuildIDMutex       sync.RWMutex
 	updateFirstLoggedBuildIDArgsForCall []struct {
@@ -1303,6 +1330,69 @@ func (fake *FakeJob) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) LatestSucceededBuildIDs(arg1 int) ([]int, error) {
+	fake.latestSucceededBuildIDsMutex.Lock()
+	ret, specificReturn := fake.latestSucceededBuildIDsReturnsOnCall[len(fake.latestSucceededBuildIDsArgsForCall)]
+	fake.latestSucceededBuildIDsArgsForCall = append(fake.latestSucceededBuildIDsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("LatestSucceededBuildIDs", []interface{}{arg1})
+	fake.latestSucceededBuildIDsMutex.Unlock()
+	if fake.LatestSucceededBuildIDsStub != nil {
+		return fake.LatestSucceededBuildIDsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.latestSucceededBuildIDsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) LatestSucceededBuildIDsCallCount() int {
+	fake.latestSucceededBuildIDsMutex.RLock()
+	defer fake.latestSucceededBuildIDsMutex.RUnlock()
+	return len(fake.latestSucceededBuildIDsArgsForCall)
+}
+
+func (fake *FakeJob) LatestSucceededBuildIDsCalls(stub func(int) ([]int, error)) {
+	fake.latestSucceededBuildIDsMutex.Lock()
+	defer fake.latestSucceededBuildIDsMutex.Unlock()
+	fake.LatestSucceededBuildIDsStub = stub
+}
+
+func (fake *FakeJob) LatestSucceededBuildIDsArgsForCall(i int) int {
+	fake.latestSucceededBuildIDsMutex.RLock()
+	defer fake.latestSucceededBuildIDsMutex.RUnlock()
+	argsForCall := fake.latestSucceededBuildIDsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) LatestSucceededBuildIDsReturns(result1 []int, result2 error) {
+	fake.latestSucceededBuildIDsMutex.Lock()
+	defer fake.latestSucceededBuildIDsMutex.Unlock()
+	fake.LatestSucceededBuildIDsStub = nil
+	fake.latestSucceededBuildIDsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) LatestSucceededBuildIDsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.latestSucceededBuildIDsMutex.Lock()
+	defer fake.latestSucceededBuildIDsMutex.Unlock()
+	fake.LatestSucceededBuildIDsStub = nil
+	if fake.latestSucceededBuildIDsReturnsOnCall == nil {
+		fake.latestSucceededBuildIDsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.latestSucceededBuildIDsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -2006,6 +2096,70 @@ func (fake *FakeJob) UnpauseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) UnreapedSucceededBuilds(arg1 int, arg2 int) ([]db.Build, error) {
+	fake.unreapedSucceededBuildsMutex.Lock()
+	ret, specificReturn := fake.unreapedSucceededBuildsReturnsOnCall[len(fake.unreapedSucceededBuildsArgsForCall)]
+	fake.unreapedSucceededBuildsArgsForCall = append(fake.unreapedSucceededBuildsArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("UnreapedSucceededBuilds", []interface{}{arg1, arg2})
+	fake.unreapedSucceededBuildsMutex.Unlock()
+	if fake.UnreapedSucceededBuildsStub != nil {
+		return fake.UnreapedSucceededBuildsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unreapedSucceededBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) UnreapedSucceededBuildsCallCount() int {
+	fake.unreapedSucceededBuildsMutex.RLock()
+	defer fake.unreapedSucceededBuildsMutex.RUnlock()
+	return len(fake.unreapedSucceededBuildsArgsForCall)
+}
+
+func (fake *FakeJob) UnreapedSucceededBuildsCalls(stub func(int, int) ([]db.Build, error)) {
+	fake.unreapedSucceededBuildsMutex.Lock()
+	defer fake.unreapedSucceededBuildsMutex.Unlock()
+	fake.UnreapedSucceededBuildsStub = stub
+}
+
+func (fake *FakeJob) UnreapedSucceededBuildsArgsForCall(i int) (int, int) {
+	fake.unreapedSucceededBuildsMutex.RLock()
+	defer fake.unreapedSucceededBuildsMutex.RUnlock()
+	argsForCall := fake.unreapedSucceededBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) UnreapedSucceededBuildsReturns(result1 []db.Build, result2 error) {
+	fake.unreapedSucceededBuildsMutex.Lock()
+	defer fake.unreapedSucceededBuildsMutex.Unlock()
+	fake.UnreapedSucceededBuildsStub = nil
+	fake.unreapedSucceededBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) UnreapedSucceededBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.unreapedSucceededBuildsMutex.Lock()
+	defer fake.unreapedSucceededBuildsMutex.Unlock()
+	fake.UnreapedSucceededBuildsStub = nil
+	if fake.unreapedSucceededBuildsReturnsOnCall == nil {
+		fake.unreapedSucceededBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.unreapedSucceededBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) UpdateFirstLoggedBuildID(arg1 int) error {
 	fake.updateFirstLoggedBuildIDMutex.Lock()
 	ret, specificReturn := fake.updateFirstLoggedBuildIDReturnsOnCall[len(fake.updateFirstLoggedBuildIDArgsForCall)]
@@ -2101,6 +2255,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.getRunningBuildsBySerialGroupMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.latestSucceededBuildIDsMutex.RLock()
+	defer fake.latestSucceededBuildIDsMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pauseMutex.RLock()
@@ -2127,6 +2283,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
+	fake.unreapedSucceededBuildsMutex.RLock()
+	defer fake.unreapedSucceededBuildsMutex.RUnlock()
 	fake.updateFirstLoggedBuildIDMutex.RLock()
 	defer fake.updateFirstLoggedBuildIDMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -8,6 +8,19 @@ import (
 )
 
 type FakeJobFactory struct {
+	PipelineDashboardStub        func(int) (db.Dashboard, error)
+	pipelineDashboardMutex       sync.RWMutex
+	pipelineDashboardArgsForCall []struct {
+		arg1 int
+	}
+	pipelineDashboardReturns struct {
+		result1 db.Dashboard
+		result2 error
+	}
+	pipelineDashboardReturnsOnCall map[int]struct {
+		result1 db.Dashboard
+		result2 error
+	}
 	VisibleJobsStub        func([]string) (db.Dashboard, error)
 	visibleJobsMutex       sync.RWMutex
 	visibleJobsArgsForCall []struct {
@@ -25,6 +38,69 @@ type FakeJobFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeJobFactory) PipelineDashboard(arg1 int) (db.Dashboard, error) {
+	fake.pipelineDashboardMutex.Lock()
+	ret, specificReturn := fake.pipelineDashboardReturnsOnCall[len(fake.pipelineDashboardArgsForCall)]
+	fake.pipelineDashboardArgsForCall = append(fake.pipelineDashboardArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("PipelineDashboard", []interface{}{arg1})
+	fake.pipelineDashboardMutex.Unlock()
+	if fake.PipelineDashboardStub != nil {
+		return fake.PipelineDashboardStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pipelineDashboardReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobFactory) PipelineDashboardCallCount() int {
+	fake.pipelineDashboardMutex.RLock()
+	defer fake.pipelineDashboardMutex.RUnlock()
+	return len(fake.pipelineDashboardArgsForCall)
+}
+
+func (fake *FakeJobFactory) PipelineDashboardCalls(stub func(int) (db.Dashboard, error)) {
+	fake.pipelineDashboardMutex.Lock()
+	defer fake.pipelineDashboardMutex.Unlock()
+	fake.PipelineDashboardStub = stub
+}
+
+func (fake *FakeJobFactory) PipelineDashboardArgsForCall(i int) int {
+	fake.pipelineDashboardMutex.RLock()
+	defer fake.pipelineDashboardMutex.RUnlock()
+	argsForCall := fake.pipelineDashboardArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJobFactory) PipelineDashboardReturns(result1 db.Dashboard, result2 error) {
+	fake.pipelineDashboardMutex.Lock()
+	defer fake.pipelineDashboardMutex.Unlock()
+	fake.PipelineDashboardStub = nil
+	fake.pipelineDashboardReturns = struct {
+		result1 db.Dashboard
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobFactory) PipelineDashboardReturnsOnCall(i int, result1 db.Dashboard, result2 error) {
+	fake.pipelineDashboardMutex.Lock()
+	defer fake.pipelineDashboardMutex.Unlock()
+	fake.PipelineDashboardStub = nil
+	if fake.pipelineDashboardReturnsOnCall == nil {
+		fake.pipelineDashboardReturnsOnCall = make(map[int]struct {
+			result1 db.Dashboard
+			result2 error
+		})
+	}
+	fake.pipelineDashboardReturnsOnCall[i] = struct {
+		result1 db.Dashboard
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJobFactory) VisibleJobs(arg1 []string) (db.Dashboard, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -96,6 +172,8 @@ func (fake *FakeJobFactory) VisibleJobsReturnsOnCall(i int, result1 db.Dashboard
 func (fake *FakeJobFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.pipelineDashboardMutex.RLock()
+	defer fake.pipelineDashboardMutex.RUnlock()
 	fake.visibleJobsMutex.RLock()
 	defer fake.visibleJobsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,1011 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	sql "database/sql"
+	driver "database/sql/driver"
+	sync "sync"
+
+	squirrel "github.com/Masterminds/squirrel"
+	db "github.com/concourse/concourse/atc/db"
+	encryption "github.com/concourse/concourse/atc/db/encryption"
+)
+
+type FakeReplicaConn struct {
+	BeginStub        func() (db.Tx, error)
+	beginMutex       sync.RWMutex
+	beginArgsForCall []struct {
+	}
+	beginReturns struct {
+		result1 db.Tx
+		result2 error
+	}
+	beginReturnsOnCall map[int]struct {
+		result1 db.Tx
+		result2 error
+	}
+	BusStub        func() db.NotificationsBus
+	busMutex       sync.RWMutex
+	busArgsForCall []struct {
+	}
+	busReturns struct {
+		result1 db.NotificationsBus
+	}
+	busReturnsOnCall map[int]struct {
+		result1 db.NotificationsBus
+	}
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DriverStub        func() driver.Driver
+	driverMutex       sync.RWMutex
+	driverArgsForCall []struct {
+	}
+	driverReturns struct {
+		result1 driver.Driver
+	}
+	driverReturnsOnCall map[int]struct {
+		result1 driver.Driver
+	}
+	EncryptionStrategyStub        func() encryption.Strategy
+	encryptionStrategyMutex       sync.RWMutex
+	encryptionStrategyArgsForCall []struct {
+	}
+	encryptionStrategyReturns struct {
+		result1 encryption.Strategy
+	}
+	encryptionStrategyReturnsOnCall map[int]struct {
+		result1 encryption.Strategy
+	}
+	ExecStub        func(string, ...interface{}) (sql.Result, error)
+	execMutex       sync.RWMutex
+	execArgsForCall []struct {
+		arg1 string
+		arg2 []interface{}
+	}
+	execReturns struct {
+		result1 sql.Result
+		result2 error
+	}
+	execReturnsOnCall map[int]struct {
+		result1 sql.Result
+		result2 error
+	}
+	NameStub        func() string
+	nameMutex       sync.RWMutex
+	nameArgsForCall []struct {
+	}
+	nameReturns struct {
+		result1 string
+	}
+	nameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PingStub        func() error
+	pingMutex       sync.RWMutex
+	pingArgsForCall []struct {
+	}
+	pingReturns struct {
+		result1 error
+	}
+	pingReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PrepareStub        func(string) (*sql.Stmt, error)
+	prepareMutex       sync.RWMutex
+	prepareArgsForCall []struct {
+		arg1 string
+	}
+	prepareReturns struct {
+		result1 *sql.Stmt
+		result2 error
+	}
+	prepareReturnsOnCall map[int]struct {
+		result1 *sql.Stmt
+		result2 error
+	}
+	QueryStub        func(string, ...interface{}) (*sql.Rows, error)
+	queryMutex       sync.RWMutex
+	queryArgsForCall []struct {
+		arg1 string
+		arg2 []interface{}
+	}
+	queryReturns struct {
+		result1 *sql.Rows
+		result2 error
+	}
+	queryReturnsOnCall map[int]struct {
+		result1 *sql.Rows
+		result2 error
+	}
+	QueryRowStub        func(string, ...interface{}) squirrel.RowScanner
+	queryRowMutex       sync.RWMutex
+	queryRowArgsForCall []struct {
+		arg1 string
+		arg2 []interface{}
+	}
+	queryRowReturns struct {
+		result1 squirrel.RowScanner
+	}
+	queryRowReturnsOnCall map[int]struct {
+		result1 squirrel.RowScanner
+	}
+	ReplicaLagsStub        func() []db.ReplicaLag
+	replicaLagsMutex       sync.RWMutex
+	replicaLagsArgsForCall []struct {
+	}
+	replicaLagsReturns struct {
+		result1 []db.ReplicaLag
+	}
+	replicaLagsReturnsOnCall map[int]struct {
+		result1 []db.ReplicaLag
+	}
+	SetMaxIdleConnsStub        func(int)
+	setMaxIdleConnsMutex       sync.RWMutex
+	setMaxIdleConnsArgsForCall []struct {
+		arg1 int
+	}
+	SetMaxOpenConnsStub        func(int)
+	setMaxOpenConnsMutex       sync.RWMutex
+	setMaxOpenConnsArgsForCall []struct {
+		arg1 int
+	}
+	StatsStub        func() sql.DBStats
+	statsMutex       sync.RWMutex
+	statsArgsForCall []struct {
+	}
+	statsReturns struct {
+		result1 sql.DBStats
+	}
+	statsReturnsOnCall map[int]struct {
+		result1 sql.DBStats
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReplicaConn) Begin() (db.Tx, error) {
+	fake.beginMutex.Lock()
+	ret, specificReturn := fake.beginReturnsOnCall[len(fake.beginArgsForCall)]
+	fake.beginArgsForCall = append(fake.beginArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Begin", []interface{}{})
+	fake.beginMutex.Unlock()
+	if fake.BeginStub != nil {
+		return fake.BeginStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.beginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReplicaConn) BeginCallCount() int {
+	fake.beginMutex.RLock()
+	defer fake.beginMutex.RUnlock()
+	return len(fake.beginArgsForCall)
+}
+
+func (fake *FakeReplicaConn) BeginCalls(stub func() (db.Tx, error)) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = stub
+}
+
+func (fake *FakeReplicaConn) BeginReturns(result1 db.Tx, result2 error) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = nil
+	fake.beginReturns = struct {
+		result1 db.Tx
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReplicaConn) BeginReturnsOnCall(i int, result1 db.Tx, result2 error) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = nil
+	if fake.beginReturnsOnCall == nil {
+		fake.beginReturnsOnCall = make(map[int]struct {
+			result1 db.Tx
+			result2 error
+		})
+	}
+	fake.beginReturnsOnCall[i] = struct {
+		result1 db.Tx
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReplicaConn) Bus() db.NotificationsBus {
+	fake.busMutex.Lock()
+	ret, specificReturn := fake.busReturnsOnCall[len(fake.busArgsForCall)]
+	fake.busArgsForCall = append(fake.busArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Bus", []interface{}{})
+	fake.busMutex.Unlock()
+	if fake.BusStub != nil {
+		return fake.BusStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.busReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) BusCallCount() int {
+	fake.busMutex.RLock()
+	defer fake.busMutex.RUnlock()
+	return len(fake.busArgsForCall)
+}
+
+func (fake *FakeReplicaConn) BusCalls(stub func() db.NotificationsBus) {
+	fake.busMutex.Lock()
+	defer fake.busMutex.Unlock()
+	fake.BusStub = stub
+}
+
+func (fake *FakeReplicaConn) BusReturns(result1 db.NotificationsBus) {
+	fake.busMutex.Lock()
+	defer fake.busMutex.Unlock()
+	fake.BusStub = nil
+	fake.busReturns = struct {
+		result1 db.NotificationsBus
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) BusReturnsOnCall(i int, result1 db.NotificationsBus) {
+	fake.busMutex.Lock()
+	defer fake.busMutex.Unlock()
+	fake.BusStub = nil
+	if fake.busReturnsOnCall == nil {
+		fake.busReturnsOnCall = make(map[int]struct {
+			result1 db.NotificationsBus
+		})
+	}
+	fake.busReturnsOnCall[i] = struct {
+		result1 db.NotificationsBus
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if fake.CloseStub != nil {
+		return fake.CloseStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.closeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeReplicaConn) CloseCalls(stub func() error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *FakeReplicaConn) CloseReturns(result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) CloseReturnsOnCall(i int, result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) Driver() driver.Driver {
+	fake.driverMutex.Lock()
+	ret, specificReturn := fake.driverReturnsOnCall[len(fake.driverArgsForCall)]
+	fake.driverArgsForCall = append(fake.driverArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Driver", []interface{}{})
+	fake.driverMutex.Unlock()
+	if fake.DriverStub != nil {
+		return fake.DriverStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.driverReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) DriverCallCount() int {
+	fake.driverMutex.RLock()
+	defer fake.driverMutex.RUnlock()
+	return len(fake.driverArgsForCall)
+}
+
+func (fake *FakeReplicaConn) DriverCalls(stub func() driver.Driver) {
+	fake.driverMutex.Lock()
+	defer fake.driverMutex.Unlock()
+	fake.DriverStub = stub
+}
+
+func (fake *FakeReplicaConn) DriverReturns(result1 driver.Driver) {
+	fake.driverMutex.Lock()
+	defer fake.driverMutex.Unlock()
+	fake.DriverStub = nil
+	fake.driverReturns = struct {
+		result1 driver.Driver
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) DriverReturnsOnCall(i int, result1 driver.Driver) {
+	fake.driverMutex.Lock()
+	defer fake.driverMutex.Unlock()
+	fake.DriverStub = nil
+	if fake.driverReturnsOnCall == nil {
+		fake.driverReturnsOnCall = make(map[int]struct {
+			result1 driver.Driver
+		})
+	}
+	fake.driverReturnsOnCall[i] = struct {
+		result1 driver.Driver
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) EncryptionStrategy() encryption.Strategy {
+	fake.encryptionStrategyMutex.Lock()
+	ret, specificReturn := fake.encryptionStrategyReturnsOnCall[len(fake.encryptionStrategyArgsForCall)]
+	fake.encryptionStrategyArgsForCall = append(fake.encryptionStrategyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EncryptionStrategy", []interface{}{})
+	fake.encryptionStrategyMutex.Unlock()
+	if fake.EncryptionStrategyStub != nil {
+		return fake.EncryptionStrategyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.encryptionStrategyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) EncryptionStrategyCallCount() int {
+	fake.encryptionStrategyMutex.RLock()
+	defer fake.encryptionStrategyMutex.RUnlock()
+	return len(fake.encryptionStrategyArgsForCall)
+}
+
+func (fake *FakeReplicaConn) EncryptionStrategyCalls(stub func() encryption.Strategy) {
+	fake.encryptionStrategyMutex.Lock()
+	defer fake.encryptionStrategyMutex.Unlock()
+	fake.EncryptionStrategyStub = stub
+}
+
+func (fake *FakeReplicaConn) EncryptionStrategyReturns(result1 encryption.Strategy) {
+	fake.encryptionStrategyMutex.Lock()
+	defer fake.encryptionStrategyMutex.Unlock()
+	fake.EncryptionStrategyStub = nil
+	fake.encryptionStrategyReturns = struct {
+		result1 encryption.Strategy
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) EncryptionStrategyReturnsOnCall(i int, result1 encryption.Strategy) {
+	fake.encryptionStrategyMutex.Lock()
+	defer fake.encryptionStrategyMutex.Unlock()
+	fake.EncryptionStrategyStub = nil
+	if fake.encryptionStrategyReturnsOnCall == nil {
+		fake.encryptionStrategyReturnsOnCall = make(map[int]struct {
+			result1 encryption.Strategy
+		})
+	}
+	fake.encryptionStrategyReturnsOnCall[i] = struct {
+		result1 encryption.Strategy
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) Exec(arg1 string, arg2 ...interface{}) (sql.Result, error) {
+	fake.execMutex.Lock()
+	ret, specificReturn := fake.execReturnsOnCall[len(fake.execArgsForCall)]
+	fake.execArgsForCall = append(fake.execArgsForCall, struct {
+		arg1 string
+		arg2 []interface{}
+	}{arg1, arg2})
+	fake.recordInvocation("Exec", []interface{}{arg1, arg2})
+	fake.execMutex.Unlock()
+	if fake.ExecStub != nil {
+		return fake.ExecStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.execReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReplicaConn) ExecCallCount() int {
+	fake.execMutex.RLock()
+	defer fake.execMutex.RUnlock()
+	return len(fake.execArgsForCall)
+}
+
+func (fake *FakeReplicaConn) ExecCalls(stub func(string, ...interface{}) (sql.Result, error)) {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.ExecStub = stub
+}
+
+func (fake *FakeReplicaConn) ExecArgsForCall(i int) (string, []interface{}) {
+	fake.execMutex.RLock()
+	defer fake.execMutex.RUnlock()
+	argsForCall := fake.execArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReplicaConn) ExecReturns(result1 sql.Result, result2 error) {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.ExecStub = nil
+	fake.execReturns = struct {
+		result1 sql.Result
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReplicaConn) ExecReturnsOnCall(i int, result1 sql.Result, result2 error) {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.ExecStub = nil
+	if fake.execReturnsOnCall == nil {
+		fake.execReturnsOnCall = make(map[int]struct {
+			result1 sql.Result
+			result2 error
+		})
+	}
+	fake.execReturnsOnCall[i] = struct {
+		result1 sql.Result
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReplicaConn) Name() string {
+	fake.nameMutex.Lock()
+	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
+	fake.nameArgsForCall = append(fake.nameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Name", []interface{}{})
+	fake.nameMutex.Unlock()
+	if fake.NameStub != nil {
+		return fake.NameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.nameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) NameCallCount() int {
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	return len(fake.nameArgsForCall)
+}
+
+func (fake *FakeReplicaConn) NameCalls(stub func() string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = stub
+}
+
+func (fake *FakeReplicaConn) NameReturns(result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	fake.nameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) NameReturnsOnCall(i int, result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	if fake.nameReturnsOnCall == nil {
+		fake.nameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.nameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) Ping() error {
+	fake.pingMutex.Lock()
+	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
+	fake.pingArgsForCall = append(fake.pingArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Ping", []interface{}{})
+	fake.pingMutex.Unlock()
+	if fake.PingStub != nil {
+		return fake.PingStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pingReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) PingCallCount() int {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	return len(fake.pingArgsForCall)
+}
+
+func (fake *FakeReplicaConn) PingCalls(stub func() error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = stub
+}
+
+func (fake *FakeReplicaConn) PingReturns(result1 error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	fake.pingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) PingReturnsOnCall(i int, result1 error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	if fake.pingReturnsOnCall == nil {
+		fake.pingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) Prepare(arg1 string) (*sql.Stmt, error) {
+	fake.prepareMutex.Lock()
+	ret, specificReturn := fake.prepareReturnsOnCall[len(fake.prepareArgsForCall)]
+	fake.prepareArgsForCall = append(fake.prepareArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Prepare", []interface{}{arg1})
+	fake.prepareMutex.Unlock()
+	if fake.PrepareStub != nil {
+		return fake.PrepareStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.prepareReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReplicaConn) PrepareCallCount() int {
+	fake.prepareMutex.RLock()
+	defer fake.prepareMutex.RUnlock()
+	return len(fake.prepareArgsForCall)
+}
+
+func (fake *FakeReplicaConn) PrepareCalls(stub func(string) (*sql.Stmt, error)) {
+	fake.prepareMutex.Lock()
+	defer fake.prepareMutex.Unlock()
+	fake.PrepareStub = stub
+}
+
+func (fake *FakeReplicaConn) PrepareArgsForCall(i int) string {
+	fake.prepareMutex.RLock()
+	defer fake.prepareMutex.RUnlock()
+	argsForCall := fake.prepareArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReplicaConn) PrepareReturns(result1 *sql.Stmt, result2 error) {
+	fake.prepareMutex.Lock()
+	defer fake.prepareMutex.Unlock()
+	fake.PrepareStub = nil
+	fake.prepareReturns = struct {
+		result1 *sql.Stmt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReplicaConn) PrepareReturnsOnCall(i int, result1 *sql.Stmt, result2 error) {
+	fake.prepareMutex.Lock()
+	defer fake.prepareMutex.Unlock()
+	fake.PrepareStub = nil
+	if fake.prepareReturnsOnCall == nil {
+		fake.prepareReturnsOnCall = make(map[int]struct {
+			result1 *sql.Stmt
+			result2 error
+		})
+	}
+	fake.prepareReturnsOnCall[i] = struct {
+		result1 *sql.Stmt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReplicaConn) Query(arg1 string, arg2 ...interface{}) (*sql.Rows, error) {
+	fake.queryMutex.Lock()
+	ret, specificReturn := fake.queryReturnsOnCall[len(fake.queryArgsForCall)]
+	fake.queryArgsForCall = append(fake.queryArgsForCall, struct {
+		arg1 string
+		arg2 []interface{}
+	}{arg1, arg2})
+	fake.recordInvocation("Query", []interface{}{arg1, arg2})
+	fake.queryMutex.Unlock()
+	if fake.QueryStub != nil {
+		return fake.QueryStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.queryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReplicaConn) QueryCallCount() int {
+	fake.queryMutex.RLock()
+	defer fake.queryMutex.RUnlock()
+	return len(fake.queryArgsForCall)
+}
+
+func (fake *FakeReplicaConn) QueryCalls(stub func(string, ...interface{}) (*sql.Rows, error)) {
+	fake.queryMutex.Lock()
+	defer fake.queryMutex.Unlock()
+	fake.QueryStub = stub
+}
+
+func (fake *FakeReplicaConn) QueryArgsForCall(i int) (string, []interface{}) {
+	fake.queryMutex.RLock()
+	defer fake.queryMutex.RUnlock()
+	argsForCall := fake.queryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReplicaConn) QueryReturns(result1 *sql.Rows, result2 error) {
+	fake.queryMutex.Lock()
+	defer fake.queryMutex.Unlock()
+	fake.QueryStub = nil
+	fake.queryReturns = struct {
+		result1 *sql.Rows
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReplicaConn) QueryReturnsOnCall(i int, result1 *sql.Rows, result2 error) {
+	fake.queryMutex.Lock()
+	defer fake.queryMutex.Unlock()
+	fake.QueryStub = nil
+	if fake.queryReturnsOnCall == nil {
+		fake.queryReturnsOnCall = make(map[int]struct {
+			result1 *sql.Rows
+			result2 error
+		})
+	}
+	fake.queryReturnsOnCall[i] = struct {
+		result1 *sql.Rows
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReplicaConn) QueryRow(arg1 string, arg2 ...interface{}) squirrel.RowScanner {
+	fake.queryRowMutex.Lock()
+	ret, specificReturn := fake.queryRowReturnsOnCall[len(fake.queryRowArgsForCall)]
+	fake.queryRowArgsForCall = append(fake.queryRowArgsForCall, struct {
+		arg1 string
+		arg2 []interface{}
+	}{arg1, arg2})
+	fake.recordInvocation("QueryRow", []interface{}{arg1, arg2})
+	fake.queryRowMutex.Unlock()
+	if fake.QueryRowStub != nil {
+		return fake.QueryRowStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.queryRowReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) QueryRowCallCount() int {
+	fake.queryRowMutex.RLock()
+	defer fake.queryRowMutex.RUnlock()
+	return len(fake.queryRowArgsForCall)
+}
+
+func (fake *FakeReplicaConn) QueryRowCalls(stub func(string, ...interface{}) squirrel.RowScanner) {
+	fake.queryRowMutex.Lock()
+	defer fake.queryRowMutex.Unlock()
+	fake.QueryRowStub = stub
+}
+
+func (fake *FakeReplicaConn) QueryRowArgsForCall(i int) (string, []interface{}) {
+	fake.queryRowMutex.RLock()
+	defer fake.queryRowMutex.RUnlock()
+	argsForCall := fake.queryRowArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReplicaConn) QueryRowReturns(result1 squirrel.RowScanner) {
+	fake.queryRowMutex.Lock()
+	defer fake.queryRowMutex.Unlock()
+	fake.QueryRowStub = nil
+	fake.queryRowReturns = struct {
+		result1 squirrel.RowScanner
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) QueryRowReturnsOnCall(i int, result1 squirrel.RowScanner) {
+	fake.queryRowMutex.Lock()
+	defer fake.queryRowMutex.Unlock()
+	fake.QueryRowStub = nil
+	if fake.queryRowReturnsOnCall == nil {
+		fake.queryRowReturnsOnCall = make(map[int]struct {
+			result1 squirrel.RowScanner
+		})
+	}
+	fake.queryRowReturnsOnCall[i] = struct {
+		result1 squirrel.RowScanner
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) ReplicaLags() []db.ReplicaLag {
+	fake.replicaLagsMutex.Lock()
+	ret, specificReturn := fake.replicaLagsReturnsOnCall[len(fake.replicaLagsArgsForCall)]
+	fake.replicaLagsArgsForCall = append(fake.replicaLagsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReplicaLags", []interface{}{})
+	fake.replicaLagsMutex.Unlock()
+	if fake.ReplicaLagsStub != nil {
+		return fake.ReplicaLagsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.replicaLagsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) ReplicaLagsCallCount() int {
+	fake.replicaLagsMutex.RLock()
+	defer fake.replicaLagsMutex.RUnlock()
+	return len(fake.replicaLagsArgsForCall)
+}
+
+func (fake *FakeReplicaConn) ReplicaLagsCalls(stub func() []db.ReplicaLag) {
+	fake.replicaLagsMutex.Lock()
+	defer fake.replicaLagsMutex.Unlock()
+	fake.ReplicaLagsStub = stub
+}
+
+func (fake *FakeReplicaConn) ReplicaLagsReturns(result1 []db.ReplicaLag) {
+	fake.replicaLagsMutex.Lock()
+	defer fake.replicaLagsMutex.Unlock()
+	fake.ReplicaLagsStub = nil
+	fake.replicaLagsReturns = struct {
+		result1 []db.ReplicaLag
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) ReplicaLagsReturnsOnCall(i int, result1 []db.ReplicaLag) {
+	fake.replicaLagsMutex.Lock()
+	defer fake.replicaLagsMutex.Unlock()
+	fake.ReplicaLagsStub = nil
+	if fake.replicaLagsReturnsOnCall == nil {
+		fake.replicaLagsReturnsOnCall = make(map[int]struct {
+			result1 []db.ReplicaLag
+		})
+	}
+	fake.replicaLagsReturnsOnCall[i] = struct {
+		result1 []db.ReplicaLag
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) SetMaxIdleConns(arg1 int) {
+	fake.setMaxIdleConnsMutex.Lock()
+	fake.setMaxIdleConnsArgsForCall = append(fake.setMaxIdleConnsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("SetMaxIdleConns", []interface{}{arg1})
+	fake.setMaxIdleConnsMutex.Unlock()
+	if fake.SetMaxIdleConnsStub != nil {
+		fake.SetMaxIdleConnsStub(arg1)
+	}
+}
+
+func (fake *FakeReplicaConn) SetMaxIdleConnsCallCount() int {
+	fake.setMaxIdleConnsMutex.RLock()
+	defer fake.setMaxIdleConnsMutex.RUnlock()
+	return len(fake.setMaxIdleConnsArgsForCall)
+}
+
+func (fake *FakeReplicaConn) SetMaxIdleConnsCalls(stub func(int)) {
+	fake.setMaxIdleConnsMutex.Lock()
+	defer fake.setMaxIdleConnsMutex.Unlock()
+	fake.SetMaxIdleConnsStub = stub
+}
+
+func (fake *FakeReplicaConn) SetMaxIdleConnsArgsForCall(i int) int {
+	fake.setMaxIdleConnsMutex.RLock()
+	defer fake.setMaxIdleConnsMutex.RUnlock()
+	argsForCall := fake.setMaxIdleConnsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReplicaConn) SetMaxOpenConns(arg1 int) {
+	fake.setMaxOpenConnsMutex.Lock()
+	fake.setMaxOpenConnsArgsForCall = append(fake.setMaxOpenConnsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("SetMaxOpenConns", []interface{}{arg1})
+	fake.setMaxOpenConnsMutex.Unlock()
+	if fake.SetMaxOpenConnsStub != nil {
+		fake.SetMaxOpenConnsStub(arg1)
+	}
+}
+
+func (fake *FakeReplicaConn) SetMaxOpenConnsCallCount() int {
+	fake.setMaxOpenConnsMutex.RLock()
+	defer fake.setMaxOpenConnsMutex.RUnlock()
+	return len(fake.setMaxOpenConnsArgsForCall)
+}
+
+func (fake *FakeReplicaConn) SetMaxOpenConnsCalls(stub func(int)) {
+	fake.setMaxOpenConnsMutex.Lock()
+	defer fake.setMaxOpenConnsMutex.Unlock()
+	fake.SetMaxOpenConnsStub = stub
+}
+
+func (fake *FakeReplicaConn) SetMaxOpenConnsArgsForCall(i int) int {
+	fake.setMaxOpenConnsMutex.RLock()
+	defer fake.setMaxOpenConnsMutex.RUnlock()
+	argsForCall := fake.setMaxOpenConnsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReplicaConn) Stats() sql.DBStats {
+	fake.statsMutex.Lock()
+	ret, specificReturn := fake.statsReturnsOnCall[len(fake.statsArgsForCall)]
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Stats", []interface{}{})
+	fake.statsMutex.Unlock()
+	if fake.StatsStub != nil {
+		return fake.StatsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.statsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaConn) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeReplicaConn) StatsCalls(stub func() sql.DBStats) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = stub
+}
+
+func (fake *FakeReplicaConn) StatsReturns(result1 sql.DBStats) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 sql.DBStats
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) StatsReturnsOnCall(i int, result1 sql.DBStats) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = nil
+	if fake.statsReturnsOnCall == nil {
+		fake.statsReturnsOnCall = make(map[int]struct {
+			result1 sql.DBStats
+		})
+	}
+	fake.statsReturnsOnCall[i] = struct {
+		result1 sql.DBStats
+	}{result1}
+}
+
+func (fake *FakeReplicaConn) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.beginMutex.RLock()
+	defer fake.beginMutex.RUnlock()
+	fake.busMutex.RLock()
+	defer fake.busMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	fake.driverMutex.RLock()
+	defer fake.driverMutex.RUnlock()
+	fake.encryptionStrategyMutex.RLock()
+	defer fake.encryptionStrategyMutex.RUnlock()
+	fake.execMutex.RLock()
+	defer fake.execMutex.RUnlock()
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	fake.prepareMutex.RLock()
+	defer fake.prepareMutex.RUnlock()
+	fake.queryMutex.RLock()
+	defer fake.queryMutex.RUnlock()
+	fake.queryRowMutex.RLock()
+	defer fake.queryRowMutex.RUnlock()
+	fake.replicaLagsMutex.RLock()
+	defer fake.replicaLagsMutex.RUnlock()
+	fake.setMaxIdleConnsMutex.RLock()
+	defer fake.setMaxIdleConnsMutex.RUnlock()
+	fake.setMaxOpenConnsMutex.RLock()
+	defer fake.setMaxOpenConnsMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReplicaConn) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ReplicaConn = new(FakeReplicaConn)
@@ -20,16 +20,19 @@ type FakeResourceCacheLifecycle struct {
 	cleanBuildImageResourceCachesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	CleanUpInvalidCachesStub        func(lager.Logger) error
+	CleanUpInvalidCachesStub        func(lager.Logger, db.ResourceCacheRetention) (int, error)
 	cleanUpInvalidCachesMutex       sync.RWMutex
 	cleanUpInvalidCachesArgsForCall []struct {
 		arg1 lager.Logger
+		arg2 db.ResourceCacheRetention
 	}
 	cleanUpInvalidCachesReturns struct {
-		result1 error
+		result1 int
+		result2 error
 	}
 	cleanUpInvalidCachesReturnsOnCall map[int]struct {
-		result1 error
+		result1 int
+		result2 error
 	}
 	CleanUsesForFinishedBuildsStub        func(lager.Logger) error
 	cleanUsesForFinishedBuildsMutex       sync.RWMutex
@@ -42,6 +45,20 @@ type FakeResourceCacheLifecycle struct {
 	cleanUsesForFinishedBuildsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FindInvalidCachesStub        func(lager.Logger, db.ResourceCacheRetention) ([]int, error)
+	findInvalidCachesMutex       sync.RWMutex
+	findInvalidCachesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.ResourceCacheRetention
+	}
+	findInvalidCachesReturns struct {
+		result1 []int
+		result2 error
+	}
+	findInvalidCachesReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -106,22 +123,23 @@ func (fake *FakeResourceCacheLifecycle) CleanBuildImageResourceCachesReturnsOnCa
 	}{result1}
 }
 
-func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCaches(arg1 lager.Logger) error {
+func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCaches(arg1 lager.Logger, arg2 db.ResourceCacheRetention) (int, error) {
 	fake.cleanUpInvalidCachesMutex.Lock()
 	ret, specificReturn := fake.cleanUpInvalidCachesReturnsOnCall[len(fake.cleanUpInvalidCachesArgsForCall)]
 	fake.cleanUpInvalidCachesArgsForCall = append(fake.cleanUpInvalidCachesArgsForCall, struct {
 		arg1 lager.Logger
-	}{arg1})
-	fake.recordInvocation("CleanUpInvalidCaches", []interface{}{arg1})
+		arg2 db.ResourceCacheRetention
+	}{arg1, arg2})
+	fake.recordInvocation("CleanUpInvalidCaches", []interface{}{arg1, arg2})
 	fake.cleanUpInvalidCachesMutex.Unlock()
 	if fake.CleanUpInvalidCachesStub != nil {
-		return fake.CleanUpInvalidCachesStub(arg1)
+		return fake.CleanUpInvalidCachesStub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
 	fakeReturns := fake.cleanUpInvalidCachesReturns
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesCallCount() int {
@@ -130,40 +148,43 @@ func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesCallCount() int {
 	return len(fake.cleanUpInvalidCachesArgsForCall)
 }
 
-func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesCalls(stub func(lager.Logger) error) {
+func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesCalls(stub func(lager.Logger, db.ResourceCacheRetention) (int, error)) {
 	fake.cleanUpInvalidCachesMutex.Lock()
 	defer fake.cleanUpInvalidCachesMutex.Unlock()
 	fake.CleanUpInvalidCachesStub = stub
 }
 
-func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesArgsForCall(i int) lager.Logger {
+func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesArgsForCall(i int) (lager.Logger, db.ResourceCacheRetention) {
 	fake.cleanUpInvalidCachesMutex.RLock()
 	defer fake.cleanUpInvalidCachesMutex.RUnlock()
 	argsForCall := fake.cleanUpInvalidCachesArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesReturns(result1 error) {
+func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesReturns(result1 int, result2 error) {
 	fake.cleanUpInvalidCachesMutex.Lock()
 	defer fake.cleanUpInvalidCachesMutex.Unlock()
 	fake.CleanUpInvalidCachesStub = nil
 	fake.cleanUpInvalidCachesReturns = struct {
-		result1 error
-	}{result1}
+		result1 int
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesReturnsOnCall(i int, result1 error) {
+func (fake *FakeResourceCacheLifecycle) CleanUpInvalidCachesReturnsOnCall(i int, result1 int, result2 error) {
 	fake.cleanUpInvalidCachesMutex.Lock()
 	defer fake.cleanUpInvalidCachesMutex.Unlock()
 	fake.CleanUpInvalidCachesStub = nil
 	if fake.cleanUpInvalidCachesReturnsOnCall == nil {
 		fake.cleanUpInvalidCachesReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 int
+			result2 error
 		})
 	}
 	fake.cleanUpInvalidCachesReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 int
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeResourceCacheLifecycle) CleanUsesForFinishedBuilds(arg1 lager.Logger) error {
@@ -226,6 +247,70 @@ func (fake *FakeResourceCacheLifecycle) CleanUsesForFinishedBuildsReturnsOnCall(
 	}{result1}
 }
 
+func (fake *FakeResourceCacheLifecycle) FindInvalidCaches(arg1 lager.Logger, arg2 db.ResourceCacheRetention) ([]int, error) {
+	fake.findInvalidCachesMutex.Lock()
+	ret, specificReturn := fake.findInvalidCachesReturnsOnCall[len(fake.findInvalidCachesArgsForCall)]
+	fake.findInvalidCachesArgsForCall = append(fake.findInvalidCachesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.ResourceCacheRetention
+	}{arg1, arg2})
+	fake.recordInvocation("FindInvalidCaches", []interface{}{arg1, arg2})
+	fake.findInvalidCachesMutex.Unlock()
+	if fake.FindInvalidCachesStub != nil {
+		return fake.FindInvalidCachesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findInvalidCachesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceCacheLifecycle) FindInvalidCachesCallCount() int {
+	fake.findInvalidCachesMutex.RLock()
+	defer fake.findInvalidCachesMutex.RUnlock()
+	return len(fake.findInvalidCachesArgsForCall)
+}
+
+func (fake *FakeResourceCacheLifecycle) FindInvalidCachesCalls(stub func(lager.Logger, db.ResourceCacheRetention) ([]int, error)) {
+	fake.findInvalidCachesMutex.Lock()
+	defer fake.findInvalidCachesMutex.Unlock()
+	fake.FindInvalidCachesStub = stub
+}
+
+func (fake *FakeResourceCacheLifecycle) FindInvalidCachesArgsForCall(i int) (lager.Logger, db.ResourceCacheRetention) {
+	fake.findInvalidCachesMutex.RLock()
+	defer fake.findInvalidCachesMutex.RUnlock()
+	argsForCall := fake.findInvalidCachesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResourceCacheLifecycle) FindInvalidCachesReturns(result1 []int, result2 error) {
+	fake.findInvalidCachesMutex.Lock()
+	defer fake.findInvalidCachesMutex.Unlock()
+	fake.FindInvalidCachesStub = nil
+	fake.findInvalidCachesReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceCacheLifecycle) FindInvalidCachesReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.findInvalidCachesMutex.Lock()
+	defer fake.findInvalidCachesMutex.Unlock()
+	fake.FindInvalidCachesStub = nil
+	if fake.findInvalidCachesReturnsOnCall == nil {
+		fake.findInvalidCachesReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.findInvalidCachesReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceCacheLifecycle) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -235,6 +320,8 @@ func (fake *FakeResourceCacheLifecycle) Invocations() map[string][][]interface{}
 	defer fake.cleanUpInvalidCachesMutex.RUnlock()
 	fake.cleanUsesForFinishedBuildsMutex.RLock()
 	defer fake.cleanUsesForFinishedBuildsMutex.RUnlock()
+	fake.findInvalidCachesMutex.RLock()
+	defer fake.findInvalidCachesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
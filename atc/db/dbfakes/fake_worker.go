@@ -50,6 +50,48 @@ type FakeWorker struct {
 	certsPathReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	CordonStub        func(string, string) error
+	cordonMutex       sync.RWMutex
+	cordonArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	cordonReturns struct {
+		result1 error
+	}
+	cordonReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CordonReasonStub        func() string
+	cordonReasonMutex       sync.RWMutex
+	cordonReasonArgsForCall []struct {
+	}
+	cordonReasonReturns struct {
+		result1 string
+	}
+	cordonReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
+	CordonedStub        func() bool
+	cordonedMutex       sync.RWMutex
+	cordonedArgsForCall []struct {
+	}
+	cordonedReturns struct {
+		result1 bool
+	}
+	cordonedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	CordonedByStub        func() string
+	cordonedByMutex       sync.RWMutex
+	cordonedByArgsForCall []struct {
+	}
+	cordonedByReturns struct {
+		result1 string
+	}
+	cordonedByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CreateContainerStub        func(db.ContainerOwner, db.ContainerMetadata) (db.CreatingContainer, error)
 	createContainerMutex       sync.RWMutex
 	createContainerArgsForCall []struct {
@@ -139,6 +181,26 @@ type FakeWorker struct {
 	hTTPSProxyURLReturnsOnCall map[int]struct {
 		result1 string
 	}
+	HealthStub        func() *atc.WorkerHealth
+	healthMutex       sync.RWMutex
+	healthArgsForCall []struct {
+	}
+	healthReturns struct {
+		result1 *atc.WorkerHealth
+	}
+	healthReturnsOnCall map[int]struct {
+		result1 *atc.WorkerHealth
+	}
+	LabelsStub        func() map[string]string
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 map[string]string
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	LandStub        func() error
 	landMutex       sync.RWMutex
 	landArgsForCall []struct {
@@ -235,6 +297,18 @@ type FakeWorker struct {
 	retireReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RunningBuildsStub        func() ([]int, error)
+	runningBuildsMutex       sync.RWMutex
+	runningBuildsArgsForCall []struct {
+	}
+	runningBuildsReturns struct {
+		result1 []int
+		result2 error
+	}
+	runningBuildsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	StartTimeStub        func() int64
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -285,6 +359,16 @@ type FakeWorker struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UncordonStub        func() error
+	uncordonMutex       sync.RWMutex
+	uncordonArgsForCall []struct {
+	}
+	uncordonReturns struct {
+		result1 error
+	}
+	uncordonReturnsOnCall map[int]struct {
+		result1 error
+	}
 	VersionStub        func() *string
 	versionMutex       sync.RWMutex
 	versionArgsForCall []struct {
@@ -507,6 +591,223 @@ func (fake *FakeWorker) CertsPathReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Cordon(arg1 string, arg2 string) error {
+	fake.cordonMutex.Lock()
+	ret, specificReturn := fake.cordonReturnsOnCall[len(fake.cordonArgsForCall)]
+	fake.cordonArgsForCall = append(fake.cordonArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Cordon", []interface{}{arg1, arg2})
+	fake.cordonMutex.Unlock()
+	if fake.CordonStub != nil {
+		return fake.CordonStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CordonCallCount() int {
+	fake.cordonMutex.RLock()
+	defer fake.cordonMutex.RUnlock()
+	return len(fake.cordonArgsForCall)
+}
+
+func (fake *FakeWorker) CordonCalls(stub func(string, string) error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = stub
+}
+
+func (fake *FakeWorker) CordonArgsForCall(i int) (string, string) {
+	fake.cordonMutex.RLock()
+	defer fake.cordonMutex.RUnlock()
+	argsForCall := fake.cordonArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorker) CordonReturns(result1 error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = nil
+	fake.cordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonReturnsOnCall(i int, result1 error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = nil
+	if fake.cordonReturnsOnCall == nil {
+		fake.cordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonReason() string {
+	fake.cordonReasonMutex.Lock()
+	ret, specificReturn := fake.cordonReasonReturnsOnCall[len(fake.cordonReasonArgsForCall)]
+	fake.cordonReasonArgsForCall = append(fake.cordonReasonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CordonReason", []interface{}{})
+	fake.cordonReasonMutex.Unlock()
+	if fake.CordonReasonStub != nil {
+		return fake.CordonReasonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonReasonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CordonReasonCallCount() int {
+	fake.cordonReasonMutex.RLock()
+	defer fake.cordonReasonMutex.RUnlock()
+	return len(fake.cordonReasonArgsForCall)
+}
+
+func (fake *FakeWorker) CordonReasonCalls(stub func() string) {
+	fake.cordonReasonMutex.Lock()
+	defer fake.cordonReasonMutex.Unlock()
+	fake.CordonReasonStub = stub
+}
+
+func (fake *FakeWorker) CordonReasonReturns(result1 string) {
+	fake.cordonReasonMutex.Lock()
+	defer fake.cordonReasonMutex.Unlock()
+	fake.CordonReasonStub = nil
+	fake.cordonReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonReasonReturnsOnCall(i int, result1 string) {
+	fake.cordonReasonMutex.Lock()
+	defer fake.cordonReasonMutex.Unlock()
+	fake.CordonReasonStub = nil
+	if fake.cordonReasonReturnsOnCall == nil {
+		fake.cordonReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cordonReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) Cordoned() bool {
+	fake.cordonedMutex.Lock()
+	ret, specificReturn := fake.cordonedReturnsOnCall[len(fake.cordonedArgsForCall)]
+	fake.cordonedArgsForCall = append(fake.cordonedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Cordoned", []interface{}{})
+	fake.cordonedMutex.Unlock()
+	if fake.CordonedStub != nil {
+		return fake.CordonedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CordonedCallCount() int {
+	fake.cordonedMutex.RLock()
+	defer fake.cordonedMutex.RUnlock()
+	return len(fake.cordonedArgsForCall)
+}
+
+func (fake *FakeWorker) CordonedCalls(stub func() bool) {
+	fake.cordonedMutex.Lock()
+	defer fake.cordonedMutex.Unlock()
+	fake.CordonedStub = stub
+}
+
+func (fake *FakeWorker) CordonedReturns(result1 bool) {
+	fake.cordonedMutex.Lock()
+	defer fake.cordonedMutex.Unlock()
+	fake.CordonedStub = nil
+	fake.cordonedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonedReturnsOnCall(i int, result1 bool) {
+	fake.cordonedMutex.Lock()
+	defer fake.cordonedMutex.Unlock()
+	fake.CordonedStub = nil
+	if fake.cordonedReturnsOnCall == nil {
+		fake.cordonedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.cordonedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonedBy() string {
+	fake.cordonedByMutex.Lock()
+	ret, specificReturn := fake.cordonedByReturnsOnCall[len(fake.cordonedByArgsForCall)]
+	fake.cordonedByArgsForCall = append(fake.cordonedByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CordonedBy", []interface{}{})
+	fake.cordonedByMutex.Unlock()
+	if fake.CordonedByStub != nil {
+		return fake.CordonedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonedByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CordonedByCallCount() int {
+	fake.cordonedByMutex.RLock()
+	defer fake.cordonedByMutex.RUnlock()
+	return len(fake.cordonedByArgsForCall)
+}
+
+func (fake *FakeWorker) CordonedByCalls(stub func() string) {
+	fake.cordonedByMutex.Lock()
+	defer fake.cordonedByMutex.Unlock()
+	fake.CordonedByStub = stub
+}
+
+func (fake *FakeWorker) CordonedByReturns(result1 string) {
+	fake.cordonedByMutex.Lock()
+	defer fake.cordonedByMutex.Unlock()
+	fake.CordonedByStub = nil
+	fake.cordonedByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonedByReturnsOnCall(i int, result1 string) {
+	fake.cordonedByMutex.Lock()
+	defer fake.cordonedByMutex.Unlock()
+	fake.CordonedByStub = nil
+	if fake.cordonedByReturnsOnCall == nil {
+		fake.cordonedByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cordonedByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeWorker) CreateContainer(arg1 db.ContainerOwner, arg2 db.ContainerMetadata) (db.CreatingContainer, error) {
 	fake.createContainerMutex.Lock()
 	ret, specificReturn := fake.createContainerReturnsOnCall[len(fake.createContainerArgsForCall)]
@@ -949,6 +1250,110 @@ func (fake *FakeWorker) HTTPSProxyURLReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Health() *atc.WorkerHealth {
+	fake.healthMutex.Lock()
+	ret, specificReturn := fake.healthReturnsOnCall[len(fake.healthArgsForCall)]
+	fake.healthArgsForCall = append(fake.healthArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Health", []interface{}{})
+	fake.healthMutex.Unlock()
+	if fake.HealthStub != nil {
+		return fake.HealthStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.healthReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) HealthCallCount() int {
+	fake.healthMutex.RLock()
+	defer fake.healthMutex.RUnlock()
+	return len(fake.healthArgsForCall)
+}
+
+func (fake *FakeWorker) HealthCalls(stub func() *atc.WorkerHealth) {
+	fake.healthMutex.Lock()
+	defer fake.healthMutex.Unlock()
+	fake.HealthStub = stub
+}
+
+func (fake *FakeWorker) HealthReturns(result1 *atc.WorkerHealth) {
+	fake.healthMutex.Lock()
+	defer fake.healthMutex.Unlock()
+	fake.HealthStub = nil
+	fake.healthReturns = struct {
+		result1 *atc.WorkerHealth
+	}{result1}
+}
+
+func (fake *FakeWorker) HealthReturnsOnCall(i int, result1 *atc.WorkerHealth) {
+	fake.healthMutex.Lock()
+	defer fake.healthMutex.Unlock()
+	fake.HealthStub = nil
+	if fake.healthReturnsOnCall == nil {
+		fake.healthReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerHealth
+		})
+	}
+	fake.healthReturnsOnCall[i] = struct {
+		result1 *atc.WorkerHealth
+	}{result1}
+}
+
+func (fake *FakeWorker) Labels() map[string]string {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if fake.LabelsStub != nil {
+		return fake.LabelsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.labelsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsCalls(stub func() map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = stub
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakeWorker) Land() error {
 	fake.landMutex.Lock()
 	ret, specificReturn := fake.landReturnsOnCall[len(fake.landArgsForCall)]
@@ -1426,6 +1831,61 @@ func (fake *FakeWorker) RetireReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) RunningBuilds() ([]int, error) {
+	fake.runningBuildsMutex.Lock()
+	ret, specificReturn := fake.runningBuildsReturnsOnCall[len(fake.runningBuildsArgsForCall)]
+	fake.runningBuildsArgsForCall = append(fake.runningBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RunningBuilds", []interface{}{})
+	fake.runningBuildsMutex.Unlock()
+	if fake.RunningBuildsStub != nil {
+		return fake.RunningBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runningBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) RunningBuildsCallCount() int {
+	fake.runningBuildsMutex.RLock()
+	defer fake.runningBuildsMutex.RUnlock()
+	return len(fake.runningBuildsArgsForCall)
+}
+
+func (fake *FakeWorker) RunningBuildsCalls(stub func() ([]int, error)) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = stub
+}
+
+func (fake *FakeWorker) RunningBuildsReturns(result1 []int, result2 error) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = nil
+	fake.runningBuildsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) RunningBuildsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = nil
+	if fake.runningBuildsReturnsOnCall == nil {
+		fake.runningBuildsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.runningBuildsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) StartTime() int64 {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
@@ -1686,6 +2146,58 @@ func (fake *FakeWorker) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Uncordon() error {
+	fake.uncordonMutex.Lock()
+	ret, specificReturn := fake.uncordonReturnsOnCall[len(fake.uncordonArgsForCall)]
+	fake.uncordonArgsForCall = append(fake.uncordonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Uncordon", []interface{}{})
+	fake.uncordonMutex.Unlock()
+	if fake.UncordonStub != nil {
+		return fake.UncordonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uncordonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) UncordonCallCount() int {
+	fake.uncordonMutex.RLock()
+	defer fake.uncordonMutex.RUnlock()
+	return len(fake.uncordonArgsForCall)
+}
+
+func (fake *FakeWorker) UncordonCalls(stub func() error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = stub
+}
+
+func (fake *FakeWorker) UncordonReturns(result1 error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = nil
+	fake.uncordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) UncordonReturnsOnCall(i int, result1 error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = nil
+	if fake.uncordonReturnsOnCall == nil {
+		fake.uncordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Version() *string {
 	fake.versionMutex.Lock()
 	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
@@ -1749,6 +2261,14 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.baggageclaimURLMutex.RUnlock()
 	fake.certsPathMutex.RLock()
 	defer fake.certsPathMutex.RUnlock()
+	fake.cordonMutex.RLock()
+	defer fake.cordonMutex.RUnlock()
+	fake.cordonReasonMutex.RLock()
+	defer fake.cordonReasonMutex.RUnlock()
+	fake.cordonedMutex.RLock()
+	defer fake.cordonedMutex.RUnlock()
+	fake.cordonedByMutex.RLock()
+	defer fake.cordonedByMutex.RUnlock()
 	fake.createContainerMutex.RLock()
 	defer fake.createContainerMutex.RUnlock()
 	fake.deleteMutex.RLock()
@@ -1765,6 +2285,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPProxyURLMutex.RUnlock()
 	fake.hTTPSProxyURLMutex.RLock()
 	defer fake.hTTPSProxyURLMutex.RUnlock()
+	fake.healthMutex.RLock()
+	defer fake.healthMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -1783,6 +2307,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
+	fake.runningBuildsMutex.RLock()
+	defer fake.runningBuildsMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.stateMutex.RLock()
@@ -1793,6 +2319,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.uncordonMutex.RLock()
+	defer fake.uncordonMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
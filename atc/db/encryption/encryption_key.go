@@ -3,6 +3,7 @@ package encryption
 import (
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"io"
 )
@@ -52,3 +53,12 @@ func (e Key) Decrypt(text string, n *string) ([]byte, error) {
 
 	return plaintext, nil
 }
+
+// Fingerprint returns a short, stable identifier for the key which does not
+// reveal the key itself.
+func (e Key) Fingerprint() string {
+	nonce := make([]byte, e.aesgcm.NonceSize())
+	sealed := e.aesgcm.Seal(nil, nonce, []byte("concourse-encryption-key-fingerprint"), nil)
+	sum := sha256.Sum256(sealed)
+	return hex.EncodeToString(sum[:8])
+}
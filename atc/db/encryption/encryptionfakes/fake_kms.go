@@ -0,0 +1,258 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package encryptionfakes
+
+import (
+	sync "sync"
+
+	encryption "github.com/concourse/concourse/atc/db/encryption"
+)
+
+type FakeKMS struct {
+	DecryptStub        func([]byte) ([]byte, error)
+	decryptMutex       sync.RWMutex
+	decryptArgsForCall []struct {
+		arg1 []byte
+	}
+	decryptReturns struct {
+		result1 []byte
+		result2 error
+	}
+	decryptReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	GenerateDataKeyStub        func() ([]byte, []byte, error)
+	generateDataKeyMutex       sync.RWMutex
+	generateDataKeyArgsForCall []struct {
+	}
+	generateDataKeyReturns struct {
+		result1 []byte
+		result2 []byte
+		result3 error
+	}
+	generateDataKeyReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 []byte
+		result3 error
+	}
+	KeyIDStub        func() string
+	keyIDMutex       sync.RWMutex
+	keyIDArgsForCall []struct {
+	}
+	keyIDReturns struct {
+		result1 string
+	}
+	keyIDReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeKMS) Decrypt(arg1 []byte) ([]byte, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.decryptMutex.Lock()
+	ret, specificReturn := fake.decryptReturnsOnCall[len(fake.decryptArgsForCall)]
+	fake.decryptArgsForCall = append(fake.decryptArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	fake.recordInvocation("Decrypt", []interface{}{arg1Copy})
+	fake.decryptMutex.Unlock()
+	if fake.DecryptStub != nil {
+		return fake.DecryptStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.decryptReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKMS) DecryptCallCount() int {
+	fake.decryptMutex.RLock()
+	defer fake.decryptMutex.RUnlock()
+	return len(fake.decryptArgsForCall)
+}
+
+func (fake *FakeKMS) DecryptCalls(stub func([]byte) ([]byte, error)) {
+	fake.decryptMutex.Lock()
+	defer fake.decryptMutex.Unlock()
+	fake.DecryptStub = stub
+}
+
+func (fake *FakeKMS) DecryptArgsForCall(i int) []byte {
+	fake.decryptMutex.RLock()
+	defer fake.decryptMutex.RUnlock()
+	argsForCall := fake.decryptArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKMS) DecryptReturns(result1 []byte, result2 error) {
+	fake.decryptMutex.Lock()
+	defer fake.decryptMutex.Unlock()
+	fake.DecryptStub = nil
+	fake.decryptReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKMS) DecryptReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.decryptMutex.Lock()
+	defer fake.decryptMutex.Unlock()
+	fake.DecryptStub = nil
+	if fake.decryptReturnsOnCall == nil {
+		fake.decryptReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.decryptReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKMS) GenerateDataKey() ([]byte, []byte, error) {
+	fake.generateDataKeyMutex.Lock()
+	ret, specificReturn := fake.generateDataKeyReturnsOnCall[len(fake.generateDataKeyArgsForCall)]
+	fake.generateDataKeyArgsForCall = append(fake.generateDataKeyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GenerateDataKey", []interface{}{})
+	fake.generateDataKeyMutex.Unlock()
+	if fake.GenerateDataKeyStub != nil {
+		return fake.GenerateDataKeyStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.generateDataKeyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeKMS) GenerateDataKeyCallCount() int {
+	fake.generateDataKeyMutex.RLock()
+	defer fake.generateDataKeyMutex.RUnlock()
+	return len(fake.generateDataKeyArgsForCall)
+}
+
+func (fake *FakeKMS) GenerateDataKeyCalls(stub func() ([]byte, []byte, error)) {
+	fake.generateDataKeyMutex.Lock()
+	defer fake.generateDataKeyMutex.Unlock()
+	fake.GenerateDataKeyStub = stub
+}
+
+func (fake *FakeKMS) GenerateDataKeyReturns(result1 []byte, result2 []byte, result3 error) {
+	fake.generateDataKeyMutex.Lock()
+	defer fake.generateDataKeyMutex.Unlock()
+	fake.GenerateDataKeyStub = nil
+	fake.generateDataKeyReturns = struct {
+		result1 []byte
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeKMS) GenerateDataKeyReturnsOnCall(i int, result1 []byte, result2 []byte, result3 error) {
+	fake.generateDataKeyMutex.Lock()
+	defer fake.generateDataKeyMutex.Unlock()
+	fake.GenerateDataKeyStub = nil
+	if fake.generateDataKeyReturnsOnCall == nil {
+		fake.generateDataKeyReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 []byte
+			result3 error
+		})
+	}
+	fake.generateDataKeyReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeKMS) KeyID() string {
+	fake.keyIDMutex.Lock()
+	ret, specificReturn := fake.keyIDReturnsOnCall[len(fake.keyIDArgsForCall)]
+	fake.keyIDArgsForCall = append(fake.keyIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("KeyID", []interface{}{})
+	fake.keyIDMutex.Unlock()
+	if fake.KeyIDStub != nil {
+		return fake.KeyIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.keyIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeKMS) KeyIDCallCount() int {
+	fake.keyIDMutex.RLock()
+	defer fake.keyIDMutex.RUnlock()
+	return len(fake.keyIDArgsForCall)
+}
+
+func (fake *FakeKMS) KeyIDCalls(stub func() string) {
+	fake.keyIDMutex.Lock()
+	defer fake.keyIDMutex.Unlock()
+	fake.KeyIDStub = stub
+}
+
+func (fake *FakeKMS) KeyIDReturns(result1 string) {
+	fake.keyIDMutex.Lock()
+	defer fake.keyIDMutex.Unlock()
+	fake.KeyIDStub = nil
+	fake.keyIDReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeKMS) KeyIDReturnsOnCall(i int, result1 string) {
+	fake.keyIDMutex.Lock()
+	defer fake.keyIDMutex.Unlock()
+	fake.KeyIDStub = nil
+	if fake.keyIDReturnsOnCall == nil {
+		fake.keyIDReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.keyIDReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeKMS) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.decryptMutex.RLock()
+	defer fake.decryptMutex.RUnlock()
+	fake.generateDataKeyMutex.RLock()
+	defer fake.generateDataKeyMutex.RUnlock()
+	fake.keyIDMutex.RLock()
+	defer fake.keyIDMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeKMS) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ encryption.KMS = new(FakeKMS)
@@ -0,0 +1,223 @@
+package encryption
+
+import (
+	"container/list"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+)
+
+var ErrDataIsNotEnvelopeEncrypted = errors.New("failed to decrypt data that is not envelope encrypted")
+
+//go:generate counterfeiter . KMS
+
+// A KMS wraps and unwraps data keys using a master key which never leaves
+// the KMS.
+type KMS interface {
+	// KeyID identifies the master key used to wrap data keys.
+	KeyID() string
+
+	// GenerateDataKey returns a new 256-bit data key, both in plaintext and
+	// wrapped by the master key.
+	GenerateDataKey() ([]byte, []byte, error)
+
+	// Decrypt unwraps a data key previously returned by GenerateDataKey.
+	Decrypt([]byte) ([]byte, error)
+}
+
+// EnvelopeStrategy encrypts data with data keys which are wrapped by a KMS.
+// The wrapped data key is stored alongside the nonce, so that only the KMS
+// is needed to decrypt it.
+//
+// A single data key is used to encrypt data until it expires, and unwrapped
+// data keys are cached in memory so that the KMS is not called for every
+// piece of data.
+type EnvelopeStrategy struct {
+	kms        KMS
+	clock      clock.Clock
+	dataKeyTTL time.Duration
+	cacheSize  int
+
+	lock    sync.Mutex
+	current *dataKey
+	keys    map[string]*list.Element
+	lru     *list.List
+}
+
+type dataKey struct {
+	wrapped   string
+	aead      cipher.AEAD
+	expiresAt time.Time
+}
+
+func NewEnvelopeStrategy(kms KMS, clock clock.Clock, dataKeyTTL time.Duration, cacheSize int) *EnvelopeStrategy {
+	return &EnvelopeStrategy{
+		kms:        kms,
+		clock:      clock,
+		dataKeyTTL: dataKeyTTL,
+		cacheSize:  cacheSize,
+
+		keys: map[string]*list.Element{},
+		lru:  list.New(),
+	}
+}
+
+func (e *EnvelopeStrategy) Encrypt(plaintext []byte) (string, *string, error) {
+	key, err := e.currentKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+
+	ciphertext := key.aead.Seal(nil, nonce, plaintext, nil)
+
+	noncense := key.wrapped + ":" + hex.EncodeToString(nonce)
+
+	return hex.EncodeToString(ciphertext), &noncense, nil
+}
+
+func (e *EnvelopeStrategy) Decrypt(text string, n *string) ([]byte, error) {
+	if n == nil {
+		return nil, ErrDataIsNotEncrypted
+	}
+
+	parts := strings.Split(*n, ":")
+	if len(parts) != 2 {
+		return nil, ErrDataIsNotEnvelopeEncrypted
+	}
+
+	aead, err := e.unwrap(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return plaintext, nil
+}
+
+// Fingerprint identifies the KMS master key.
+func (e *EnvelopeStrategy) Fingerprint() string {
+	sum := sha256.Sum256([]byte("envelope:" + e.kms.KeyID()))
+	return hex.EncodeToString(sum[:8])
+}
+
+func (e *EnvelopeStrategy) currentKey() (*dataKey, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	now := e.clock.Now()
+	if e.current != nil && now.Before(e.current.expiresAt) {
+		return e.current, nil
+	}
+
+	plaintext, wrapped, err := e.kms.GenerateDataKey()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	e.current = &dataKey{
+		wrapped:   base64.StdEncoding.EncodeToString(wrapped),
+		aead:      aead,
+		expiresAt: now.Add(e.dataKeyTTL),
+	}
+
+	e.cache(e.current)
+
+	return e.current, nil
+}
+
+func (e *EnvelopeStrategy) unwrap(wrapped string) (cipher.AEAD, error) {
+	e.lock.Lock()
+	elem, found := e.keys[wrapped]
+	if found {
+		e.lru.MoveToFront(elem)
+	}
+	e.lock.Unlock()
+
+	if found {
+		return elem.Value.(*dataKey).aead, nil
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := e.kms.Decrypt(wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	e.lock.Lock()
+	e.cache(&dataKey{wrapped: wrapped, aead: aead})
+	e.lock.Unlock()
+
+	return aead, nil
+}
+
+// cache must be called with the lock held.
+func (e *EnvelopeStrategy) cache(key *dataKey) {
+	if e.cacheSize <= 0 {
+		return
+	}
+
+	if elem, found := e.keys[key.wrapped]; found {
+		e.lru.MoveToFront(elem)
+		return
+	}
+
+	for e.lru.Len() >= e.cacheSize {
+		oldest := e.lru.Back()
+		e.lru.Remove(oldest)
+		delete(e.keys, oldest.Value.(*dataKey).wrapped)
+	}
+
+	e.keys[key.wrapped] = e.lru.PushFront(key)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
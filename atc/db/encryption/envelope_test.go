@@ -0,0 +1,185 @@
+package encryption_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/encryption/encryptionfakes"
+	"github.com/concourse/concourse/atc/db/encryption/kms"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EnvelopeStrategy", func() {
+	var (
+		fakeKMS   *encryptionfakes.FakeKMS
+		localKMS  *kms.LocalKMS
+		fakeClock *fakeclock.FakeClock
+		cacheSize int
+
+		strategy *encryption.EnvelopeStrategy
+	)
+
+	BeforeEach(func() {
+		block, err := aes.NewCipher([]byte("AES256Key-32Characters1234567890"))
+		Expect(err).ToNot(HaveOccurred())
+
+		aesgcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+
+		localKMS = kms.NewLocalKMS(aesgcm)
+
+		fakeKMS = new(encryptionfakes.FakeKMS)
+		fakeKMS.KeyIDStub = localKMS.KeyID
+		fakeKMS.GenerateDataKeyStub = localKMS.GenerateDataKey
+		fakeKMS.DecryptStub = localKMS.Decrypt
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+		cacheSize = 10
+	})
+
+	JustBeforeEach(func() {
+		strategy = encryption.NewEnvelopeStrategy(fakeKMS, fakeClock, time.Hour, cacheSize)
+	})
+
+	It("encrypts and decrypts plaintext", func() {
+		encryptedText, nonce, err := strategy.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encryptedText).ToNot(BeEmpty())
+		Expect(encryptedText).ToNot(Equal("exampleplaintext"))
+
+		decryptedText, err := strategy.Decrypt(encryptedText, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+	})
+
+	It("stores the wrapped data key alongside the nonce", func() {
+		_, nonce, err := strategy.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.Split(*nonce, ":")).To(HaveLen(2))
+	})
+
+	It("reuses the data key until it expires", func() {
+		_, _, err := strategy.Encrypt([]byte("one"))
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = strategy.Encrypt([]byte("two"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeKMS.GenerateDataKeyCallCount()).To(Equal(1))
+
+		fakeClock.Increment(time.Hour)
+
+		_, _, err = strategy.Encrypt([]byte("three"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeKMS.GenerateDataKeyCallCount()).To(Equal(2))
+	})
+
+	It("does not call the KMS to decrypt with a cached data key", func() {
+		encryptedText, nonce, err := strategy.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = strategy.Decrypt(encryptedText, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeKMS.DecryptCallCount()).To(BeZero())
+	})
+
+	Context("when the data key is not cached", func() {
+		var (
+			encryptedText string
+			nonce         *string
+		)
+
+		BeforeEach(func() {
+			other := encryption.NewEnvelopeStrategy(fakeKMS, fakeClock, time.Hour, cacheSize)
+
+			var err error
+			encryptedText, nonce, err = other.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("unwraps it with the KMS once", func() {
+			decryptedText, err := strategy.Decrypt(encryptedText, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+
+			_, err = strategy.Decrypt(encryptedText, nonce)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeKMS.DecryptCallCount()).To(Equal(1))
+		})
+
+		Context("when the cache is disabled", func() {
+			BeforeEach(func() {
+				cacheSize = 0
+			})
+
+			It("unwraps it with the KMS every time", func() {
+				_, err := strategy.Decrypt(encryptedText, nonce)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = strategy.Decrypt(encryptedText, nonce)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeKMS.DecryptCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the KMS fails to unwrap it", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeKMS.DecryptStub = nil
+				fakeKMS.DecryptReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				_, err := strategy.Decrypt(encryptedText, nonce)
+				Expect(err).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when the KMS fails to generate a data key", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeKMS.GenerateDataKeyStub = nil
+			fakeKMS.GenerateDataKeyReturns(nil, nil, disaster)
+		})
+
+		It("returns the error", func() {
+			_, _, err := strategy.Encrypt([]byte("exampleplaintext"))
+			Expect(err).To(Equal(disaster))
+		})
+	})
+
+	It("fails to decrypt data encrypted with a raw key", func() {
+		block, err := aes.NewCipher([]byte("AES256Key-32Characters0987654321"))
+		Expect(err).ToNot(HaveOccurred())
+
+		aesgcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+
+		encryptedText, nonce, err := encryption.NewKey(aesgcm).Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = strategy.Decrypt(encryptedText, nonce)
+		Expect(err).To(Equal(encryption.ErrDataIsNotEnvelopeEncrypted))
+	})
+
+	It("fails to decrypt data which is not encrypted", func() {
+		_, err := strategy.Decrypt("exampleplaintext", nil)
+		Expect(err).To(Equal(encryption.ErrDataIsNotEncrypted))
+	})
+
+	It("is fingerprinted by the KMS key", func() {
+		Expect(strategy.Fingerprint()).ToNot(BeEmpty())
+
+		fakeKMS.KeyIDStub = nil
+		fakeKMS.KeyIDReturns("some-other-key")
+		Expect(strategy.Fingerprint()).ToNot(Equal(encryption.NewEnvelopeStrategy(localKMS, fakeClock, time.Hour, cacheSize).Fingerprint()))
+	})
+})
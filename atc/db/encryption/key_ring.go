@@ -0,0 +1,85 @@
+package encryption
+
+import "errors"
+
+var ErrDataEncryptedWithUnknownKey = errors.New("failed to decrypt data with any key in the key ring")
+
+// A KeyRing encrypts data with its primary key, and decrypts data encrypted
+// with either the primary key or any of its old keys. This allows data to be
+// re-encrypted with a new key in the background while it is still in use.
+type KeyRing struct {
+	primary Cipher
+	old     []*Key
+}
+
+func NewKeyRing(primary Cipher, old ...*Key) *KeyRing {
+	return &KeyRing{
+		primary: primary,
+		old:     old,
+	}
+}
+
+func (k KeyRing) Encrypt(plaintext []byte) (string, *string, error) {
+	return k.primary.Encrypt(plaintext)
+}
+
+func (k KeyRing) Decrypt(text string, nonce *string) ([]byte, error) {
+	plaintext, _, err := k.decrypt(text, nonce)
+	return plaintext, err
+}
+
+// Rotate re-encrypts data with the primary key if it was encrypted with one
+// of the old keys. It returns false if the data was already encrypted with
+// the primary key.
+func (k KeyRing) Rotate(text string, nonce *string) (string, *string, bool, error) {
+	plaintext, primary, err := k.decrypt(text, nonce)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	if primary {
+		return text, nonce, false, nil
+	}
+
+	encrypted, newNonce, err := k.primary.Encrypt(plaintext)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	return encrypted, newNonce, true, nil
+}
+
+// NeedsRotation returns true if data was encrypted with one of the old keys.
+func (k KeyRing) NeedsRotation(text string, nonce *string) (bool, error) {
+	_, primary, err := k.decrypt(text, nonce)
+	if err != nil {
+		return false, err
+	}
+
+	return !primary, nil
+}
+
+// Fingerprint identifies the primary key.
+func (k KeyRing) Fingerprint() string {
+	return k.primary.Fingerprint()
+}
+
+func (k KeyRing) decrypt(text string, nonce *string) ([]byte, bool, error) {
+	if nonce == nil {
+		return nil, false, ErrDataIsNotEncrypted
+	}
+
+	plaintext, err := k.primary.Decrypt(text, nonce)
+	if err == nil {
+		return plaintext, true, nil
+	}
+
+	for _, key := range k.old {
+		plaintext, err := key.Decrypt(text, nonce)
+		if err == nil {
+			return plaintext, false, nil
+		}
+	}
+
+	return nil, false, ErrDataEncryptedWithUnknownKey
+}
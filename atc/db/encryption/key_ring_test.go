@@ -0,0 +1,139 @@
+package encryption_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KeyRing", func() {
+	var (
+		newKey   *encryption.Key
+		oldKey   *encryption.Key
+		olderKey *encryption.Key
+		otherKey *encryption.Key
+
+		keyRing *encryption.KeyRing
+	)
+
+	makeKey := func(k string) *encryption.Key {
+		block, err := aes.NewCipher([]byte(k))
+		Expect(err).ToNot(HaveOccurred())
+
+		aesgcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+
+		return encryption.NewKey(aesgcm)
+	}
+
+	BeforeEach(func() {
+		newKey = makeKey("AES256Key-32Characters1234567890")
+		oldKey = makeKey("AES256Key-32Characters0987654321")
+		olderKey = makeKey("AES128Key-16Char")
+		otherKey = makeKey("AES256Key-32Characters9564567123")
+
+		keyRing = encryption.NewKeyRing(newKey, oldKey, olderKey)
+	})
+
+	It("encrypts with the primary key", func() {
+		encryptedText, nonce, err := keyRing.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		decryptedText, err := newKey.Decrypt(encryptedText, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+	})
+
+	It("decrypts text encrypted with any of its keys", func() {
+		for _, key := range []*encryption.Key{newKey, oldKey, olderKey} {
+			encryptedText, nonce, err := key.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			decryptedText, err := keyRing.Decrypt(encryptedText, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+		}
+	})
+
+	It("fails to decrypt text encrypted with an unknown key", func() {
+		encryptedText, nonce, err := otherKey.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = keyRing.Decrypt(encryptedText, nonce)
+		Expect(err).To(Equal(encryption.ErrDataEncryptedWithUnknownKey))
+	})
+
+	It("fails to decrypt text which is not encrypted", func() {
+		_, err := keyRing.Decrypt("exampleplaintext", nil)
+		Expect(err).To(Equal(encryption.ErrDataIsNotEncrypted))
+	})
+
+	Describe("Rotate", func() {
+		It("re-encrypts text encrypted with an old key with the primary key", func() {
+			encryptedText, nonce, err := olderKey.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			rotatedText, newNonce, changed, err := keyRing.Rotate(encryptedText, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			decryptedText, err := newKey.Decrypt(rotatedText, newNonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+		})
+
+		It("leaves text encrypted with the primary key as-is", func() {
+			encryptedText, nonce, err := newKey.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			rotatedText, newNonce, changed, err := keyRing.Rotate(encryptedText, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeFalse())
+			Expect(rotatedText).To(Equal(encryptedText))
+			Expect(newNonce).To(Equal(nonce))
+		})
+
+		It("fails to rotate text encrypted with an unknown key", func() {
+			encryptedText, nonce, err := otherKey.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, _, err = keyRing.Rotate(encryptedText, nonce)
+			Expect(err).To(Equal(encryption.ErrDataEncryptedWithUnknownKey))
+		})
+	})
+
+	Describe("NeedsRotation", func() {
+		It("returns true for text encrypted with an old key", func() {
+			encryptedText, nonce, err := olderKey.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(keyRing.NeedsRotation(encryptedText, nonce)).To(BeTrue())
+		})
+
+		It("returns false for text encrypted with the primary key", func() {
+			encryptedText, nonce, err := newKey.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(keyRing.NeedsRotation(encryptedText, nonce)).To(BeFalse())
+		})
+
+		It("fails for text encrypted with an unknown key", func() {
+			encryptedText, nonce, err := otherKey.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = keyRing.NeedsRotation(encryptedText, nonce)
+			Expect(err).To(Equal(encryption.ErrDataEncryptedWithUnknownKey))
+		})
+	})
+
+	Describe("Fingerprint", func() {
+		It("identifies the primary key", func() {
+			Expect(keyRing.Fingerprint()).To(Equal(newKey.Fingerprint()))
+			Expect(keyRing.Fingerprint()).To(Equal(makeKey("AES256Key-32Characters1234567890").Fingerprint()))
+			Expect(keyRing.Fingerprint()).ToNot(Equal(oldKey.Fingerprint()))
+		})
+	})
+})
@@ -0,0 +1,47 @@
+package kms
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awskms "github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// AWSKMS wraps data keys with a customer master key in AWS KMS.
+type AWSKMS struct {
+	client kmsiface.KMSAPI
+	keyID  string
+}
+
+func NewAWSKMS(client kmsiface.KMSAPI, keyID string) *AWSKMS {
+	return &AWSKMS{
+		client: client,
+		keyID:  keyID,
+	}
+}
+
+func (k *AWSKMS) KeyID() string {
+	return "aws:" + k.keyID
+}
+
+func (k *AWSKMS) GenerateDataKey() ([]byte, []byte, error) {
+	output, err := k.client.GenerateDataKey(&awskms.GenerateDataKeyInput{
+		KeyId:   aws.String(k.keyID),
+		KeySpec: aws.String(awskms.DataKeySpecAes256),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return output.Plaintext, output.CiphertextBlob, nil
+}
+
+func (k *AWSKMS) Decrypt(wrapped []byte) ([]byte, error) {
+	output, err := k.client.Decrypt(&awskms.DecryptInput{
+		CiphertextBlob: wrapped,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Plaintext, nil
+}
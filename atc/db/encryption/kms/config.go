@@ -0,0 +1,137 @@
+package kms
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awskms "github.com/aws/aws-sdk-go/service/kms"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/flag"
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+// Config configures envelope encryption with data keys wrapped by a KMS, as
+// an alternative to passing the encryption key itself.
+type Config struct {
+	Provider string `long:"provider" choice:"aws" choice:"vault" choice:"local" description:"KMS used to wrap the data keys which encrypt sensitive information in the database."`
+
+	DataKeyTTL time.Duration `long:"data-key-ttl"   default:"1h"   description:"How long to encrypt data with the same data key before generating a new one."`
+	CacheSize  int           `long:"key-cache-size" default:"1000" description:"Maximum number of unwrapped data keys to cache in memory."`
+
+	AWS struct {
+		Region          string `long:"region"        description:"AWS region of the KMS key."`
+		KeyID           string `long:"key-id"        description:"ID, ARN or alias of the AWS KMS customer master key."`
+		AccessKeyID     string `long:"access-key"    description:"AWS access key ID."`
+		SecretAccessKey string `long:"secret-key"    description:"AWS secret access key."`
+		SessionToken    string `long:"session-token" description:"AWS session token."`
+	} `group:"AWS KMS" namespace:"aws"`
+
+	Vault struct {
+		URL                string `long:"url"         description:"Vault server address."`
+		Token              string `long:"token"       description:"Vault token with access to the transit key."`
+		MountPath          string `long:"mount-path"  default:"transit" description:"Path at which the Transit secrets engine is mounted."`
+		KeyName            string `long:"key-name"    description:"Name of the Transit key used to wrap data keys."`
+		CACert             string `long:"ca-cert"     description:"Path to a PEM-encoded CA cert file to use to verify the vault server SSL cert."`
+		InsecureSkipVerify bool   `long:"insecure-skip-verify" description:"Enable insecure SSL verification."`
+	} `group:"Vault Transit KMS" namespace:"vault"`
+
+	Local struct {
+		MasterKey flag.Cipher `long:"master-key" description:"A 16 or 32 length key used to wrap data keys in memory. For testing only."`
+	} `group:"Local KMS" namespace:"local"`
+}
+
+func (config Config) IsConfigured() bool {
+	return config.Provider != ""
+}
+
+func (config Config) Validate() error {
+	switch config.Provider {
+	case "aws":
+		if config.AWS.KeyID == "" {
+			return errors.New("--encryption-kms-aws-key-id must be specified")
+		}
+
+		if config.AWS.AccessKeyID != "" && config.AWS.SecretAccessKey == "" {
+			return errors.New("--encryption-kms-aws-secret-key must be specified along with --encryption-kms-aws-access-key")
+		}
+	case "vault":
+		if config.Vault.URL == "" {
+			return errors.New("--encryption-kms-vault-url must be specified")
+		}
+
+		if config.Vault.KeyName == "" {
+			return errors.New("--encryption-kms-vault-key-name must be specified")
+		}
+	case "local":
+		if config.Local.MasterKey.AEAD == nil {
+			return errors.New("--encryption-kms-local-master-key must be specified")
+		}
+	}
+
+	return nil
+}
+
+// NewKMS constructs a client for the configured KMS.
+func (config Config) NewKMS() (encryption.KMS, error) {
+	switch config.Provider {
+	case "aws":
+		awsConfig := &aws.Config{Region: &config.AWS.Region}
+		if config.AWS.AccessKeyID != "" {
+			awsConfig.Credentials = credentials.NewStaticCredentials(config.AWS.AccessKeyID, config.AWS.SecretAccessKey, config.AWS.SessionToken)
+		}
+
+		sess, err := session.NewSession(awsConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewAWSKMS(awskms.New(sess), config.AWS.KeyID), nil
+
+	case "vault":
+		vaultConfig := vaultapi.DefaultConfig()
+		vaultConfig.Address = config.Vault.URL
+
+		err := vaultConfig.ConfigureTLS(&vaultapi.TLSConfig{
+			CACert:   config.Vault.CACert,
+			Insecure: config.Vault.InsecureSkipVerify,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := vaultapi.NewClient(vaultConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		client.SetToken(config.Vault.Token)
+
+		return NewVaultTransit(client, config.Vault.MountPath, config.Vault.KeyName), nil
+
+	case "local":
+		return NewLocalKMS(config.Local.MasterKey.AEAD), nil
+	}
+
+	return nil, fmt.Errorf("unknown KMS provider: %s", config.Provider)
+}
+
+// NewStrategy constructs an envelope encryption strategy using the
+// configured KMS.
+func (config Config) NewStrategy() (*encryption.EnvelopeStrategy, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	kms, err := config.NewKMS()
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.NewEnvelopeStrategy(kms, clock.NewClock(), config.DataKeyTTL, config.CacheSize), nil
+}
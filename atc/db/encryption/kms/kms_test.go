@@ -0,0 +1,226 @@
+package kms_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awskms "github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/concourse/concourse/atc/db/encryption/kms"
+	vaultapi "github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+type MockKMSService struct {
+	kmsiface.KMSAPI
+
+	generateDataKeyInput *awskms.GenerateDataKeyInput
+	decryptInput         *awskms.DecryptInput
+	err                  error
+}
+
+func (mock *MockKMSService) GenerateDataKey(input *awskms.GenerateDataKeyInput) (*awskms.GenerateDataKeyOutput, error) {
+	mock.generateDataKeyInput = input
+	if mock.err != nil {
+		return nil, mock.err
+	}
+
+	return &awskms.GenerateDataKeyOutput{
+		Plaintext:      []byte("some-plaintext-key"),
+		CiphertextBlob: []byte("some-wrapped-key"),
+	}, nil
+}
+
+func (mock *MockKMSService) Decrypt(input *awskms.DecryptInput) (*awskms.DecryptOutput, error) {
+	mock.decryptInput = input
+	if mock.err != nil {
+		return nil, mock.err
+	}
+
+	return &awskms.DecryptOutput{
+		Plaintext: []byte("some-plaintext-key"),
+	}, nil
+}
+
+// vault does not set a content type, so ghttp.VerifyJSON cannot be used
+func verifyJSONBody(expected string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(expected))
+	}
+}
+
+var _ = Describe("KMS", func() {
+	Describe("LocalKMS", func() {
+		var localKMS *kms.LocalKMS
+
+		newAEAD := func(key string) cipher.AEAD {
+			block, err := aes.NewCipher([]byte(key))
+			Expect(err).ToNot(HaveOccurred())
+
+			aesgcm, err := cipher.NewGCM(block)
+			Expect(err).ToNot(HaveOccurred())
+
+			return aesgcm
+		}
+
+		BeforeEach(func() {
+			localKMS = kms.NewLocalKMS(newAEAD("AES256Key-32Characters1234567890"))
+		})
+
+		It("generates data keys which it can unwrap", func() {
+			plaintext, wrapped, err := localKMS.GenerateDataKey()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plaintext).To(HaveLen(32))
+			Expect(wrapped).ToNot(ContainSubstring(string(plaintext)))
+
+			unwrapped, err := localKMS.Decrypt(wrapped)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unwrapped).To(Equal(plaintext))
+		})
+
+		It("fails to unwrap data keys wrapped by another master key", func() {
+			_, wrapped, err := kms.NewLocalKMS(newAEAD("AES256Key-32Characters0987654321")).GenerateDataKey()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = localKMS.Decrypt(wrapped)
+			Expect(err).To(Equal(kms.ErrInvalidDataKey))
+		})
+
+		It("identifies the master key", func() {
+			Expect(localKMS.KeyID()).To(Equal(kms.NewLocalKMS(newAEAD("AES256Key-32Characters1234567890")).KeyID()))
+			Expect(localKMS.KeyID()).ToNot(Equal(kms.NewLocalKMS(newAEAD("AES256Key-32Characters0987654321")).KeyID()))
+		})
+	})
+
+	Describe("AWSKMS", func() {
+		var (
+			mockService *MockKMSService
+			awsKMS      *kms.AWSKMS
+		)
+
+		BeforeEach(func() {
+			mockService = &MockKMSService{}
+			awsKMS = kms.NewAWSKMS(mockService, "alias/concourse")
+		})
+
+		It("generates 256-bit data keys with the configured key", func() {
+			plaintext, wrapped, err := awsKMS.GenerateDataKey()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("some-plaintext-key")))
+			Expect(wrapped).To(Equal([]byte("some-wrapped-key")))
+
+			Expect(mockService.generateDataKeyInput.KeyId).To(Equal(aws.String("alias/concourse")))
+			Expect(mockService.generateDataKeyInput.KeySpec).To(Equal(aws.String("AES_256")))
+		})
+
+		It("unwraps data keys", func() {
+			plaintext, err := awsKMS.Decrypt([]byte("some-wrapped-key"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("some-plaintext-key")))
+			Expect(mockService.decryptInput.CiphertextBlob).To(Equal([]byte("some-wrapped-key")))
+		})
+
+		It("returns errors from the KMS", func() {
+			mockService.err = errors.New("nope")
+
+			_, _, err := awsKMS.GenerateDataKey()
+			Expect(err).To(MatchError("nope"))
+
+			_, err = awsKMS.Decrypt([]byte("some-wrapped-key"))
+			Expect(err).To(MatchError("nope"))
+		})
+
+		It("identifies the master key", func() {
+			Expect(awsKMS.KeyID()).To(Equal("aws:alias/concourse"))
+		})
+	})
+
+	Describe("VaultTransit", func() {
+		var (
+			server       *ghttp.Server
+			vaultTransit *kms.VaultTransit
+			plaintext    string
+		)
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+
+			config := vaultapi.DefaultConfig()
+			config.Address = server.URL()
+
+			client, err := vaultapi.NewClient(config)
+			Expect(err).ToNot(HaveOccurred())
+
+			client.SetToken("some-token")
+
+			vaultTransit = kms.NewVaultTransit(client, "transit", "concourse")
+
+			plaintext = base64.StdEncoding.EncodeToString([]byte("some-plaintext-key"))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("generates data keys", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/transit/datakey/plaintext/concourse"),
+					ghttp.VerifyHeaderKV("X-Vault-Token", "some-token"),
+					verifyJSONBody(`{"bits":256}`),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+						"data": map[string]interface{}{
+							"plaintext":  plaintext,
+							"ciphertext": "vault:v1:some-wrapped-key",
+						},
+					}),
+				),
+			)
+
+			key, wrapped, err := vaultTransit.GenerateDataKey()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key).To(Equal([]byte("some-plaintext-key")))
+			Expect(wrapped).To(Equal([]byte("vault:v1:some-wrapped-key")))
+		})
+
+		It("unwraps data keys", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/transit/decrypt/concourse"),
+					verifyJSONBody(`{"ciphertext":"vault:v1:some-wrapped-key"}`),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+						"data": map[string]interface{}{
+							"plaintext": plaintext,
+						},
+					}),
+				),
+			)
+
+			key, err := vaultTransit.Decrypt([]byte("vault:v1:some-wrapped-key"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key).To(Equal([]byte("some-plaintext-key")))
+		})
+
+		It("returns an error when vault fails", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusForbidden, `{"errors":["permission denied"]}`),
+			)
+
+			_, err := vaultTransit.Decrypt([]byte("vault:v1:some-wrapped-key"))
+			Expect(err).To(MatchError(ContainSubstring("permission denied")))
+		})
+
+		It("identifies the master key", func() {
+			Expect(vaultTransit.KeyID()).To(Equal("vault:transit/keys/concourse"))
+		})
+	})
+})
@@ -0,0 +1,74 @@
+package kms
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path"
+
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+// VaultTransit wraps data keys with a named key in Vault's Transit secrets
+// engine.
+type VaultTransit struct {
+	client    *vaultapi.Client
+	mountPath string
+	keyName   string
+}
+
+func NewVaultTransit(client *vaultapi.Client, mountPath string, keyName string) *VaultTransit {
+	return &VaultTransit{
+		client:    client,
+		mountPath: mountPath,
+		keyName:   keyName,
+	}
+}
+
+func (k *VaultTransit) KeyID() string {
+	return "vault:" + path.Join(k.mountPath, "keys", k.keyName)
+}
+
+func (k *VaultTransit) GenerateDataKey() ([]byte, []byte, error) {
+	secret, err := k.client.Logical().Write(path.Join(k.mountPath, "datakey", "plaintext", k.keyName), map[string]interface{}{
+		"bits": 256,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plaintext, err := k.plaintext(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ciphertext, ok := secret.Data["ciphertext"].(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("vault transit response is missing ciphertext")
+	}
+
+	return plaintext, []byte(ciphertext), nil
+}
+
+func (k *VaultTransit) Decrypt(wrapped []byte) ([]byte, error) {
+	secret, err := k.client.Logical().Write(path.Join(k.mountPath, "decrypt", k.keyName), map[string]interface{}{
+		"ciphertext": string(wrapped),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return k.plaintext(secret)
+}
+
+func (k *VaultTransit) plaintext(secret *vaultapi.Secret) ([]byte, error) {
+	if secret == nil {
+		return nil, fmt.Errorf("vault transit returned no data")
+	}
+
+	plaintext, ok := secret.Data["plaintext"].(string)
+	if !ok {
+		return nil, fmt.Errorf("vault transit response is missing plaintext")
+	}
+
+	return base64.StdEncoding.DecodeString(plaintext)
+}
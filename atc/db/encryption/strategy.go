@@ -11,3 +11,11 @@ type Strategy interface {
 	Encrypt([]byte) (string, *string, error)
 	Decrypt(string, *string) ([]byte, error)
 }
+
+// A Cipher is a Strategy whose key can be identified without revealing it,
+// so that data encrypted with a previous key can be detected and rotated.
+type Cipher interface {
+	Strategy
+
+	Fingerprint() string
+}
@@ -0,0 +1,355 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db/encryption"
+)
+
+//go:generate counterfeiter . EncryptionKeyRotator
+
+// An EncryptionKeyRotator re-encrypts data encrypted with an old key using
+// the current key, a batch at a time, so that keys can be rotated without
+// blocking the ATC from starting. Progress is stored in the database so that
+// rotation resumes where it left off after a restart.
+type EncryptionKeyRotator interface {
+	Run(context.Context) error
+	Progress() (EncryptionKeyRotationProgress, error)
+}
+
+type EncryptionKeyRotationProgress struct {
+	KeyFingerprint string
+	Tables         []EncryptionKeyRotationTableProgress
+}
+
+// Completed returns true once every table has been re-encrypted with the
+// current key.
+func (progress EncryptionKeyRotationProgress) Completed() bool {
+	for _, table := range progress.Tables {
+		if !table.Completed {
+			return false
+		}
+	}
+
+	return true
+}
+
+type EncryptionKeyRotationTableProgress struct {
+	Table       string
+	LastID      int
+	RowsRotated int
+	Completed   bool
+	UpdatedAt   time.Time
+
+	// RowsRemaining is the number of rows still encrypted with an old key.
+	RowsRemaining int
+
+	// RowsUndecryptable is the number of rows which none of the keys can
+	// decrypt. They are skipped, as rotating cannot make them readable.
+	RowsUndecryptable int
+}
+
+type encryptionKeyRotator struct {
+	conn      Conn
+	keyRing   *encryption.KeyRing
+	batchSize int
+}
+
+func NewEncryptionKeyRotator(conn Conn, keyRing *encryption.KeyRing, batchSize int) EncryptionKeyRotator {
+	return &encryptionKeyRotator{
+		conn:      conn,
+		keyRing:   keyRing,
+		batchSize: batchSize,
+	}
+}
+
+// Run re-encrypts the next batch of rows in each table which has not yet
+// been completed. Once a pass reaches the end of a table, the table is only
+// completed if no row is left on an old key, e.g. because it was written by
+// an ATC which did not have the new key yet; otherwise another pass starts
+// from the beginning of the table.
+func (rotator *encryptionKeyRotator) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("encryption-key-rotator")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	for _, table := range encryptedTables() {
+		tLog := logger.Session("table", lager.Data{
+			"table": table,
+		})
+
+		progress, err := rotator.tableProgress(table)
+		if err != nil {
+			tLog.Error("failed-to-get-progress", err)
+			return err
+		}
+
+		if progress.Completed {
+			continue
+		}
+
+		err = rotator.rotateBatch(tLog, table, progress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rotator *encryptionKeyRotator) Progress() (EncryptionKeyRotationProgress, error) {
+	progress := EncryptionKeyRotationProgress{
+		KeyFingerprint: rotator.keyRing.Fingerprint(),
+	}
+
+	for _, table := range encryptedTables() {
+		tableProgress, err := rotator.tableProgress(table)
+		if err != nil {
+			return EncryptionKeyRotationProgress{}, err
+		}
+
+		tableProgress.RowsRemaining, tableProgress.RowsUndecryptable, err = rotator.countRows(table)
+		if err != nil {
+			return EncryptionKeyRotationProgress{}, err
+		}
+
+		progress.Tables = append(progress.Tables, tableProgress)
+	}
+
+	return progress, nil
+}
+
+// tableProgress returns the progress of rotating the table to the current
+// key. Progress towards a previous key is discarded.
+func (rotator *encryptionKeyRotator) tableProgress(table string) (EncryptionKeyRotationTableProgress, error) {
+	progress := EncryptionKeyRotationTableProgress{
+		Table: table,
+	}
+
+	var fingerprint string
+	err := psql.Select("key_fingerprint", "last_id", "rows_rotated", "completed", "updated_at").
+		From("encryption_key_rotations").
+		Where(sq.Eq{"table_name": table}).
+		RunWith(rotator.conn).
+		QueryRow().
+		Scan(&fingerprint, &progress.LastID, &progress.RowsRotated, &progress.Completed, &progress.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return progress, nil
+		}
+
+		return EncryptionKeyRotationTableProgress{}, err
+	}
+
+	if fingerprint != rotator.keyRing.Fingerprint() {
+		return EncryptionKeyRotationTableProgress{Table: table}, nil
+	}
+
+	return progress, nil
+}
+
+func (rotator *encryptionKeyRotator) rotateBatch(logger lager.Logger, table string, progress EncryptionKeyRotationTableProgress) error {
+	col := encryptedColumns[table]
+
+	rows, err := psql.Select("id", "nonce", col).
+		From(table).
+		Where(sq.Gt{"id": progress.LastID}).
+		Where(sq.NotEq{"nonce": nil}).
+		OrderBy("id ASC").
+		Limit(uint64(rotator.batchSize)).
+		RunWith(rotator.conn).
+		Query()
+	if err != nil {
+		logger.Error("failed-to-query", err)
+		return err
+	}
+
+	type encryptedRow struct {
+		id         int
+		val, nonce string
+	}
+
+	var batch []encryptedRow
+	for rows.Next() {
+		var row encryptedRow
+		err := rows.Scan(&row.id, &row.nonce, &row.val)
+		if err != nil {
+			Close(rows)
+			logger.Error("failed-to-scan", err)
+			return err
+		}
+
+		batch = append(batch, row)
+	}
+
+	Close(rows)
+
+	rotated := 0
+	undecryptable := 0
+	for _, row := range batch {
+		rLog := logger.Session("row", lager.Data{
+			"id": row.id,
+		})
+
+		encrypted, newNonce, changed, err := rotator.keyRing.Rotate(row.val, &row.nonce)
+		if err == encryption.ErrDataEncryptedWithUnknownKey {
+			rLog.Error("failed-to-decrypt", err)
+			undecryptable++
+			continue
+		}
+
+		if err != nil {
+			rLog.Error("failed-to-rotate", err)
+			return err
+		}
+
+		if !changed {
+			continue
+		}
+
+		// only update the row if it has not been changed since it was read;
+		// if it was, the final pass checks which key it was written with
+		result, err := psql.Update(table).
+			Set(col, encrypted).
+			Set("nonce", newNonce).
+			Where(sq.Eq{
+				"id":    row.id,
+				"nonce": row.nonce,
+			}).
+			RunWith(rotator.conn).
+			Exec()
+		if err != nil {
+			rLog.Error("failed-to-update", err)
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			rLog.Error("failed-to-update", err)
+			return err
+		}
+
+		rotated += int(affected)
+	}
+
+	if len(batch) > 0 {
+		progress.LastID = batch[len(batch)-1].id
+	}
+
+	progress.RowsRotated += rotated
+
+	if len(batch) < rotator.batchSize {
+		remaining, _, err := rotator.countRows(table)
+		if err != nil {
+			logger.Error("failed-to-count-remaining-rows", err)
+			return err
+		}
+
+		if remaining > 0 {
+			logger.Info("restarting", lager.Data{
+				"rows-remaining": remaining,
+			})
+
+			progress.LastID = 0
+		} else {
+			progress.Completed = true
+		}
+	}
+
+	_, err = rotator.conn.Exec(`
+		INSERT INTO encryption_key_rotations (table_name, key_fingerprint, last_id, rows_rotated, completed, updated_at)
+		VALUES ($1, $2, $3, $4, $5, now())
+		ON CONFLICT (table_name) DO UPDATE SET
+			key_fingerprint = EXCLUDED.key_fingerprint,
+			last_id = EXCLUDED.last_id,
+			rows_rotated = EXCLUDED.rows_rotated,
+			completed = EXCLUDED.completed,
+			updated_at = EXCLUDED.updated_at
+	`, table, rotator.keyRing.Fingerprint(), progress.LastID, progress.RowsRotated, progress.Completed)
+	if err != nil {
+		logger.Error("failed-to-save-progress", err)
+		return err
+	}
+
+	if rotated > 0 {
+		logger.Info("re-encrypted-batch", lager.Data{
+			"rows":    rotated,
+			"last-id": progress.LastID,
+		})
+	}
+
+	if undecryptable > 0 {
+		logger.Info("skipped-undecryptable-rows", lager.Data{
+			"rows":    undecryptable,
+			"last-id": progress.LastID,
+		})
+	}
+
+	if progress.Completed {
+		logger.Info("completed", lager.Data{
+			"rows": progress.RowsRotated,
+		})
+	}
+
+	return nil
+}
+
+// countRows returns the number of rows in the table which are still
+// encrypted with an old key, and the number of rows which none of the keys
+// can decrypt.
+func (rotator *encryptionKeyRotator) countRows(table string) (int, int, error) {
+	rows, err := psql.Select("nonce", encryptedColumns[table]).
+		From(table).
+		Where(sq.NotEq{"nonce": nil}).
+		RunWith(rotator.conn).
+		Query()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer Close(rows)
+
+	remaining := 0
+	undecryptable := 0
+	for rows.Next() {
+		var val, nonce string
+		err := rows.Scan(&nonce, &val)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		needsRotation, err := rotator.keyRing.NeedsRotation(val, &nonce)
+		if err == encryption.ErrDataEncryptedWithUnknownKey {
+			undecryptable++
+			continue
+		}
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if needsRotation {
+			remaining++
+		}
+	}
+
+	return remaining, undecryptable, rows.Err()
+}
+
+func encryptedTables() []string {
+	tables := []string{}
+	for table := range encryptedColumns {
+		tables = append(tables, table)
+	}
+
+	sort.Strings(tables)
+
+	return tables
+}
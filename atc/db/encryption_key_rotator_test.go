@@ -0,0 +1,193 @@
+package db_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptionKeyRotator", func() {
+	var (
+		newKey   *encryption.Key
+		oldKey   *encryption.Key
+		otherKey *encryption.Key
+
+		ctx     context.Context
+		rotator db.EncryptionKeyRotator
+	)
+
+	makeKey := func(k string) *encryption.Key {
+		block, err := aes.NewCipher([]byte(k))
+		Expect(err).ToNot(HaveOccurred())
+
+		aesgcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+
+		return encryption.NewKey(aesgcm)
+	}
+
+	writeTeamAuth := func(id int, key *encryption.Key) {
+		encrypted, nonce, err := key.Encrypt([]byte(`{"some":"auth"}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = dbConn.Exec(`UPDATE teams SET legacy_auth = $1, nonce = $2 WHERE id = $3`, encrypted, nonce, id)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createTeam := func(name string, key *encryption.Key) int {
+		var id int
+		err := dbConn.QueryRow(`INSERT INTO teams (name) VALUES ($1) RETURNING id`, name).Scan(&id)
+		Expect(err).ToNot(HaveOccurred())
+
+		writeTeamAuth(id, key)
+
+		return id
+	}
+
+	teamAuthKey := func(id int) *encryption.Key {
+		var legacyAuth, nonce string
+		err := dbConn.QueryRow(`SELECT legacy_auth, nonce FROM teams WHERE id = $1`, id).Scan(&legacyAuth, &nonce)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, key := range []*encryption.Key{newKey, oldKey, otherKey} {
+			_, err := key.Decrypt(legacyAuth, &nonce)
+			if err == nil {
+				return key
+			}
+		}
+
+		Fail("team auth is not encrypted with any known key")
+		return nil
+	}
+
+	teamsProgress := func() db.EncryptionKeyRotationTableProgress {
+		progress, err := rotator.Progress()
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, table := range progress.Tables {
+			if table.Table == "teams" {
+				return table
+			}
+		}
+
+		Fail("no progress for teams")
+		return db.EncryptionKeyRotationTableProgress{}
+	}
+
+	BeforeEach(func() {
+		newKey = makeKey("AES256Key-32Characters1234567890")
+		oldKey = makeKey("AES256Key-32Characters0987654321")
+		otherKey = makeKey("AES256Key-32Characters9564567123")
+
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		rotator = db.NewEncryptionKeyRotator(dbConn, encryption.NewKeyRing(newKey, oldKey), 2)
+	})
+
+	It("re-encrypts rows encrypted with an old key a batch at a time", func() {
+		ids := []int{
+			createTeam("team-1", oldKey),
+			createTeam("team-2", oldKey),
+			createTeam("team-3", oldKey),
+		}
+
+		Expect(rotator.Run(ctx)).To(Succeed())
+
+		Expect(teamAuthKey(ids[0])).To(Equal(newKey))
+		Expect(teamAuthKey(ids[1])).To(Equal(newKey))
+		Expect(teamAuthKey(ids[2])).To(Equal(oldKey))
+
+		progress := teamsProgress()
+		Expect(progress.LastID).To(Equal(ids[1]))
+		Expect(progress.RowsRotated).To(Equal(2))
+		Expect(progress.RowsRemaining).To(Equal(1))
+		Expect(progress.Completed).To(BeFalse())
+
+		Expect(rotator.Run(ctx)).To(Succeed())
+
+		Expect(teamAuthKey(ids[2])).To(Equal(newKey))
+
+		progress = teamsProgress()
+		Expect(progress.RowsRotated).To(Equal(3))
+		Expect(progress.RowsRemaining).To(BeZero())
+		Expect(progress.Completed).To(BeTrue())
+	})
+
+	It("only counts rows encrypted with an old key as remaining", func() {
+		createTeam("team-1", newKey)
+		createTeam("team-2", oldKey)
+		createTeam("team-3", newKey)
+
+		Expect(teamsProgress().RowsRemaining).To(Equal(1))
+	})
+
+	Context("when a row is written with an old key after its batch was rotated", func() {
+		var ids []int
+
+		BeforeEach(func() {
+			ids = []int{
+				createTeam("team-1", oldKey),
+				createTeam("team-2", oldKey),
+				createTeam("team-3", oldKey),
+			}
+
+			Expect(rotator.Run(ctx)).To(Succeed())
+
+			writeTeamAuth(ids[0], oldKey)
+
+			Expect(rotator.Run(ctx)).To(Succeed())
+		})
+
+		It("restarts from the beginning of the table instead of completing", func() {
+			progress := teamsProgress()
+			Expect(progress.LastID).To(BeZero())
+			Expect(progress.RowsRemaining).To(Equal(1))
+			Expect(progress.Completed).To(BeFalse())
+
+			Expect(rotator.Run(ctx)).To(Succeed())
+			Expect(teamAuthKey(ids[0])).To(Equal(newKey))
+
+			Expect(rotator.Run(ctx)).To(Succeed())
+
+			progress = teamsProgress()
+			Expect(progress.RowsRemaining).To(BeZero())
+			Expect(progress.Completed).To(BeTrue())
+		})
+	})
+
+	Context("when a row cannot be decrypted with any key", func() {
+		var ids []int
+
+		BeforeEach(func() {
+			rotator = db.NewEncryptionKeyRotator(dbConn, encryption.NewKeyRing(newKey, oldKey), 3)
+
+			ids = []int{
+				createTeam("team-1", oldKey),
+				createTeam("team-2", otherKey),
+				createTeam("team-3", oldKey),
+			}
+		})
+
+		It("skips the row and rotates the rest of the batch", func() {
+			Expect(rotator.Run(ctx)).To(Succeed())
+
+			Expect(teamAuthKey(ids[0])).To(Equal(newKey))
+			Expect(teamAuthKey(ids[1])).To(Equal(otherKey))
+			Expect(teamAuthKey(ids[2])).To(Equal(newKey))
+
+			Expect(rotator.Run(ctx)).To(Succeed())
+
+			progress := teamsProgress()
+			Expect(progress.RowsRotated).To(Equal(2))
+			Expect(progress.RowsRemaining).To(BeZero())
+			Expect(progress.RowsUndecryptable).To(Equal(1))
+			Expect(progress.Completed).To(BeTrue())
+		})
+	})
+})
@@ -35,6 +35,8 @@ type Job interface {
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
 	FinishedAndNextBuild() (Build, Build, error)
+	LatestSucceededBuildIDs(limit int) ([]int, error)
+	UnreapedSucceededBuilds(before int, limit int) ([]Build, error)
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
 	EnsurePendingBuildExists() error
 	GetPendingBuilds() ([]Build, error)
@@ -207,6 +209,73 @@ func (j *job) Builds(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithPagination(newBuildsQuery, newMinMaxIdQuery, page, j.conn, j.lockFactory)
 }
 
+// LatestSucceededBuildIDs returns the IDs of the job's latest succeeded
+// builds, newest first.
+func (j *job) LatestSucceededBuildIDs(limit int) ([]int, error) {
+	rows, err := psql.Select("id").
+		From("builds").
+		Where(sq.Eq{
+			"job_id": j.id,
+			"status": BuildStatusSucceeded,
+		}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// UnreapedSucceededBuilds returns the job's succeeded builds before the given
+// build ID whose logs have not been reaped, oldest first.
+func (j *job) UnreapedSucceededBuilds(before int, limit int) ([]Build, error) {
+	rows, err := buildsQuery.
+		Where(sq.Eq{
+			"b.job_id":    j.id,
+			"b.status":    BuildStatusSucceeded,
+			"b.reap_time": nil,
+		}).
+		Where(sq.Lt{"b.id": before}).
+		OrderBy("b.id ASC").
+		Limit(uint64(limit)).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	builds := []Build{}
+	for rows.Next() {
+		build := &build{conn: j.conn, lockFactory: j.lockFactory}
+		err = scanBuild(build, rows, j.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, nil
+}
+
 func (j *job) Build(name string) (Build, bool, error) {
 	var query sq.SelectBuilder
 
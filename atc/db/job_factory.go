@@ -9,6 +9,7 @@ import (
 
 type JobFactory interface {
 	VisibleJobs([]string) (Dashboard, error)
+	PipelineDashboard(pipelineID int) (Dashboard, error)
 }
 
 type jobFactory struct {
@@ -105,6 +106,57 @@ func (j *jobFactory) VisibleJobs(teamNames []string) (Dashboard, error) {
 	return dashboard, nil
 }
 
+func (j *jobFactory) PipelineDashboard(pipelineID int) (Dashboard, error) {
+	rows, err := jobsQuery.
+		Where(sq.Eq{
+			"j.pipeline_id": pipelineID,
+			"j.active":      true,
+		}).
+		OrderBy("j.id ASC").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := scanJobs(j.conn, j.lockFactory, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobIDs []int
+	for _, job := range jobs {
+		jobIDs = append(jobIDs, job.ID())
+	}
+
+	nextBuilds, err := j.getBuildsFrom("next_build_id", jobIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	finishedBuilds, err := j.getBuildsFrom("latest_completed_build_id", jobIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard := Dashboard{}
+	for _, job := range jobs {
+		dashboardJob := DashboardJob{Job: job}
+
+		if nextBuild, found := nextBuilds[job.ID()]; found {
+			dashboardJob.NextBuild = nextBuild
+		}
+
+		if finishedBuild, found := finishedBuilds[job.ID()]; found {
+			dashboardJob.FinishedBuild = finishedBuild
+		}
+
+		dashboard = append(dashboard, dashboardJob)
+	}
+
+	return dashboard, nil
+}
+
 func (j *jobFactory) getBuildsFrom(col string, jobIDs []int) (map[int]Build, error) {
 	rows, err := buildsQuery.
 		Where(sq.Eq{"j.id": jobIDs}).
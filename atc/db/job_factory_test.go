@@ -77,4 +77,48 @@ var _ = Describe("Job Factory", func() {
 			Expect(visibleJobs[0].TransitionBuild.ID()).To(Equal(transitionBuild.ID()))
 		})
 	})
+
+	Describe("PipelineDashboard", func() {
+		var otherPipeline db.Pipeline
+
+		BeforeEach(func() {
+			var err error
+			otherPipeline, _, err = defaultTeam.SavePipeline("other-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "other-pipeline-job"},
+				},
+			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns only the jobs of the given pipeline", func() {
+			dashboard, err := jobFactory.PipelineDashboard(otherPipeline.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(len(dashboard)).To(Equal(1))
+			Expect(dashboard[0].Job.Name()).To(Equal("other-pipeline-job"))
+		})
+
+		It("returns next build and latest completed build for each job", func() {
+			job, found, err := defaultPipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			finishedBuild, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = finishedBuild.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			nextBuild, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			dashboard, err := jobFactory.PipelineDashboard(defaultPipeline.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(dashboard[0].Job.Name()).To(Equal("some-job"))
+			Expect(dashboard[0].NextBuild.ID()).To(Equal(nextBuild.ID()))
+			Expect(dashboard[0].FinishedBuild.ID()).To(Equal(finishedBuild.ID()))
+		})
+	})
 })
@@ -486,6 +486,88 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("LatestSucceededBuildIDs", func() {
+		var succeededBuilds []db.Build
+
+		BeforeEach(func() {
+			succeededBuilds = []db.Build{}
+
+			for _, status := range []db.BuildStatus{
+				db.BuildStatusSucceeded,
+				db.BuildStatusSucceeded,
+				db.BuildStatusFailed,
+				db.BuildStatusSucceeded,
+				db.BuildStatusErrored,
+			} {
+				build, err := job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.Finish(status)
+				Expect(err).NotTo(HaveOccurred())
+
+				if status == db.BuildStatusSucceeded {
+					succeededBuilds = append(succeededBuilds, build)
+				}
+			}
+		})
+
+		It("returns the latest succeeded builds, newest first", func() {
+			ids, err := job.LatestSucceededBuildIDs(2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]int{succeededBuilds[2].ID(), succeededBuilds[1].ID()}))
+		})
+
+		It("returns every succeeded build when there are fewer than the limit", func() {
+			ids, err := job.LatestSucceededBuildIDs(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(HaveLen(3))
+		})
+	})
+
+	Describe("UnreapedSucceededBuilds", func() {
+		var succeededBuilds []db.Build
+
+		BeforeEach(func() {
+			succeededBuilds = []db.Build{}
+
+			for _, status := range []db.BuildStatus{
+				db.BuildStatusSucceeded,
+				db.BuildStatusFailed,
+				db.BuildStatusSucceeded,
+				db.BuildStatusSucceeded,
+				db.BuildStatusSucceeded,
+			} {
+				build, err := job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.Finish(status)
+				Expect(err).NotTo(HaveOccurred())
+
+				if status == db.BuildStatusSucceeded {
+					succeededBuilds = append(succeededBuilds, build)
+				}
+			}
+
+			err := pipeline.DeleteBuildEventsByBuildIDs([]int{succeededBuilds[0].ID()})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the succeeded builds before the given build whose logs have not been reaped, oldest first", func() {
+			builds, err := job.UnreapedSucceededBuilds(succeededBuilds[3].ID(), 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(HaveLen(2))
+			Expect(builds[0].ID()).To(Equal(succeededBuilds[1].ID()))
+			Expect(builds[1].ID()).To(Equal(succeededBuilds[2].ID()))
+		})
+
+		It("returns at most the limit", func() {
+			builds, err := job.UnreapedSucceededBuilds(succeededBuilds[3].ID(), 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+			Expect(builds[0].ID()).To(Equal(succeededBuilds[1].ID()))
+		})
+	})
+
 	Describe("GetRunningBuildsBySerialGroup", func() {
 		Describe("same job", func() {
 			var startedBuild, scheduledBuild db.Build
@@ -0,0 +1,4 @@
+BEGIN;
+  UPDATE workers SET state = 'running' WHERE state = 'cordoned';
+  ALTER TABLE workers DROP COLUMN cordoned_by, DROP COLUMN cordon_reason;
+COMMIT;
@@ -0,0 +1,3 @@
+-- NO_TRANSACTION
+ALTER TYPE worker_state ADD VALUE IF NOT EXISTS 'cordoned';
+ALTER TABLE workers ADD COLUMN cordoned_by text, ADD COLUMN cordon_reason text;
@@ -0,0 +1,10 @@
+BEGIN;
+  CREATE TABLE encryption_key_rotations (
+    table_name text PRIMARY KEY,
+    key_fingerprint text NOT NULL,
+    last_id integer NOT NULL DEFAULT 0,
+    rows_rotated integer NOT NULL DEFAULT 0,
+    completed boolean NOT NULL DEFAULT false,
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+COMMIT;
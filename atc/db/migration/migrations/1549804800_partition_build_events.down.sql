@@ -0,0 +1,10 @@
+BEGIN;
+  DROP TRIGGER IF EXISTS build_events_partition_insert_trigger ON builds;
+  DROP FUNCTION IF EXISTS on_build_insert();
+  DROP FUNCTION IF EXISTS create_build_event_partition(integer, integer, integer);
+  DROP FUNCTION IF EXISTS build_event_partition_size();
+
+  -- existing partitions are left in place, as their events are still read
+  -- through the pipeline and team tables, so the pipeline and team tables
+  -- must still be dropped along with them
+COMMIT;
@@ -0,0 +1,82 @@
+BEGIN;
+  -- Build events are partitioned by build ID range within each pipeline's
+  -- and team's build events table, so that reaped build events can be
+  -- dropped a partition at a time rather than deleted a row at a time.
+  --
+  -- Existing events are left in the pipeline and team tables, where they are
+  -- still read and reaped as before.
+
+  -- must match buildEventPartitionSize in atc/db
+  CREATE FUNCTION build_event_partition_size() RETURNS integer AS $$
+    SELECT 100000
+  $$ LANGUAGE sql IMMUTABLE;
+
+
+  CREATE FUNCTION create_build_event_partition(build integer, pipeline integer, team integer) RETURNS void AS $$
+  DECLARE
+          parent text;
+          partition text;
+          bucket integer;
+  BEGIN
+          IF pipeline IS NOT NULL THEN
+                  parent := format('pipeline_build_events_%s', pipeline);
+          ELSE
+                  parent := format('team_build_events_%s', team);
+          END IF;
+
+          bucket := build / build_event_partition_size();
+          partition := format('%s_p%s', parent, bucket);
+
+          IF to_regclass(partition) IS NOT NULL THEN
+                  RETURN;
+          END IF;
+
+          -- the partition may be created concurrently for another build
+          BEGIN
+                  EXECUTE format(
+                    'CREATE TABLE %I (CHECK (build_id >= %s AND build_id < %s)) INHERITS (%I)',
+                    partition,
+                    bucket * build_event_partition_size(),
+                    (bucket + 1) * build_event_partition_size(),
+                    parent
+                  );
+                  EXECUTE format('CREATE UNIQUE INDEX %I ON %I (build_id, event_id)', partition || '_build_id_event_id', partition);
+          EXCEPTION WHEN duplicate_table OR unique_violation THEN
+                  NULL;
+          END;
+  END;
+  $$ LANGUAGE plpgsql;
+
+
+  CREATE FUNCTION on_build_insert() RETURNS TRIGGER AS $$
+  BEGIN
+          PERFORM create_build_event_partition(NEW.id, NEW.pipeline_id, NEW.team_id);
+          RETURN NULL;
+  END;
+  $$ LANGUAGE plpgsql;
+
+  CREATE TRIGGER build_events_partition_insert_trigger AFTER INSERT on builds FOR EACH ROW EXECUTE PROCEDURE on_build_insert();
+
+
+  -- partitions inherit from the pipeline and team tables, so they must be
+  -- dropped along with them
+  CREATE OR REPLACE FUNCTION on_pipeline_delete() RETURNS TRIGGER AS $$
+  BEGIN
+          EXECUTE format('DROP TABLE IF EXISTS pipeline_build_events_%s CASCADE', OLD.id);
+          RETURN NULL;
+  END;
+  $$ LANGUAGE plpgsql;
+
+  CREATE OR REPLACE FUNCTION on_team_delete() RETURNS TRIGGER AS $$
+  BEGIN
+          EXECUTE format('DROP TABLE IF EXISTS team_build_events_%s CASCADE', OLD.id);
+          RETURN NULL;
+  END;
+  $$ LANGUAGE plpgsql;
+
+
+  -- builds which are still running will keep saving events
+  SELECT create_build_event_partition(id, pipeline_id, team_id)
+  FROM builds
+  WHERE NOT completed;
+COMMIT;
@@ -3,7 +3,6 @@ package db
 import (
 	"database/sql"
 	"database/sql/driver"
-	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -55,11 +54,13 @@ type Tx interface {
 	Stmt(stmt *sql.Stmt) *sql.Stmt
 }
 
-func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey *encryption.Key, oldKey *encryption.Key, connectionName string, lockFactory lock.LockFactory) (Conn, error) {
+func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey encryption.Cipher, oldKeys []*encryption.Key, connectionName string, lockFactory lock.LockFactory) (Conn, error) {
 	for {
 		var strategy encryption.Strategy
 		if newKey != nil {
-			strategy = newKey
+			// data encrypted with any of the old keys remains readable while it
+			// is re-encrypted in the background by the EncryptionKeyRotator
+			strategy = encryption.NewKeyRing(newKey, oldKeys...)
 		} else {
 			strategy = encryption.NewNoEncryption()
 		}
@@ -75,14 +76,11 @@ func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey *e
 			return nil, err
 		}
 
-		switch {
-		case oldKey != nil && newKey == nil:
-			err = decryptToPlaintext(logger.Session("decrypt"), sqlDb, oldKey)
-		case oldKey != nil && newKey != nil:
-			err = encryptWithNewKey(logger.Session("rotate"), sqlDb, newKey, oldKey)
-		}
-		if err != nil {
-			return nil, err
+		if len(oldKeys) > 0 && newKey == nil {
+			err = decryptToPlaintext(logger.Session("decrypt"), sqlDb, encryption.NewKeyRing(oldKeys[0], oldKeys[1:]...))
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if newKey != nil {
@@ -124,7 +122,7 @@ var encryptedColumns = map[string]string{
 	"builds":         "engine_metadata",
 }
 
-func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
+func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key encryption.Strategy) error {
 	for table, col := range encryptedColumns {
 		rows, err := sqlDB.Query(`
 			SELECT id, ` + col + `
@@ -191,7 +189,7 @@ func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) e
 	return nil
 }
 
-func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey *encryption.Key) error {
+func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKeys encryption.Strategy) error {
 	for table, col := range encryptedColumns {
 		rows, err := sqlDB.Query(`
 			SELECT id, nonce, ` + col + `
@@ -224,7 +222,7 @@ func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey *encryption.K
 				"id": id,
 			})
 
-			decrypted, err := oldKey.Decrypt(val, &nonce)
+			decrypted, err := oldKeys.Decrypt(val, &nonce)
 			if err != nil {
 				rLog.Error("failed-to-decrypt", err)
 				return err
@@ -253,82 +251,6 @@ func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey *encryption.K
 	return nil
 }
 
-var ErrEncryptedWithUnknownKey = errors.New("row encrypted with neither old nor new key")
-
-func encryptWithNewKey(logger lager.Logger, sqlDB *sql.DB, newKey *encryption.Key, oldKey *encryption.Key) error {
-	for table, col := range encryptedColumns {
-		rows, err := sqlDB.Query(`
-			SELECT id, nonce, ` + col + `
-			FROM ` + table + `
-			WHERE nonce IS NOT NULL
-		`)
-		if err != nil {
-			return err
-		}
-
-		tLog := logger.Session("table", lager.Data{
-			"table": table,
-		})
-
-		encryptedRows := 0
-
-		for rows.Next() {
-			var (
-				id         int
-				val, nonce string
-			)
-
-			err := rows.Scan(&id, &nonce, &val)
-			if err != nil {
-				tLog.Error("failed-to-scan", err)
-				return err
-			}
-
-			rLog := tLog.Session("row", lager.Data{
-				"id": id,
-			})
-
-			decrypted, err := oldKey.Decrypt(val, &nonce)
-			if err != nil {
-				_, err = newKey.Decrypt(val, &nonce)
-				if err == nil {
-					rLog.Debug("already-encrypted-with-new-key")
-					continue
-				}
-
-				logger.Error("failed-to-decrypt-with-either-key", err)
-				return ErrEncryptedWithUnknownKey
-			}
-
-			encrypted, newNonce, err := newKey.Encrypt(decrypted)
-			if err != nil {
-				rLog.Error("failed-to-encrypt", err)
-				return err
-			}
-
-			_, err = sqlDB.Exec(`
-				UPDATE `+table+`
-				SET `+col+` = $1, nonce = $2
-				WHERE id = $3
-			`, encrypted, newNonce, id)
-			if err != nil {
-				rLog.Error("failed-to-update", err)
-				return err
-			}
-
-			encryptedRows++
-		}
-
-		if encryptedRows > 0 {
-			tLog.Info("re-encrypted-existing-encrypted-data", lager.Data{
-				"rows": encryptedRows,
-			})
-		}
-	}
-
-	return nil
-}
-
 type db struct {
 	*sql.DB
 
@@ -876,17 +876,26 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 	defer Rollback(tx)
 
 	_, err = tx.Exec(`
-   DELETE FROM build_events
-	 WHERE build_id IN (`+strings.Join(indexStrings, ",")+`)
-	 `, interfaceBuildIDs...)
+		UPDATE builds
+		SET reap_time = now()
+		WHERE id IN (`+strings.Join(indexStrings, ",")+`)
+	`, interfaceBuildIDs...)
+	if err != nil {
+		return err
+	}
+
+	// events saved to partitions are left in place for the partition to be
+	// dropped once every build in it has been reaped, which is far cheaper
+	// than deleting their rows; only events saved before build events were
+	// partitioned are deleted from the pipeline's table itself
+	_, err = dropReapedBuildEventPartitions(tx, p.id)
 	if err != nil {
 		return err
 	}
 
 	_, err = tx.Exec(`
-		UPDATE builds
-		SET reap_time = now()
-		WHERE id IN (`+strings.Join(indexStrings, ",")+`)
+		DELETE FROM ONLY `+buildEventsTable(0, p.id)+`
+		WHERE build_id IN (`+strings.Join(indexStrings, ",")+`)
 	`, interfaceBuildIDs...)
 	if err != nil {
 		return err
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -1647,6 +1648,101 @@ var _ = Describe("Pipeline", func() {
 			// Not required behavior, just a sanity check for what I think will happen
 			Expect(build4DB.ReapTime()).To(Equal(build1DB.ReapTime()))
 		})
+
+		It("only deletes the rows of events saved before build events were partitioned", func() {
+			job, found, err := pipeline.Job("job-name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.SaveEvent(event.Log{
+				Payload: "log",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			table := fmt.Sprintf("pipeline_build_events_%d", pipeline.ID())
+
+			_, err = dbConn.Exec(`
+				INSERT INTO `+table+` (build_id, event_id, type, version, payload)
+				VALUES ($1, 1000, 'log', '5.0', '{"payload":"legacy log"}')
+			`, build.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = pipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+			Expect(err).ToNot(HaveOccurred())
+
+			countRows := func(from string) int {
+				var count int
+				err := dbConn.QueryRow(`SELECT COUNT(*) FROM `+from+` WHERE build_id = $1`, build.ID()).Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				return count
+			}
+
+			By("deleting the rows of legacy events")
+			Expect(countRows("ONLY " + table)).To(BeZero())
+
+			By("leaving the rows in the partition to be dropped with it")
+			Expect(countRows(fmt.Sprintf("%s_p%d", table, build.ID()/100000))).To(Equal(2))
+
+			By("no longer reading any of the build's events")
+			events, err := build.Events(0)
+			Expect(err).ToNot(HaveOccurred())
+			defer db.Close(events)
+
+			_, err = events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		It("drops partitions in which every build has been reaped", func() {
+			job, found, err := pipeline.Job("job-name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.SaveEvent(event.Log{
+				Payload: "log",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			partition := fmt.Sprintf("pipeline_build_events_%d_p%d", pipeline.ID(), build.ID()/100000)
+
+			partitionExists := func() bool {
+				var exists bool
+				err := dbConn.QueryRow(`SELECT to_regclass($1) IS NOT NULL`, partition).Scan(&exists)
+				Expect(err).ToNot(HaveOccurred())
+				return exists
+			}
+
+			Expect(partitionExists()).To(BeTrue())
+
+			By("keeping the partition while builds may still be created in it")
+			err = pipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(partitionExists()).To(BeTrue())
+
+			By("dropping the partition once builds have moved on")
+			_, err = dbConn.Exec(`SELECT setval('builds_id_seq', $1)`, (build.ID()/100000+2)*100000)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = pipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(partitionExists()).To(BeFalse())
+		})
 	})
 
 	Describe("Jobs", func() {
@@ -0,0 +1,223 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . ReplicaConn
+
+// ReplicaConn is a Conn which serves reads from read replicas of the primary
+// database. Only queries which can tolerate slightly stale data should be
+// run against it; writes and transactions always go to the primary.
+type ReplicaConn interface {
+	Conn
+
+	ReplicaLags() []ReplicaLag
+}
+
+type ReplicaLag struct {
+	Name string
+	Lag  time.Duration
+
+	// InSync is false if the replica is too far behind the primary, or its
+	// lag could not be determined, in which case reads go to the primary.
+	InSync bool
+}
+
+// replicaLagQuery reports how far behind the primary a replica is. A replica
+// which has replayed everything it has received is not lagging, even if the
+// primary has not written anything for a while.
+const replicaLagQuery = `
+	SELECT CASE
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+	END
+`
+
+type replicaConn struct {
+	Conn
+
+	logger   lager.Logger
+	replicas []*replica
+	next     uint32
+
+	maxLag        time.Duration
+	checkInterval time.Duration
+	clock         clock.Clock
+}
+
+type replica struct {
+	name string
+	db   *sql.DB
+
+	lock      sync.Mutex
+	lag       time.Duration
+	inSync    bool
+	checkedAt time.Time
+}
+
+// NewReplicaConn returns a ReplicaConn which round-robins reads across the
+// replicas, skipping any which are more than maxLag behind the primary. Each
+// replica's lag is checked at most once per checkInterval. When no replica is
+// in sync, reads go to the primary.
+func NewReplicaConn(
+	logger lager.Logger,
+	primary Conn,
+	replicaDBs []*sql.DB,
+	maxLag time.Duration,
+	checkInterval time.Duration,
+	clock clock.Clock,
+) ReplicaConn {
+	replicas := make([]*replica, len(replicaDBs))
+	for i, replicaDB := range replicaDBs {
+		replicas[i] = &replica{
+			name: fmt.Sprintf("%s-replica-%d", primary.Name(), i),
+			db:   replicaDB,
+		}
+	}
+
+	return &replicaConn{
+		Conn: primary,
+
+		logger:   logger,
+		replicas: replicas,
+
+		maxLag:        maxLag,
+		checkInterval: checkInterval,
+		clock:         clock,
+	}
+}
+
+func (c *replicaConn) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	r := c.replica()
+	if r == nil {
+		return c.Conn.Query(query, args...)
+	}
+
+	defer GlobalConnectionTracker.Track().Release()
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		c.logger.Error("failed-to-query-replica", err, lager.Data{"replica": r.name})
+		r.outOfSync()
+		return c.Conn.Query(query, args...)
+	}
+
+	return rows, nil
+}
+
+// to conform to squirrel.Runner interface
+func (c *replicaConn) QueryRow(query string, args ...interface{}) squirrel.RowScanner {
+	r := c.replica()
+	if r == nil {
+		return c.Conn.QueryRow(query, args...)
+	}
+
+	defer GlobalConnectionTracker.Track().Release()
+
+	return &replicaRow{
+		row: r.db.QueryRow(query, args...),
+		fallback: func(err error) squirrel.RowScanner {
+			c.logger.Error("failed-to-query-replica", err, lager.Data{"replica": r.name})
+			r.outOfSync()
+			return c.Conn.QueryRow(query, args...)
+		},
+	}
+}
+
+// replicaRow retries a row read against the primary if reading it from the
+// replica fails. The error of a row is only known once it is scanned.
+type replicaRow struct {
+	row      *sql.Row
+	fallback func(error) squirrel.RowScanner
+}
+
+func (r *replicaRow) Scan(dest ...interface{}) error {
+	err := r.row.Scan(dest...)
+	if err == nil || err == sql.ErrNoRows {
+		return err
+	}
+
+	return r.fallback(err).Scan(dest...)
+}
+
+func (c *replicaConn) ReplicaLags() []ReplicaLag {
+	lags := make([]ReplicaLag, len(c.replicas))
+	for i, r := range c.replicas {
+		lags[i] = r.check(c.logger, c.clock, c.maxLag, c.checkInterval)
+	}
+
+	return lags
+}
+
+func (c *replicaConn) Close() error {
+	for _, r := range c.replicas {
+		err := r.db.Close()
+		if err != nil {
+			c.logger.Error("failed-to-close-replica", err, lager.Data{"replica": r.name})
+		}
+	}
+
+	return c.Conn.Close()
+}
+
+func (c *replicaConn) replica() *replica {
+	if len(c.replicas) == 0 {
+		return nil
+	}
+
+	start := int(atomic.AddUint32(&c.next, 1))
+	for i := 0; i < len(c.replicas); i++ {
+		r := c.replicas[(start+i)%len(c.replicas)]
+		if r.check(c.logger, c.clock, c.maxLag, c.checkInterval).InSync {
+			return r
+		}
+	}
+
+	return nil
+}
+
+func (r *replica) check(logger lager.Logger, clock clock.Clock, maxLag time.Duration, checkInterval time.Duration) ReplicaLag {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := clock.Now()
+	if !r.checkedAt.IsZero() && now.Sub(r.checkedAt) < checkInterval {
+		return ReplicaLag{Name: r.name, Lag: r.lag, InSync: r.inSync}
+	}
+
+	r.checkedAt = now
+
+	var seconds float64
+	err := r.db.QueryRow(replicaLagQuery).Scan(&seconds)
+	if err != nil {
+		logger.Error("failed-to-check-replica-lag", err, lager.Data{"replica": r.name})
+		r.inSync = false
+		return ReplicaLag{Name: r.name, Lag: r.lag, InSync: false}
+	}
+
+	r.lag = time.Duration(seconds * float64(time.Second))
+	r.inSync = r.lag <= maxLag
+
+	if !r.inSync {
+		logger.Info("replica-lagging", lager.Data{"replica": r.name, "lag": r.lag.String()})
+	}
+
+	return ReplicaLag{Name: r.name, Lag: r.lag, InSync: r.inSync}
+}
+
+// outOfSync stops reads from going to the replica until its lag is next
+// checked.
+func (r *replica) outOfSync() {
+	r.lock.Lock()
+	r.inSync = false
+	r.lock.Unlock()
+}
@@ -0,0 +1,106 @@
+package db_test
+
+import (
+	"database/sql"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReplicaConn", func() {
+	var (
+		replicaDB   *sql.DB
+		fakeClock   *fakeclock.FakeClock
+		replicaConn db.ReplicaConn
+	)
+
+	BeforeEach(func() {
+		// the test database is not a replica, so it never lags behind itself
+		replicaDB = postgresRunner.OpenDB()
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		replicaConn = db.NewReplicaConn(
+			lagertest.NewTestLogger("test"),
+			dbConn,
+			[]*sql.DB{replicaDB},
+			time.Second,
+			time.Minute,
+			fakeClock,
+		)
+	})
+
+	It("reports the lag of each replica", func() {
+		Expect(replicaConn.ReplicaLags()).To(Equal([]db.ReplicaLag{
+			{Name: dbConn.Name() + "-replica-0", Lag: 0, InSync: true},
+		}))
+	})
+
+	It("serves reads from the replica", func() {
+		var pid, replicaPID int
+		err := replicaDB.QueryRow(`SELECT pg_backend_pid()`).Scan(&replicaPID)
+		Expect(err).ToNot(HaveOccurred())
+
+		replicaDB.SetMaxOpenConns(1)
+
+		err = replicaConn.QueryRow(`SELECT pg_backend_pid()`).Scan(&pid)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pid).To(Equal(replicaPID))
+	})
+
+	It("writes to the primary", func() {
+		_, err := db.NewTeamFactory(replicaConn, lockFactory).CreateTeam(atc.Team{Name: "some-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, found, err := teamFactory.FindTeam("some-team")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+	})
+
+	Context("when the replica is unavailable", func() {
+		BeforeEach(func() {
+			Expect(replicaDB.Close()).To(Succeed())
+		})
+
+		It("reports the replica as out of sync", func() {
+			lags := replicaConn.ReplicaLags()
+			Expect(lags).To(HaveLen(1))
+			Expect(lags[0].InSync).To(BeFalse())
+		})
+
+		It("serves reads from the primary", func() {
+			var one int
+			err := replicaConn.QueryRow(`SELECT 1`).Scan(&one)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(one).To(Equal(1))
+		})
+	})
+
+	Context("when the replica fails after its lag was checked", func() {
+		BeforeEach(func() {
+			Expect(replicaConn.ReplicaLags()[0].InSync).To(BeTrue())
+			Expect(replicaDB.Close()).To(Succeed())
+		})
+
+		It("falls back to the primary for rows", func() {
+			var one int
+			err := replicaConn.QueryRow(`SELECT 1`).Scan(&one)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(one).To(Equal(1))
+
+			Expect(replicaConn.ReplicaLags()[0].InSync).To(BeFalse())
+		})
+
+		It("falls back to the primary for queries", func() {
+			rows, err := replicaConn.Query(`SELECT 1`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows.Close()).To(Succeed())
+
+			Expect(replicaConn.ReplicaLags()[0].InSync).To(BeFalse())
+		})
+	})
+})
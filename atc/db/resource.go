@@ -30,6 +30,7 @@ type Resource interface {
 	CheckTimeout() string
 	LastChecked() time.Time
 	Tags() atc.Tags
+	WorkerSelector() atc.WorkerSelector
 	CheckSetupError() error
 	CheckError() error
 	WebhookToken() string
@@ -76,6 +77,7 @@ type resource struct {
 	checkTimeout          string
 	lastChecked           time.Time
 	tags                  atc.Tags
+	workerSelector        atc.WorkerSelector
 	checkSetupError       error
 	checkError            error
 	webhookToken          string
@@ -121,31 +123,34 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 			CheckEvery:   r.CheckEvery(),
 			Tags:         r.Tags(),
 			Version:      r.ConfigPinnedVersion(),
+
+			WorkerSelector: r.WorkerSelector(),
 		})
 	}
 
 	return configs
 }
 
-func (r *resource) ID() int                          { return r.id }
-func (r *resource) Name() string                     { return r.name }
-func (r *resource) PipelineID() int                  { return r.pipelineID }
-func (r *resource) PipelineName() string             { return r.pipelineName }
-func (r *resource) TeamName() string                 { return r.teamName }
-func (r *resource) Type() string                     { return r.type_ }
-func (r *resource) Source() atc.Source               { return r.source }
-func (r *resource) CheckEvery() string               { return r.checkEvery }
-func (r *resource) CheckTimeout() string             { return r.checkTimeout }
-func (r *resource) LastChecked() time.Time           { return r.lastChecked }
-func (r *resource) Tags() atc.Tags                   { return r.tags }
-func (r *resource) CheckSetupError() error           { return r.checkSetupError }
-func (r *resource) CheckError() error                { return r.checkError }
-func (r *resource) WebhookToken() string             { return r.webhookToken }
-func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
-func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
-func (r *resource) PinComment() string               { return r.pinComment }
-func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
-func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
+func (r *resource) ID() int                            { return r.id }
+func (r *resource) Name() string                       { return r.name }
+func (r *resource) PipelineID() int                    { return r.pipelineID }
+func (r *resource) PipelineName() string               { return r.pipelineName }
+func (r *resource) TeamName() string                   { return r.teamName }
+func (r *resource) Type() string                       { return r.type_ }
+func (r *resource) Source() atc.Source                 { return r.source }
+func (r *resource) CheckEvery() string                 { return r.checkEvery }
+func (r *resource) CheckTimeout() string               { return r.checkTimeout }
+func (r *resource) LastChecked() time.Time             { return r.lastChecked }
+func (r *resource) Tags() atc.Tags                     { return r.tags }
+func (r *resource) WorkerSelector() atc.WorkerSelector { return r.workerSelector }
+func (r *resource) CheckSetupError() error             { return r.checkSetupError }
+func (r *resource) CheckError() error                  { return r.checkError }
+func (r *resource) WebhookToken() string               { return r.webhookToken }
+func (r *resource) ConfigPinnedVersion() atc.Version   { return r.configPinnedVersion }
+func (r *resource) APIPinnedVersion() atc.Version      { return r.apiPinnedVersion }
+func (r *resource) PinComment() string                 { return r.pinComment }
+func (r *resource) ResourceConfigID() int              { return r.resourceConfigID }
+func (r *resource) ResourceConfigScopeID() int         { return r.resourceConfigScopeID }
 
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
@@ -609,6 +614,7 @@ func scanResource(r *resource, row scannable) error {
 	r.checkEvery = config.CheckEvery
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
+	r.workerSelector = config.WorkerSelector
 	r.webhookToken = config.WebhookToken
 	r.configPinnedVersion = config.Version
 
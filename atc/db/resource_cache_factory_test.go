@@ -271,7 +271,8 @@ var _ = Describe("ResourceCacheFactory", func() {
 								return
 							default:
 								Expect(resourceCacheLifecycle.CleanUsesForFinishedBuilds(logger)).To(Succeed())
-								Expect(resourceCacheLifecycle.CleanUpInvalidCaches(logger)).To(Succeed())
+								_, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger, nil)
+								Expect(err).ToNot(HaveOccurred())
 								Expect(resourceConfigFactory.CleanUnreferencedConfigs()).To(Succeed())
 							}
 						}
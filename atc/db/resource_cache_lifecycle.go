@@ -1,6 +1,7 @@
 package db
 
 import (
+	"sort"
 	"strings"
 
 	"code.cloudfoundry.org/lager"
@@ -13,9 +14,15 @@ import (
 type ResourceCacheLifecycle interface {
 	CleanUsesForFinishedBuilds(lager.Logger) error
 	CleanBuildImageResourceCaches(lager.Logger) error
-	CleanUpInvalidCaches(lager.Logger) error
+	CleanUpInvalidCaches(lager.Logger, ResourceCacheRetention) (int, error)
+	FindInvalidCaches(lager.Logger, ResourceCacheRetention) ([]int, error)
 }
 
+// ResourceCacheRetention maps team names to the number of the latest versions
+// of each of the team's resources whose caches should be kept, even once they
+// are no longer in use.
+type ResourceCacheRetention map[string]int
+
 type resourceCacheLifecycle struct {
 	conn Conn
 }
@@ -47,13 +54,94 @@ func (f *resourceCacheLifecycle) CleanUsesForFinishedBuilds(logger lager.Logger)
 	return err
 }
 
-func (f *resourceCacheLifecycle) CleanUpInvalidCaches(logger lager.Logger) error {
+func (f *resourceCacheLifecycle) CleanUpInvalidCaches(logger lager.Logger, retention ResourceCacheRetention) (int, error) {
+	condition, err := invalidCachesCondition(retention)
+	if err != nil {
+		return 0, err
+	}
+
+	query, args, err := sq.Delete("resource_caches").
+		Where(condition).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := f.conn.Query(query, args...)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqFKeyViolationErrCode {
+			// this can happen if a use or resource cache is created referencing the
+			// config; as the subqueries are not atomic
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	defer Close(rows)
+
+	var deletedCacheIDs []int
+	for rows.Next() {
+		var cacheID int
+		err = rows.Scan(&cacheID)
+		if err != nil {
+			return len(deletedCacheIDs), nil
+		}
+
+		deletedCacheIDs = append(deletedCacheIDs, cacheID)
+	}
+
+	if len(deletedCacheIDs) > 0 {
+		logger.Debug("deleted-resource-caches", lager.Data{"id": deletedCacheIDs})
+	}
+
+	return len(deletedCacheIDs), nil
+}
+
+func (f *resourceCacheLifecycle) FindInvalidCaches(logger lager.Logger, retention ResourceCacheRetention) ([]int, error) {
+	condition, err := invalidCachesCondition(retention)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := sq.Select("id").
+		From("resource_caches").
+		Where(condition).
+		OrderBy("id ASC").
+		PlaceholderFormat(sq.Dollar).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var cacheIDs []int
+	for rows.Next() {
+		var cacheID int
+		err = rows.Scan(&cacheID)
+		if err != nil {
+			return nil, err
+		}
+
+		cacheIDs = append(cacheIDs, cacheID)
+	}
+
+	return cacheIDs, nil
+}
+
+// invalidCachesCondition matches resource caches which are no longer in use
+// and are not retained by the retention policy.
+func invalidCachesCondition(retention ResourceCacheRetention) (sq.Sqlizer, error) {
 	stillInUseCacheIds, _, err := sq.
 		Select("resource_cache_id").
 		From("resource_cache_uses").
 		ToSql()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resourceConfigCacheIds, _, err := sq.
@@ -62,7 +150,7 @@ func (f *resourceCacheLifecycle) CleanUpInvalidCaches(logger lager.Logger) error
 		Where(sq.NotEq{"resource_cache_id": nil}).
 		ToSql()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	buildImageCacheIds, _, err := sq.
@@ -70,7 +158,7 @@ func (f *resourceCacheLifecycle) CleanUpInvalidCaches(logger lager.Logger) error
 		From("build_image_resource_caches").
 		ToSql()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	nextBuildInputsCacheIds, _, err := sq.
@@ -84,52 +172,53 @@ func (f *resourceCacheLifecycle) CleanUpInvalidCaches(logger lager.Logger) error
 		Where(sq.Expr("p.paused = false")).
 		ToSql()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	query, args, err := sq.Delete("resource_caches").
-		Where("id NOT IN (" + strings.Join([]string{
-			stillInUseCacheIds,
-			resourceConfigCacheIds,
-			buildImageCacheIds,
-			nextBuildInputsCacheIds,
-		}, " UNION ") + ")").
-		Suffix("RETURNING id").
-		PlaceholderFormat(sq.Dollar).
-		ToSql()
-	if err != nil {
-		return err
+	validCacheIds := []string{
+		stillInUseCacheIds,
+		resourceConfigCacheIds,
+		buildImageCacheIds,
+		nextBuildInputsCacheIds,
 	}
 
-	rows, err := f.conn.Query(query, args...)
-	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqFKeyViolationErrCode {
-			// this can happen if a use or resource cache is created referencing the
-			// config; as the subqueries above are not atomic
-			return nil
-		}
+	var args []interface{}
 
-		return err
+	teams := []string{}
+	for team := range retention {
+		teams = append(teams, team)
 	}
 
-	defer Close(rows)
-
-	var deletedCacheIDs []int
-	for rows.Next() {
-		var cacheID int
-		err = rows.Scan(&cacheID)
+	sort.Strings(teams)
+
+	for _, team := range teams {
+		retainedCacheIds, retainedArgs, err := sq.
+			Select("r_cache.id").
+			From("resource_caches r_cache").
+			Join("resource_config_scopes rs ON rs.resource_config_id = r_cache.resource_config_id").
+			Join("resources r ON r.resource_config_scope_id = rs.id").
+			Join("pipelines p ON p.id = r.pipeline_id").
+			Join("teams t ON t.id = p.team_id").
+			JoinClause(
+				"JOIN LATERAL ("+
+					"SELECT rcv.version FROM resource_config_versions rcv "+
+					"WHERE rcv.resource_config_scope_id = rs.id "+
+					"ORDER BY rcv.check_order DESC LIMIT ?"+
+					") latest ON latest.version = r_cache.version",
+				retention[team],
+			).
+			Where(sq.Eq{"t.name": team}).
+			Where(sq.Expr("r.active = true")).
+			ToSql()
 		if err != nil {
-			return nil
+			return nil, err
 		}
 
-		deletedCacheIDs = append(deletedCacheIDs, cacheID)
-	}
-
-	if len(deletedCacheIDs) > 0 {
-		logger.Debug("deleted-resource-caches", lager.Data{"id": deletedCacheIDs})
+		validCacheIds = append(validCacheIds, retainedCacheIds)
+		args = append(args, retainedArgs...)
 	}
 
-	return nil
+	return sq.Expr("id NOT IN ("+strings.Join(validCacheIds, " UNION ")+")", args...), nil
 }
 
 func (f *resourceCacheLifecycle) CleanUsesForPausedPipelineResources() error {
@@ -40,7 +40,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 				It("doesn't delete the resource cache", func() {
 					_, _ = resourceCacheForOneOffBuild()
 
-					err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+					_, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(countResourceCaches()).ToNot(BeZero())
 				})
@@ -54,7 +54,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 
 						Expect(countResourceCaches()).ToNot(BeZero())
 
-						err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+						_, err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 						Expect(err).ToNot(HaveOccurred())
 					})
 
@@ -85,7 +85,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 							setBuildStatus(db.BuildStatusSucceeded)
 							Expect(countResourceCaches()).ToNot(BeZero())
 
-							err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+							_, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 							Expect(err).ToNot(HaveOccurred())
 
 							Expect(countResourceCaches()).ToNot(BeZero())
@@ -97,7 +97,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 							setBuildStatus(db.BuildStatusFailed)
 							Expect(countResourceCaches()).ToNot(BeZero())
 
-							err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+							_, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 							Expect(err).ToNot(HaveOccurred())
 
 							Expect(countResourceCaches()).ToNot(BeZero())
@@ -131,7 +131,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 							setBuildStatus(db.BuildStatusSucceeded)
 							Expect(countResourceCaches()).To(Equal(1))
 
-							err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+							_, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 							Expect(err).ToNot(HaveOccurred())
 
 							Expect(countResourceCaches()).To(Equal(1))
@@ -144,7 +144,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 
 							Expect(countResourceCaches()).To(Equal(2))
 
-							err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+							_, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 							Expect(err).ToNot(HaveOccurred())
 
 							Expect(countResourceCaches()).To(Equal(1))
@@ -156,7 +156,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 							setBuildStatus(db.BuildStatusFailed)
 							Expect(countResourceCaches()).ToNot(BeZero())
 
-							err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+							_, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 							Expect(err).ToNot(HaveOccurred())
 
 							Expect(countResourceCaches()).ToNot(BeZero())
@@ -197,7 +197,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 
 			Context("and the container still exists", func() {
 				BeforeEach(func() {
-					err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+					_, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -217,7 +217,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 					_, err = destroyingContainer.Destroy()
 					Expect(err).ToNot(HaveOccurred())
 
-					err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+					_, err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -254,7 +254,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(countResourceCaches()).ToNot(BeZero())
-				err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+				_, err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(countResourceCaches()).ToNot(BeZero())
@@ -293,7 +293,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 
 				Expect(countResourceCaches()).ToNot(BeZero())
 
-				err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+				_, err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(countResourceCaches()).To(BeZero())
@@ -348,12 +348,84 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 
 				Expect(countResourceCaches()).ToNot(BeZero())
 
-				err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+				_, err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"), nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(countResourceCaches()).ToNot(BeZero())
 			})
 		})
+
+		Context("when the cache is for one of the latest versions of a resource", func() {
+			var resourceConfigScope db.ResourceConfigScope
+
+			BeforeEach(func() {
+				var err error
+				resourceConfigScope, err = defaultResource.SetResourceConfig(
+					logger,
+					atc.Source{"some": "source"},
+					creds.NewVersionedResourceTypes(
+						template.StaticVariables{"source-param": "some-secret-sauce"},
+						atc.VersionedResourceTypes{},
+					),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				containerOwner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), db.ContainerOwnerExpiries{})
+
+				container, err := defaultWorker.CreateContainer(containerOwner, db.ContainerMetadata{})
+				Expect(err).ToNot(HaveOccurred())
+
+				_ = createResourceCacheWithUser(db.ForContainer(container.ID()))
+
+				err = resourceConfigScope.SaveVersions([]atc.Version{{"some": "version"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				createdContainer, err := container.Created()
+				Expect(err).ToNot(HaveOccurred())
+
+				destroyingContainer, err := createdContainer.Destroying()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = destroyingContainer.Destroy()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not remove the cache if the team retains it", func() {
+				invalid, err := resourceCacheLifecycle.FindInvalidCaches(logger, db.ResourceCacheRetention{defaultTeam.Name(): 1})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(invalid).To(BeEmpty())
+
+				deleted, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger, db.ResourceCacheRetention{defaultTeam.Name(): 1})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(BeZero())
+
+				Expect(countResourceCaches()).ToNot(BeZero())
+			})
+
+			It("removes the cache if another team retains its caches", func() {
+				invalid, err := resourceCacheLifecycle.FindInvalidCaches(logger, db.ResourceCacheRetention{"some-other-team": 1})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(invalid).To(HaveLen(1))
+
+				Expect(countResourceCaches()).ToNot(BeZero())
+
+				deleted, err := resourceCacheLifecycle.CleanUpInvalidCaches(logger, db.ResourceCacheRetention{"some-other-team": 1})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(Equal(1))
+
+				Expect(countResourceCaches()).To(BeZero())
+			})
+
+			It("removes the cache once there are newer versions than are retained", func() {
+				err := resourceConfigScope.SaveVersions([]atc.Version{{"some": "newer-version"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = resourceCacheLifecycle.CleanUpInvalidCaches(logger, db.ResourceCacheRetention{defaultTeam.Name(): 1})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(countResourceCaches()).To(BeZero())
+			})
+		})
 	})
 })
 
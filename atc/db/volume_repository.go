@@ -430,6 +430,7 @@ func (repository *volumeRepository) GetOrphanedVolumes() ([]CreatedVolume, error
 			sq.Eq{"w.state": string(WorkerStateRunning)},
 			sq.Eq{"w.state": string(WorkerStateLanding)},
 			sq.Eq{"w.state": string(WorkerStateRetiring)},
+			sq.Eq{"w.state": string(WorkerStateCordoned)},
 		}).
 		ToSql()
 	if err != nil {
@@ -298,6 +298,25 @@ var _ = Describe("VolumeFactory", func() {
 			})
 		})
 
+		Context("when worker is cordoned", func() {
+			BeforeEach(func() {
+				err := defaultWorker.Cordon("disk replacement", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("still returns volumes for the worker", func() {
+				createdVolumes, err := volumeRepository.GetOrphanedVolumes()
+				Expect(err).NotTo(HaveOccurred())
+
+				createdHandles := []string{}
+				for _, vol := range createdVolumes {
+					createdHandles = append(createdHandles, vol.Handle())
+				}
+
+				Expect(createdHandles).To(ConsistOf(expectedCreatedHandles))
+			})
+		})
+
 		Context("when worker is landed", func() {
 			BeforeEach(func() {
 				err := defaultWorker.Land()
@@ -16,6 +16,7 @@ import (
 var (
 	ErrWorkerNotPresent         = errors.New("worker not present in db")
 	ErrCannotPruneRunningWorker = errors.New("worker not stalled for pruning")
+	ErrCannotCordonWorker       = errors.New("worker must be running to be cordoned")
 )
 
 type ContainerOwnerDisappearedError struct {
@@ -34,6 +35,7 @@ const (
 	WorkerStateLanding  = WorkerState("landing")
 	WorkerStateLanded   = WorkerState("landed")
 	WorkerStateRetiring = WorkerState("retiring")
+	WorkerStateCordoned = WorkerState("cordoned")
 )
 
 //go:generate counterfeiter . Worker
@@ -54,6 +56,11 @@ type Worker interface {
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
+	Labels() map[string]string
+	Health() *atc.WorkerHealth
+	Cordoned() bool
+	CordonedBy() string
+	CordonReason() string
 	TeamID() int
 	TeamName() string
 	StartTime() int64
@@ -64,9 +71,13 @@ type Worker interface {
 
 	Land() error
 	Retire() error
+	Cordon(reason string, user string) error
+	Uncordon() error
 	Prune() error
 	Delete() error
 
+	RunningBuilds() ([]int, error)
+
 	FindContainerOnWorker(owner ContainerOwner) (CreatingContainer, CreatedContainer, error)
 	CreateContainer(owner ContainerOwner, meta ContainerMetadata) (CreatingContainer, error)
 }
@@ -87,6 +98,10 @@ type worker struct {
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
+	labels           map[string]string
+	health           *atc.WorkerHealth
+	cordonedBy       *string
+	cordonReason     string
 	teamID           int
 	teamName         string
 	startTime        int64
@@ -110,10 +125,25 @@ func (worker *worker) ActiveVolumes() int                      { return worker.a
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
+func (worker *worker) Labels() map[string]string               { return worker.labels }
+func (worker *worker) Health() *atc.WorkerHealth               { return worker.health }
+func (worker *worker) CordonReason() string                    { return worker.cordonReason }
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
 
+// Cordoned returns true if the worker has been cordoned and not yet
+// uncordoned, even if it has since stalled or started landing.
+func (worker *worker) Cordoned() bool { return worker.cordonedBy != nil }
+
+func (worker *worker) CordonedBy() string {
+	if worker.cordonedBy == nil {
+		return ""
+	}
+
+	return *worker.cordonedBy
+}
+
 // TODO: normalize time values
 func (worker *worker) StartTime() int64     { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
@@ -165,6 +195,49 @@ func (worker *worker) Land() error {
 	return nil
 }
 
+// RunningBuilds returns the IDs of the pending or started builds which own
+// containers on the worker and would prevent it from landing, i.e. one-off
+// builds and builds of uninterruptible jobs.
+func (worker *worker) RunningBuilds() ([]int, error) {
+	rows, err := psql.Select("b.id").
+		Distinct().
+		From("builds b").
+		Join("containers c ON b.id = c.build_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		Where(sq.Eq{
+			"c.worker_name": worker.name,
+			"b.status": []string{
+				string(BuildStatusPending),
+				string(BuildStatusStarted),
+			},
+		}).
+		Where(sq.Or{
+			sq.Eq{"j.interruptible": false},
+			sq.Eq{"b.job_id": nil},
+		}).
+		OrderBy("b.id").
+		RunWith(worker.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	buildIDs := []int{}
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		buildIDs = append(buildIDs, id)
+	}
+
+	return buildIDs, nil
+}
+
 func (worker *worker) Retire() error {
 	result, err := psql.Update("workers").
 		SetMap(map[string]interface{}{
@@ -189,13 +262,102 @@ func (worker *worker) Retire() error {
 	return nil
 }
 
+// Cordon prevents any new containers from being placed on the running worker,
+// while leaving its existing containers alone. The worker stays cordoned
+// across re-registrations until it is uncordoned.
+func (worker *worker) Cordon(reason string, user string) error {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"state":         string(WorkerStateCordoned),
+			"cordoned_by":   user,
+			"cordon_reason": reason,
+		}).
+		Where(sq.Eq{
+			"name": worker.name,
+			"state": []string{
+				string(WorkerStateRunning),
+				string(WorkerStateCordoned),
+			},
+		}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		found, err := worker.exists()
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return ErrWorkerNotPresent
+		}
+
+		return ErrCannotCordonWorker
+	}
+
+	return nil
+}
+
+// Uncordon allows new containers to be placed on the worker again. Workers
+// which are not cordoned are left as-is.
+func (worker *worker) Uncordon() error {
+	result, err := psql.Update("workers").
+		Set("state", sq.Expr(`(CASE WHEN state = 'cordoned'::worker_state THEN 'running'::worker_state ELSE state END)`)).
+		Set("cordoned_by", nil).
+		Set("cordon_reason", nil).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	return nil
+}
+
+func (worker *worker) exists() (bool, error) {
+	var one int
+	err := psql.Select("1").From("workers").Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&one)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (worker *worker) Prune() error {
 	rows, err := sq.Delete("workers").
 		Where(sq.Eq{
 			"name": worker.name,
 		}).
 		Where(sq.NotEq{
-			"state": string(WorkerStateRunning),
+			"state": []string{
+				string(WorkerStateRunning),
+				string(WorkerStateCordoned),
+			},
 		}).
 		PlaceholderFormat(sq.Dollar).
 		RunWith(worker.conn).
@@ -212,18 +374,15 @@ func (worker *worker) Prune() error {
 
 	if affected == 0 {
 		//check whether the worker exists in the database at all
-		var one int
-		err := psql.Select("1").From("workers").Where(sq.Eq{"name": worker.name}).
-			RunWith(worker.conn).
-			QueryRow().
-			Scan(&one)
+		found, err := worker.exists()
 		if err != nil {
-			if err == sql.ErrNoRows {
-				return ErrWorkerNotPresent
-			}
 			return err
 		}
 
+		if !found {
+			return ErrWorkerNotPresent
+		}
+
 		return ErrCannotPruneRunningWorker
 	}
 
@@ -49,6 +49,10 @@ var workersQuery = psql.Select(`
 		w.resource_types,
 		w.platform,
 		w.tags,
+		w.labels,
+		w.health,
+		w.cordoned_by,
+		w.cordon_reason,
 		t.name,
 		w.team_id,
 		w.start_time,
@@ -141,6 +145,10 @@ func scanWorker(worker *worker, row scannable) error {
 		resourceTypes []byte
 		platform      sql.NullString
 		tags          []byte
+		labels        []byte
+		health        []byte
+		cordonedBy    sql.NullString
+		cordonReason  sql.NullString
 		teamName      sql.NullString
 		teamID        sql.NullInt64
 		startTime     sql.NullInt64
@@ -163,6 +171,10 @@ func scanWorker(worker *worker, row scannable) error {
 		&resourceTypes,
 		&platform,
 		&tags,
+		&labels,
+		&health,
+		&cordonedBy,
+		&cordonReason,
 		&teamName,
 		&teamID,
 		&startTime,
@@ -211,6 +223,14 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.noProxy = noProxy.String
 	}
 
+	if cordonedBy.Valid {
+		worker.cordonedBy = &cordonedBy.String
+	}
+
+	if cordonReason.Valid {
+		worker.cordonReason = cordonReason.String
+	}
+
 	if teamName.Valid {
 		worker.teamName = teamName.String
 	}
@@ -232,7 +252,26 @@ func scanWorker(worker *worker, row scannable) error {
 		return err
 	}
 
-	return json.Unmarshal(tags, &worker.tags)
+	err = json.Unmarshal(tags, &worker.tags)
+	if err != nil {
+		return err
+	}
+
+	if labels != nil {
+		err = json.Unmarshal(labels, &worker.labels)
+		if err != nil {
+			return err
+		}
+	}
+
+	if health != nil {
+		err = json.Unmarshal(health, &worker.health)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error) {
@@ -253,10 +292,11 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
 	}
 
-	cSQL, _, err := sq.Case("state").
-		When("'landing'::worker_state", "'landing'::worker_state").
-		When("'landed'::worker_state", "'landed'::worker_state").
-		When("'retiring'::worker_state", "'retiring'::worker_state").
+	cSQL, _, err := sq.Case().
+		When("state = 'landing'::worker_state", "'landing'::worker_state").
+		When("state = 'landed'::worker_state", "'landed'::worker_state").
+		When("state = 'retiring'::worker_state", "'retiring'::worker_state").
+		When("cordoned_by IS NOT NULL", "'cordoned'::worker_state").
 		Else("'running'::worker_state").
 		ToSql()
 
@@ -264,10 +304,16 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		return nil, err
 	}
 
+	health, err := marshalWorkerHealth(atcWorker.Health)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = psql.Update("workers").
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("health", health).
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
@@ -412,6 +458,16 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	labels, err := json.Marshal(atcWorker.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := marshalWorkerHealth(atcWorker.Health)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -427,10 +483,24 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 
 	currWorker, found, err := getWorker(tx, workersQuery.Where(sq.Eq{"w.name": atcWorker.Name}))
 
+	var cordonedBy *string
+	var cordonReason string
 	if found {
 		if (currWorker.State() == WorkerStateLanding || currWorker.State() == WorkerStateRetiring) && atcWorker.State == "" {
 			workerState = currWorker.State()
 		}
+
+		// stay cordoned until explicitly uncordoned, even if the worker was
+		// restarted in the meantime
+		if currWorker.Cordoned() {
+			by := currWorker.CordonedBy()
+			cordonedBy = &by
+			cordonReason = currWorker.CordonReason()
+
+			if workerState == WorkerStateRunning {
+				workerState = WorkerStateCordoned
+			}
+		}
 	}
 
 	var workerVersion *string
@@ -444,6 +514,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.ActiveVolumes,
 		resourceTypes,
 		tags,
+		labels,
+		health,
 		atcWorker.Platform,
 		atcWorker.BaggageclaimURL,
 		atcWorker.CertsPath,
@@ -475,6 +547,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"active_volumes",
 			"resource_types",
 			"tags",
+			"labels",
+			"health",
 			"platform",
 			"baggageclaim_url",
 			"certs_path",
@@ -497,6 +571,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				active_volumes = ?,
 				resource_types = ?,
 				tags = ?,
+				labels = ?,
+				health = ?,
 				platform = ?,
 				baggageclaim_url = ?,
 				certs_path = ?,
@@ -547,6 +623,10 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
+		labels:           atcWorker.Labels,
+		health:           atcWorker.Health,
+		cordonedBy:       cordonedBy,
+		cordonReason:     cordonReason,
 		teamName:         atcWorker.Team,
 		teamID:           workerTeamID,
 		startTime:        atcWorker.StartTime,
@@ -618,3 +698,11 @@ insert_coin:
 
 	return true
 }
+
+func marshalWorkerHealth(health *atc.WorkerHealth) ([]byte, error) {
+	if health == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(health)
+}
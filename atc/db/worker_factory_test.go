@@ -45,6 +45,7 @@ var _ = Describe("WorkerFactory", func() {
 			},
 			Platform:  "some-platform",
 			Tags:      atc.Tags{"some", "tags"},
+			Labels:    map[string]string{"arch": "arm64"},
 			Name:      "some-name",
 			StartTime: 55,
 		}
@@ -275,6 +276,7 @@ var _ = Describe("WorkerFactory", func() {
 				}))
 				Expect(foundWorker.Platform()).To(Equal("some-platform"))
 				Expect(foundWorker.Tags()).To(Equal([]string{"some", "tags"}))
+				Expect(foundWorker.Labels()).To(Equal(map[string]string{"arch": "arm64"}))
 				Expect(foundWorker.StartTime()).To(Equal(int64(55)))
 				Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
 			})
@@ -468,6 +470,19 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(*foundWorker.BaggageclaimURL()).To(Equal("some-bc-url"))
 			})
 
+			It("updates the reported health", func() {
+				atcWorker.Health = &atc.WorkerHealth{
+					CheckedAt: 42,
+					Volumes:   3,
+					Breaches:  []string{"too many volumes"},
+				}
+
+				foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(foundWorker.Health()).To(Equal(atcWorker.Health))
+			})
+
 			Context("when the current state is landing", func() {
 				BeforeEach(func() {
 					atcWorker.State = string(db.WorkerStateLanding)
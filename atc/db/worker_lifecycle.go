@@ -51,7 +51,10 @@ func (lifecycle *workerLifecycle) StallUnresponsiveWorkers() ([]string, error) {
 			"state":   string(WorkerStateStalled),
 			"expires": nil,
 		}).
-		Where(sq.Eq{"state": string(WorkerStateRunning)}).
+		Where(sq.Eq{"state": []string{
+			string(WorkerStateRunning),
+			string(WorkerStateCordoned),
+		}}).
 		Where(sq.Expr("expires < NOW()")).
 		Suffix("RETURNING name").
 		ToSql()
@@ -100,6 +100,23 @@ var _ = Describe("Worker Lifecycle", func() {
 				Expect(len(stalledWorkers)).To(Equal(1))
 				Expect(stalledWorkers[0]).To(Equal("some-name"))
 			})
+
+			Context("when the worker is cordoned", func() {
+				BeforeEach(func() {
+					dbWorker, found, err := workerFactory.GetWorker("some-name")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					err = dbWorker.Cordon("some-reason", "some-user")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("marks the worker as `stalled`", func() {
+					stalledWorkers, err := workerLifecycle.StallUnresponsiveWorkers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(stalledWorkers).To(Equal([]string{"some-name"}))
+				})
+			})
 		})
 	})
 
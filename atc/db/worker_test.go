@@ -99,6 +99,103 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("Cordon", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the worker is running", func() {
+			It("marks the worker as `cordoned` with the reason and user", func() {
+				err := worker.Cordon("disk replacement", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateCordoned))
+				Expect(worker.Cordoned()).To(BeTrue())
+				Expect(worker.CordonedBy()).To(Equal("some-user"))
+				Expect(worker.CordonReason()).To(Equal("disk replacement"))
+			})
+
+			It("stays cordoned when heartbeating", func() {
+				err := worker.Cordon("disk replacement", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+
+				worker, err = workerFactory.HeartbeatWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateCordoned))
+			})
+
+			It("stays cordoned when re-registering", func() {
+				err := worker.Cordon("disk replacement", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+
+				worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateCordoned))
+				Expect(worker.CordonedBy()).To(Equal("some-user"))
+				Expect(worker.CordonReason()).To(Equal("disk replacement"))
+			})
+		})
+
+		Context("when the worker is landing", func() {
+			BeforeEach(func() {
+				err := worker.Land()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.Cordon("disk replacement", "some-user")
+				Expect(err).To(Equal(ErrCannotCordonWorker))
+			})
+		})
+
+		Context("when the worker is not present", func() {
+			It("returns an error", func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = worker.Cordon("disk replacement", "some-user")
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
+	Describe("Uncordon", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = worker.Cordon("disk replacement", "some-user")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("marks the worker as `running` and clears the reason and user", func() {
+			err := worker.Uncordon()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateRunning))
+			Expect(worker.Cordoned()).To(BeFalse())
+			Expect(worker.CordonedBy()).To(BeEmpty())
+			Expect(worker.CordonReason()).To(BeEmpty())
+		})
+
+		Context("when the worker is not present", func() {
+			It("returns an error", func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = worker.Uncordon()
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
 	Describe("Retire", func() {
 		BeforeEach(func() {
 			var err error
@@ -149,6 +246,88 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("RunningBuilds", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the worker has no containers", func() {
+			It("returns no builds", func() {
+				buildIDs, err := worker.RunningBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buildIDs).To(BeEmpty())
+			})
+		})
+
+		DescribeTable("with a one-off build that is",
+			func(s BuildStatus, running bool) {
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				switch s {
+				case BuildStatusPending:
+				case BuildStatusStarted:
+					_, err := build.Start("exec.v2", "{}", atc.Plan{})
+					Expect(err).NotTo(HaveOccurred())
+				default:
+					err := build.Finish(s)
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				_, err = worker.CreateContainer(NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-plan"), defaultTeam.ID()), ContainerMetadata{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.CreateContainer(NewBuildStepContainerOwner(build.ID(), atc.PlanID("other-plan"), defaultTeam.ID()), ContainerMetadata{})
+				Expect(err).NotTo(HaveOccurred())
+
+				buildIDs, err := worker.RunningBuilds()
+				Expect(err).NotTo(HaveOccurred())
+
+				if running {
+					Expect(buildIDs).To(Equal([]int{build.ID()}))
+				} else {
+					Expect(buildIDs).To(BeEmpty())
+				}
+			},
+			Entry("pending", BuildStatusPending, true),
+			Entry("started", BuildStatusStarted, true),
+			Entry("aborted", BuildStatusAborted, false),
+			Entry("succeeded", BuildStatusSucceeded, false),
+			Entry("failed", BuildStatusFailed, false),
+			Entry("errored", BuildStatusErrored, false),
+		)
+
+		Context("when the worker has a build of an interruptible job", func() {
+			It("does not return the build", func() {
+				pipeline, _, err := defaultTeam.SavePipeline("interruptible-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name:          "some-job",
+							Interruptible: true,
+						},
+					},
+				}, ConfigVersion(0), PipelineUnpaused)
+				Expect(err).NotTo(HaveOccurred())
+
+				job, found, err := pipeline.Job("some-job")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				build, err := job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.CreateContainer(NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-plan"), defaultTeam.ID()), ContainerMetadata{})
+				Expect(err).NotTo(HaveOccurred())
+
+				buildIDs, err := worker.RunningBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buildIDs).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Prune", func() {
 		Context("when worker exists", func() {
 			DescribeTable("worker in state",
@@ -169,6 +348,34 @@ var _ = Describe("Worker", func() {
 				Entry("retiring", "retiring", BeNil()),
 			)
 
+			Context("when worker is cordoned", func() {
+				var pruneErr error
+
+				BeforeEach(func() {
+					worker, err := workerFactory.SaveWorker(atc.Worker{
+						Name:       "worker-to-prune",
+						GardenAddr: "1.2.3.4",
+						State:      "running",
+					}, 5*time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = worker.Cordon("disk replacement", "some-user")
+					Expect(err).NotTo(HaveOccurred())
+
+					pruneErr = worker.Prune()
+				})
+
+				It("returns ErrCannotPruneRunningWorker", func() {
+					Expect(pruneErr).To(Equal(ErrCannotPruneRunningWorker))
+				})
+
+				It("does not prune the worker", func() {
+					_, found, err := workerFactory.GetWorker("worker-to-prune")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+
 			Context("when worker is stalled", func() {
 				var pruneErr error
 				BeforeEach(func() {
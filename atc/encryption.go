@@ -0,0 +1,17 @@
+package atc
+
+type EncryptionKeyRotation struct {
+	KeyFingerprint string                       `json:"key_fingerprint"`
+	Completed      bool                         `json:"completed"`
+	Tables         []EncryptionKeyRotationTable `json:"tables"`
+}
+
+type EncryptionKeyRotationTable struct {
+	Table             string `json:"table"`
+	LastID            int    `json:"last_id"`
+	RowsRotated       int    `json:"rows_rotated"`
+	RowsRemaining     int    `json:"rows_remaining"`
+	RowsUndecryptable int    `json:"rows_undecryptable"`
+	Completed         bool   `json:"completed"`
+	UpdatedAt         int64  `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,141 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+const (
+	DefaultLogBatchInterval  = 100 * time.Millisecond
+	DefaultLogBatchMaxEvents = 100
+)
+
+// BatchingBuild buffers the log events saved to a build and saves them
+// together, either once the interval has elapsed since the first buffered
+// event or once maxEvents have been buffered.
+//
+// Any other event, and finishing the build, saves the buffered events first
+// so that the order of the build's events is preserved. An error saving a
+// batch in the background is returned by the next call to SaveEvent.
+type BatchingBuild struct {
+	db.Build
+
+	clock     clock.Clock
+	interval  time.Duration
+	maxEvents int
+
+	lock      sync.Mutex
+	pending   []atc.Event
+	scheduled bool
+	err       error
+}
+
+func NewBatchingBuild(build db.Build, clock clock.Clock, interval time.Duration, maxEvents int) *BatchingBuild {
+	return &BatchingBuild{
+		Build: build,
+
+		clock:     clock,
+		interval:  interval,
+		maxEvents: maxEvents,
+	}
+}
+
+func (build *BatchingBuild) SaveEvent(ev atc.Event) error {
+	build.lock.Lock()
+	defer build.lock.Unlock()
+
+	if build.err != nil {
+		err := build.err
+		build.err = nil
+		return err
+	}
+
+	if ev.EventType() != event.EventTypeLog {
+		err := build.flush()
+		if err != nil {
+			return err
+		}
+
+		return build.Build.SaveEvent(ev)
+	}
+
+	build.pending = append(build.pending, ev)
+
+	if len(build.pending) >= build.maxEvents {
+		return build.flush()
+	}
+
+	if !build.scheduled {
+		build.scheduled = true
+		go build.flushAfter(build.clock.NewTimer(build.interval))
+	}
+
+	return nil
+}
+
+func (build *BatchingBuild) SaveEvents(events []atc.Event) error {
+	build.lock.Lock()
+	defer build.lock.Unlock()
+
+	err := build.flush()
+	if err != nil {
+		return err
+	}
+
+	return build.Build.SaveEvents(events)
+}
+
+// Finish saves any buffered events and finishes the build. The build is
+// finished even if the buffered events could not be saved.
+func (build *BatchingBuild) Finish(status db.BuildStatus) error {
+	flushErr := build.Flush()
+
+	err := build.Build.Finish(status)
+	if err != nil {
+		return err
+	}
+
+	return flushErr
+}
+
+// Flush saves any buffered events.
+func (build *BatchingBuild) Flush() error {
+	build.lock.Lock()
+	defer build.lock.Unlock()
+
+	return build.flush()
+}
+
+func (build *BatchingBuild) flushAfter(timer clock.Timer) {
+	<-timer.C()
+
+	build.lock.Lock()
+	defer build.lock.Unlock()
+
+	build.scheduled = false
+
+	err := build.flush()
+	if err != nil {
+		build.err = err
+	}
+}
+
+func (build *BatchingBuild) flush() error {
+	if len(build.pending) == 0 {
+		return nil
+	}
+
+	err := build.Build.SaveEvents(build.pending)
+	if err != nil {
+		return err
+	}
+
+	build.pending = nil
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package engine_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BatchingBuild", func() {
+	var (
+		fakeBuild *dbfakes.FakeBuild
+		fakeClock *fakeclock.FakeClock
+
+		build *engine.BatchingBuild
+	)
+
+	BeforeEach(func() {
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
+		build = engine.NewBatchingBuild(fakeBuild, fakeClock, time.Second, 3)
+	})
+
+	It("buffers log events until the interval has elapsed", func() {
+		Expect(build.SaveEvent(event.Log{Payload: "a"})).To(Succeed())
+		Expect(build.SaveEvent(event.Log{Payload: "b"})).To(Succeed())
+
+		Expect(fakeBuild.SaveEventsCallCount()).To(Equal(0))
+		Expect(fakeBuild.SaveEventCallCount()).To(Equal(0))
+
+		fakeClock.WaitForWatcherAndIncrement(time.Second)
+
+		Eventually(fakeBuild.SaveEventsCallCount).Should(Equal(1))
+		Expect(fakeBuild.SaveEventsArgsForCall(0)).To(Equal([]atc.Event{
+			event.Log{Payload: "a"},
+			event.Log{Payload: "b"},
+		}))
+	})
+
+	It("saves the batch once it is full", func() {
+		Expect(build.SaveEvent(event.Log{Payload: "a"})).To(Succeed())
+		Expect(build.SaveEvent(event.Log{Payload: "b"})).To(Succeed())
+		Expect(build.SaveEvent(event.Log{Payload: "c"})).To(Succeed())
+
+		Expect(fakeBuild.SaveEventsCallCount()).To(Equal(1))
+		Expect(fakeBuild.SaveEventsArgsForCall(0)).To(HaveLen(3))
+	})
+
+	It("saves buffered log events before any other event", func() {
+		Expect(build.SaveEvent(event.Log{Payload: "a"})).To(Succeed())
+		Expect(build.SaveEvent(event.FinishTask{ExitStatus: 0})).To(Succeed())
+
+		Expect(fakeBuild.SaveEventsCallCount()).To(Equal(1))
+		Expect(fakeBuild.SaveEventsArgsForCall(0)).To(Equal([]atc.Event{
+			event.Log{Payload: "a"},
+		}))
+
+		Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+		Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.FinishTask{ExitStatus: 0}))
+	})
+
+	It("saves buffered log events before finishing the build", func() {
+		Expect(build.SaveEvent(event.Log{Payload: "a"})).To(Succeed())
+		Expect(build.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+		Expect(fakeBuild.SaveEventsCallCount()).To(Equal(1))
+		Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+		Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusSucceeded))
+	})
+
+	Context("when saving a batch in the background fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.SaveEventsReturnsOnCall(0, disaster)
+		})
+
+		It("returns the error from the next call to SaveEvent", func() {
+			Expect(build.SaveEvent(event.Log{Payload: "a"})).To(Succeed())
+
+			fakeClock.WaitForWatcherAndIncrement(time.Second)
+			Eventually(fakeBuild.SaveEventsCallCount).Should(Equal(1))
+
+			Eventually(func() error {
+				return build.SaveEvent(event.Log{Payload: "b"})
+			}).Should(Equal(disaster))
+		})
+	})
+
+	Context("when saving buffered events fails while finishing", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.SaveEventsReturns(disaster)
+		})
+
+		It("still finishes the build", func() {
+			Expect(build.SaveEvent(event.Log{Payload: "a"})).To(Succeed())
+			Expect(build.Finish(db.BuildStatusFailed)).To(Equal(disaster))
+			Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -35,7 +35,7 @@ func NewBuildDelegateFactory() BuildDelegateFactory {
 }
 
 func (factory buildDelegateFactory) Delegate(build db.Build) BuildDelegate {
-	return newBuildDelegate(build)
+	return newBuildDelegate(NewBatchingBuild(build, clock.NewClock(), DefaultLogBatchInterval, DefaultLogBatchMaxEvents))
 }
 
 type delegate struct {
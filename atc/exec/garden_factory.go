@@ -67,6 +67,7 @@ func (factory *gardenFactory) Get(
 		creds.NewParams(variables, plan.Get.Params),
 		NewVersionSourceFromPlan(plan.Get),
 		plan.Get.Tags,
+		plan.Get.WorkerSelector,
 
 		delegate,
 		factory.resourceFetcher,
@@ -111,6 +112,7 @@ func (factory *gardenFactory) Put(
 		creds.NewSource(variables, plan.Put.Source),
 		creds.NewParams(variables, plan.Put.Params),
 		plan.Put.Tags,
+		plan.Put.WorkerSelector,
 		putInputs,
 
 		delegate,
@@ -167,6 +169,7 @@ func (factory *gardenFactory) Task(
 		Privileged(plan.Task.Privileged),
 		taskConfigSource,
 		plan.Task.Tags,
+		plan.Task.WorkerSelector,
 		plan.Task.InputMapping,
 		plan.Task.OutputMapping,
 
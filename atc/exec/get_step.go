@@ -45,13 +45,14 @@ type GetDelegate interface {
 type GetStep struct {
 	build db.Build
 
-	name          string
-	resourceType  string
-	resource      string
-	source        creds.Source
-	params        creds.Params
-	versionSource VersionSource
-	tags          atc.Tags
+	name           string
+	resourceType   string
+	resource       string
+	source         creds.Source
+	params         creds.Params
+	versionSource  VersionSource
+	tags           atc.Tags
+	workerSelector atc.WorkerSelector
 
 	delegate GetDelegate
 
@@ -78,6 +79,7 @@ func NewGetStep(
 	params creds.Params,
 	versionSource VersionSource,
 	tags atc.Tags,
+	workerSelector atc.WorkerSelector,
 
 	delegate GetDelegate,
 
@@ -94,13 +96,14 @@ func NewGetStep(
 	return &GetStep{
 		build: build,
 
-		name:          name,
-		resourceType:  resourceType,
-		resource:      resource,
-		source:        source,
-		params:        params,
-		versionSource: versionSource,
-		tags:          tags,
+		name:           name,
+		resourceType:   resourceType,
+		resource:       resource,
+		source:         source,
+		params:         params,
+		versionSource:  versionSource,
+		tags:           tags,
+		workerSelector: workerSelector,
 
 		delegate: delegate,
 
@@ -188,6 +191,7 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 			Metadata: step.containerMetadata,
 		},
 		step.tags,
+		step.workerSelector,
 		step.teamID,
 		step.resourceTypes,
 		resourceInstance,
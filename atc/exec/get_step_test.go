@@ -111,6 +111,7 @@ var _ = Describe("GetStep", func() {
 			Source:                 atc.Source{"some": "((source-param))"},
 			Params:                 atc.Params{"some-param": "some-value"},
 			Tags:                   []string{"some", "tags"},
+			WorkerSelector:         atc.WorkerSelector{"arch": "arm64"},
 			Version:                &atc.Version{"some-version": "some-value"},
 			VersionedResourceTypes: resourceTypes,
 		}
@@ -145,7 +146,7 @@ var _ = Describe("GetStep", func() {
 		Expect(stepErr).ToNot(HaveOccurred())
 
 		Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(1))
-		fctx, _, sid, tags, actualTeamID, actualResourceTypes, resourceInstance, sm, delegate := fakeResourceFetcher.FetchArgsForCall(0)
+		fctx, _, sid, tags, workerSelector, actualTeamID, actualResourceTypes, resourceInstance, sm, delegate := fakeResourceFetcher.FetchArgsForCall(0)
 		Expect(fctx).To(Equal(ctx))
 		Expect(sm).To(Equal(stepMetadata))
 		Expect(sid).To(Equal(resource.Session{
@@ -157,6 +158,7 @@ var _ = Describe("GetStep", func() {
 			},
 		}))
 		Expect(tags).To(ConsistOf("some", "tags"))
+		Expect(workerSelector).To(Equal(atc.WorkerSelector{"arch": "arm64"}))
 		Expect(actualTeamID).To(Equal(teamID))
 		Expect(resourceInstance).To(Equal(resource.NewResourceInstance(
 			"some-resource-type",
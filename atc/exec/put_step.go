@@ -25,13 +25,14 @@ type PutDelegate interface {
 type PutStep struct {
 	build db.Build
 
-	name         string
-	resourceType string
-	resource     string
-	source       creds.Source
-	params       creds.Params
-	tags         atc.Tags
-	inputs       PutInputs
+	name           string
+	resourceType   string
+	resource       string
+	source         creds.Source
+	params         creds.Params
+	tags           atc.Tags
+	workerSelector atc.WorkerSelector
+	inputs         PutInputs
 
 	delegate              PutDelegate
 	resourceFactory       resource.ResourceFactory
@@ -54,6 +55,7 @@ func NewPutStep(
 	source creds.Source,
 	params creds.Params,
 	tags atc.Tags,
+	workerSelector atc.WorkerSelector,
 	inputs PutInputs,
 	delegate PutDelegate,
 	resourceFactory resource.ResourceFactory,
@@ -72,6 +74,7 @@ func NewPutStep(
 		source:                source,
 		params:                params,
 		tags:                  tags,
+		workerSelector:        workerSelector,
 		inputs:                inputs,
 		delegate:              delegate,
 		resourceFactory:       resourceFactory,
@@ -118,6 +121,8 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		Tags:          step.tags,
 		TeamID:        step.build.TeamID(),
 		ResourceTypes: step.resourceTypes,
+
+		WorkerSelector: step.workerSelector,
 	}
 
 	putResource, err := step.resourceFactory.NewResource(
@@ -114,6 +114,7 @@ var _ = Describe("PutStep", func() {
 			creds.NewSource(variables, atc.Source{"some": "((source-param))"}),
 			creds.NewParams(variables, atc.Params{"some-param": "some-value"}),
 			[]string{"some", "tags"},
+			atc.WorkerSelector{"arch": "arm64"},
 			putInputs,
 			fakeDelegate,
 			fakeResourceFactory,
@@ -188,6 +189,8 @@ var _ = Describe("PutStep", func() {
 					Tags:          []string{"some", "tags"},
 					ResourceType:  "some-resource-type",
 					ResourceTypes: resourceTypes,
+
+					WorkerSelector: atc.WorkerSelector{"arch": "arm64"},
 				}))
 
 				Expect([]worker.ArtifactSource{
@@ -68,11 +68,12 @@ type TaskDelegate interface {
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
 // worker.ArtifactRepository and outputs will be added to the worker.ArtifactRepository.
 type TaskStep struct {
-	privileged    Privileged
-	configSource  TaskConfigSource
-	tags          atc.Tags
-	inputMapping  map[string]string
-	outputMapping map[string]string
+	privileged     Privileged
+	configSource   TaskConfigSource
+	tags           atc.Tags
+	workerSelector atc.WorkerSelector
+	inputMapping   map[string]string
+	outputMapping  map[string]string
 
 	artifactsRoot     string
 	imageArtifactName string
@@ -98,6 +99,7 @@ func NewTaskStep(
 	privileged Privileged,
 	configSource TaskConfigSource,
 	tags atc.Tags,
+	workerSelector atc.WorkerSelector,
 	inputMapping map[string]string,
 	outputMapping map[string]string,
 	artifactsRoot string,
@@ -117,6 +119,7 @@ func NewTaskStep(
 		privileged:        privileged,
 		configSource:      configSource,
 		tags:              tags,
+		workerSelector:    workerSelector,
 		inputMapping:      inputMapping,
 		outputMapping:     outputMapping,
 		artifactsRoot:     artifactsRoot,
@@ -411,6 +414,8 @@ func (action *TaskStep) workerSpec(logger lager.Logger, resourceTypes creds.Vers
 		Tags:          action.tags,
 		TeamID:        action.teamID,
 		ResourceTypes: resourceTypes,
+
+		WorkerSelector: action.workerSelector,
 	}
 
 	imageSpec, err := action.imageSpec(logger, repository, config)
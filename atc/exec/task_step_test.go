@@ -45,6 +45,7 @@ var _ = Describe("TaskStep", func() {
 
 		privileged    exec.Privileged
 		tags          []string
+		selector      atc.WorkerSelector
 		teamID        int
 		buildID       int
 		planID        atc.PlanID
@@ -77,6 +78,7 @@ var _ = Describe("TaskStep", func() {
 
 		privileged = false
 		tags = []string{"step", "tags"}
+		selector = atc.WorkerSelector{"arch": "arm64"}
 		teamID = 123
 		planID = atc.PlanID(42)
 		buildID = 1234
@@ -116,6 +118,7 @@ var _ = Describe("TaskStep", func() {
 			privileged,
 			configSource,
 			tags,
+			selector,
 			inputMapping,
 			outputMapping,
 			"some-artifact-root",
@@ -232,6 +235,8 @@ var _ = Describe("TaskStep", func() {
 					TeamID:        teamID,
 					ResourceType:  "docker",
 					ResourceTypes: resourceTypes,
+
+					WorkerSelector: selector,
 				}))
 				Expect(actualResourceTypes).To(Equal(resourceTypes))
 			})
@@ -282,6 +287,8 @@ var _ = Describe("TaskStep", func() {
 						Tags:          []string{"step", "tags"},
 						TeamID:        teamID,
 						ResourceTypes: resourceTypes,
+
+						WorkerSelector: selector,
 					}))
 
 					Expect(actualResourceTypes).To(Equal(resourceTypes))
@@ -1313,6 +1320,8 @@ var _ = Describe("TaskStep", func() {
 							ResourceTypes: resourceTypes,
 							Tags:          []string{"step", "tags"},
 							ResourceType:  "docker",
+
+							WorkerSelector: selector,
 						}))
 					})
 				})
@@ -1341,6 +1350,8 @@ var _ = Describe("TaskStep", func() {
 							Platform:      "some-platform",
 							ResourceTypes: resourceTypes,
 							Tags:          []string{"step", "tags"},
+
+							WorkerSelector: selector,
 						}))
 					})
 				})
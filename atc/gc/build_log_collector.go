@@ -2,9 +2,13 @@ package gc
 
 import (
 	"context"
+	"time"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/archive"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 )
 
 type buildLogCollector struct {
@@ -12,19 +16,29 @@ type buildLogCollector struct {
 	batchSize                   int
 	drainerConfigured           bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
+	archiver                    archive.Archiver
+	dryRun                      bool
 }
 
+// NewBuildLogCollector constructs a collector which reaps the logs of builds
+// outside of their job's retention policy. If an archiver is given, each
+// build's logs are archived before they are reaped, and reaping stops if a
+// build fails to be archived.
 func NewBuildLogCollector(
 	pipelineFactory db.PipelineFactory,
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	archiver archive.Archiver,
+	dryRun bool,
 ) Collector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
+		archiver:                    archiver,
+		dryRun:                      dryRun,
 	}
 }
 
@@ -40,6 +54,16 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 		return err
 	}
 
+	reaped := 0
+	defer func() {
+		metric.GarbageCollectionDestroyed{
+			Collector: "build-log",
+			Kind:      "build-logs",
+			Count:     reaped,
+			DryRun:    br.dryRun,
+		}.Emit(logger)
+	}()
+
 	for _, pipeline := range pipelines {
 		if pipeline.Paused() {
 			continue
@@ -52,8 +76,8 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 		}
 
 		for _, job := range jobs {
-			buildLogsToRetain := br.buildLogRetentionCalculator.BuildLogsToRetain(job)
-			if buildLogsToRetain == 0 {
+			retention := br.buildLogRetentionCalculator.BuildLogsToRetain(job)
+			if retention.Builds == 0 && retention.Days == 0 {
 				continue
 			}
 
@@ -95,25 +119,85 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 				buildIDsToConsiderDeleting = append(buildIDsToConsiderDeleting, build.ID())
 			}
 
-			buildsToRetain, _, err := job.Builds(
-				db.Page{Limit: buildLogsToRetain},
-			)
-			if err != nil {
-				logger.Error("failed-to-get-job-builds-to-retain", err)
-				return err
+			// builds from firstBuildToRetain onwards are within the build count
+			firstBuildToRetain := 0
+			if retention.Builds > 0 {
+				buildsToRetain, _, err := job.Builds(
+					db.Page{Limit: retention.Builds},
+				)
+				if err != nil {
+					logger.Error("failed-to-get-job-builds-to-retain", err)
+					return err
+				}
+
+				if len(buildsToRetain) == 0 {
+					continue
+				}
+
+				firstBuildToRetain = buildsToRetain[len(buildsToRetain)-1].ID()
 			}
 
-			if len(buildsToRetain) == 0 {
-				continue
+			// succeeded builds from firstSucceededBuildToRetain onwards are kept
+			// regardless of the build count and age
+			firstSucceededBuildToRetain := 0
+			if retention.MinimumSucceededBuilds > 0 {
+				succeededBuildIDs, err := job.LatestSucceededBuildIDs(retention.MinimumSucceededBuilds)
+				if err != nil {
+					logger.Error("failed-to-get-job-succeeded-builds-to-retain", err)
+					return err
+				}
+
+				if len(succeededBuildIDs) > 0 {
+					firstSucceededBuildToRetain = succeededBuildIDs[len(succeededBuildIDs)-1]
+				}
 			}
 
-			firstBuildToRetain := buildsToRetain[len(buildsToRetain)-1].ID()
+			expiry := time.Now().AddDate(0, 0, -retention.Days)
 
+			buildsToDelete := []db.Build{}
 			buildIDsToDelete := []int{}
+
+			// succeeded builds retained by previous runs are behind
+			// FirstLoggedBuildID, so they are re-checked separately and reaped
+			// once enough newer builds have succeeded
+			if retention.MinimumSucceededBuilds > 0 && job.FirstLoggedBuildID() > 1 {
+				retainedBuilds, err := job.UnreapedSucceededBuilds(job.FirstLoggedBuildID(), br.batchSize)
+				if err != nil {
+					logger.Error("failed-to-get-job-retained-succeeded-builds", err)
+					return err
+				}
+
+				for _, build := range retainedBuilds {
+					if build.ID() >= firstSucceededBuildToRetain {
+						break
+					}
+
+					if br.drainerConfigured {
+						if !build.IsDrained() {
+							continue
+						}
+					}
+
+					buildsToDelete = append(buildsToDelete, build)
+					buildIDsToDelete = append(buildIDsToDelete, build.ID())
+				}
+			}
+
+			lastConsideredBuildID := 0
 			for i := len(buildsToConsiderDeleting) - 1; i >= 0; i-- {
 				build := buildsToConsiderDeleting[i]
 
-				if build.ID() >= firstBuildToRetain || build.IsRunning() {
+				if build.IsRunning() {
+					break
+				}
+
+				exceedsBuilds := retention.Builds > 0 && build.ID() < firstBuildToRetain
+
+				// builds which never finished have no end time, and are
+				// considered expired
+				expired := retention.Days > 0 && build.EndTime().Before(expiry)
+
+				if !exceedsBuilds && !expired {
 					break
 				}
 
@@ -123,20 +207,59 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 					}
 				}
 
+				lastConsideredBuildID = build.ID()
+
+				if firstSucceededBuildToRetain > 0 &&
+					build.ID() >= firstSucceededBuildToRetain &&
+					build.Status() == db.BuildStatusSucceeded {
+					continue
+				}
+
+				buildsToDelete = append(buildsToDelete, build)
 				buildIDsToDelete = append(buildIDsToDelete, build.ID())
 			}
 
-			if len(buildIDsToDelete) == 0 {
+			if br.dryRun {
+				if len(buildIDsToDelete) > 0 {
+					logger.Info("would-reap-build-logs", lager.Data{
+						"pipeline": pipeline.Name(),
+						"job":      job.Name(),
+						"builds":   buildIDsToDelete,
+					})
+
+					reaped += len(buildIDsToDelete)
+				}
+
 				continue
 			}
 
-			err = pipeline.DeleteBuildEventsByBuildIDs(buildIDsToDelete)
-			if err != nil {
-				logger.Error("failed-to-delete-build-events", err)
-				return err
+			if len(buildIDsToDelete) > 0 {
+				if br.archiver != nil {
+					for _, build := range buildsToDelete {
+						err = br.archiver.Archive(logger, build)
+						if err != nil {
+							logger.Error("failed-to-archive-build-events", err)
+							return err
+						}
+					}
+				}
+
+				err = pipeline.DeleteBuildEventsByBuildIDs(buildIDsToDelete)
+				if err != nil {
+					logger.Error("failed-to-delete-build-events", err)
+					return err
+				}
+
+				reaped += len(buildIDsToDelete)
+			}
+
+			if lastConsideredBuildID == 0 {
+				continue
 			}
 
-			err = job.UpdateFirstLoggedBuildID(buildIDsToDelete[len(buildIDsToDelete)-1] + 1)
+			// move past retained succeeded builds too, so that the next run
+			// considers newer builds rather than the same batch again
+			err = job.UpdateFirstLoggedBuildID(lastConsideredBuildID + 1)
 			if err != nil {
 				logger.Error("failed-to-update-first-logged-build-id", err)
 				return err
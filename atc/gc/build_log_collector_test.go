@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/archive"
+	"github.com/concourse/concourse/atc/archive/archivefakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/gc"
@@ -20,12 +23,16 @@ var _ = Describe("BuildLogCollector", func() {
 		fakePipelineFactory *dbfakes.FakePipelineFactory
 		batchSize           int
 		buildLogRetainCalc  BuildLogRetentionCalculator
+		fakeArchiver        *archivefakes.FakeArchiver
+		archiver            archive.Archiver
 	)
 
 	BeforeEach(func() {
 		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
 		batchSize = 5
-		buildLogRetainCalc = NewBuildLogRetentionCalculator(0, 0)
+		buildLogRetainCalc = NewBuildLogRetentionCalculator(0, 0, 0, 0)
+		fakeArchiver = new(archivefakes.FakeArchiver)
+		archiver = nil
 	})
 
 	JustBeforeEach(func() {
@@ -34,6 +41,8 @@ var _ = Describe("BuildLogCollector", func() {
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			archiver,
+			false,
 		)
 	})
 
@@ -82,6 +91,8 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						archiver,
+						false,
 					)
 				})
 				BeforeEach(func() {
@@ -380,7 +391,7 @@ var _ = Describe("BuildLogCollector", func() {
 
 			Context("when we install a custom build log retention calculator", func() {
 				BeforeEach(func() {
-					buildLogRetainCalc = NewBuildLogRetentionCalculator(3, 3)
+					buildLogRetainCalc = NewBuildLogRetentionCalculator(3, 3, 0, 0)
 
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Since: 2, Limit: 1}) {
@@ -565,6 +576,290 @@ var _ = Describe("BuildLogCollector", func() {
 			})
 		})
 
+		Context("when the job retains build logs by age", func() {
+			var fakeJob *dbfakes.FakeJob
+
+			BeforeEach(func() {
+				fakeJob = new(dbfakes.FakeJob)
+				fakeJob.NameReturns("job-1")
+				fakeJob.FirstLoggedBuildIDReturns(6)
+				fakeJob.ConfigReturns(atc.JobConfig{
+					BuildLogRetention: &atc.BuildLogRetention{
+						Days: 3,
+					},
+				})
+
+				fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					if page == (db.Page{Until: 5, Limit: 5}) {
+						return []db.Build{
+							finishedBuild(10, db.BuildStatusSucceeded, 1),
+							finishedBuild(9, db.BuildStatusSucceeded, 2),
+							finishedBuild(8, db.BuildStatusFailed, 4),
+							finishedBuild(7, db.BuildStatusSucceeded, 5),
+							finishedBuild(6, db.BuildStatusSucceeded, 6),
+						}, db.Pagination{}, nil
+					}
+
+					Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+					return nil, db.Pagination{}, nil
+				}
+
+				fakePipeline.JobsReturns([]db.Job{fakeJob}, nil)
+			})
+
+			It("reaps builds which finished before then", func() {
+				Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+
+				Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+				Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6, 7, 8))
+
+				Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(Equal(1))
+				Expect(fakeJob.UpdateFirstLoggedBuildIDArgsForCall(0)).To(Equal(9))
+			})
+
+			Context("when an archiver is configured", func() {
+				BeforeEach(func() {
+					archiver = fakeArchiver
+				})
+
+				It("archives each build before reaping it", func() {
+					Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+
+					Expect(fakeArchiver.ArchiveCallCount()).To(Equal(3))
+
+					archivedBuildIDs := []int{}
+					for i := 0; i < fakeArchiver.ArchiveCallCount(); i++ {
+						_, build := fakeArchiver.ArchiveArgsForCall(i)
+						archivedBuildIDs = append(archivedBuildIDs, build.ID())
+					}
+
+					Expect(archivedBuildIDs).To(ConsistOf(6, 7, 8))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+				})
+
+				Context("when archiving fails", func() {
+					disaster := errors.New("nope")
+
+					BeforeEach(func() {
+						fakeArchiver.ArchiveReturns(disaster)
+					})
+
+					It("does not reap any builds", func() {
+						Expect(buildLogCollector.Run(context.TODO())).To(Equal(disaster))
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+						Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+					})
+				})
+
+				Context("in dry-run mode", func() {
+					JustBeforeEach(func() {
+						buildLogCollector = NewBuildLogCollector(
+							fakePipelineFactory,
+							batchSize,
+							buildLogRetainCalc,
+							false,
+							archiver,
+							true,
+						)
+					})
+
+					It("does not archive or reap any builds", func() {
+						Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+
+						Expect(fakeArchiver.ArchiveCallCount()).To(BeZero())
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the job also retains a number of builds", func() {
+				BeforeEach(func() {
+					fakeJob.ConfigReturns(atc.JobConfig{
+						BuildLogRetention: &atc.BuildLogRetention{
+							Days:   3,
+							Builds: 2,
+						},
+					})
+
+					buildsStub := fakeJob.BuildsStub
+					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+						if page == (db.Page{Limit: 2}) {
+							return []db.Build{sb(12), sb(11)}, db.Pagination{}, nil
+						}
+
+						return buildsStub(page)
+					}
+				})
+
+				It("reaps builds which exceed either limit", func() {
+					Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6, 7, 8, 9, 10))
+				})
+			})
+
+			Context("when the job retains a minimum of succeeded builds", func() {
+				BeforeEach(func() {
+					fakeJob.ConfigReturns(atc.JobConfig{
+						BuildLogRetention: &atc.BuildLogRetention{
+							Days:                   3,
+							MinimumSucceededBuilds: 4,
+						},
+					})
+
+					fakeJob.LatestSucceededBuildIDsReturns([]int{10, 9, 7, 6}, nil)
+				})
+
+				It("does not reap the latest succeeded builds", func() {
+					Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+
+					Expect(fakeJob.LatestSucceededBuildIDsCallCount()).To(Equal(1))
+					Expect(fakeJob.LatestSucceededBuildIDsArgsForCall(0)).To(Equal(4))
+
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(8))
+				})
+
+				It("moves FirstLoggedBuildID past the retained builds", func() {
+					Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+
+					Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(Equal(1))
+					Expect(fakeJob.UpdateFirstLoggedBuildIDArgsForCall(0)).To(Equal(9))
+				})
+
+				Context("when succeeded builds were retained by previous runs", func() {
+					BeforeEach(func() {
+						fakeJob.UnreapedSucceededBuildsReturns([]db.Build{
+							finishedBuild(3, db.BuildStatusSucceeded, 10),
+							finishedBuild(4, db.BuildStatusSucceeded, 9),
+							finishedBuild(6, db.BuildStatusSucceeded, 6),
+						}, nil)
+					})
+
+					It("re-checks the builds before FirstLoggedBuildID", func() {
+						Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+
+						Expect(fakeJob.UnreapedSucceededBuildsCallCount()).To(Equal(1))
+						before, limit := fakeJob.UnreapedSucceededBuildsArgsForCall(0)
+						Expect(before).To(Equal(6))
+						Expect(limit).To(Equal(batchSize))
+					})
+
+					It("reaps those which are no longer among the latest succeeded builds", func() {
+						Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(3, 4, 8))
+					})
+
+					Context("when getting them fails", func() {
+						disaster := errors.New("nope")
+
+						BeforeEach(func() {
+							fakeJob.UnreapedSucceededBuildsReturns(nil, disaster)
+						})
+
+						It("returns the error", func() {
+							Expect(buildLogCollector.Run(context.TODO())).To(Equal(disaster))
+						})
+					})
+				})
+
+				Context("when getting the succeeded builds fails", func() {
+					disaster := errors.New("nope")
+
+					BeforeEach(func() {
+						fakeJob.LatestSucceededBuildIDsReturns(nil, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(buildLogCollector.Run(context.TODO())).To(Equal(disaster))
+					})
+				})
+			})
+		})
+
+		Context("when a job retaining succeeded builds has more expired builds than the batch size", func() {
+			var (
+				fakeJob      *dbfakes.FakeJob
+				reapedBuilds []int
+			)
+
+			BeforeEach(func() {
+				reapedBuilds = []int{}
+
+				allBuilds := []db.Build{
+					finishedBuild(1, db.BuildStatusSucceeded, 20),
+					finishedBuild(2, db.BuildStatusSucceeded, 19),
+				}
+
+				for id := 3; id <= 12; id++ {
+					allBuilds = append(allBuilds, finishedBuild(id, db.BuildStatusFailed, 18))
+				}
+
+				firstLoggedBuildID := 1
+
+				fakeJob = new(dbfakes.FakeJob)
+				fakeJob.NameReturns("job-1")
+				fakeJob.ConfigReturns(atc.JobConfig{
+					BuildLogRetention: &atc.BuildLogRetention{
+						Days:                   3,
+						MinimumSucceededBuilds: 2,
+					},
+				})
+
+				fakeJob.FirstLoggedBuildIDStub = func() int {
+					return firstLoggedBuildID
+				}
+
+				fakeJob.UpdateFirstLoggedBuildIDStub = func(id int) error {
+					firstLoggedBuildID = id
+					return nil
+				}
+
+				fakeJob.LatestSucceededBuildIDsReturns([]int{2, 1}, nil)
+
+				fakeJob.UnreapedSucceededBuildsReturns([]db.Build{allBuilds[0], allBuilds[1]}, nil)
+
+				fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+					if page.Since != 0 {
+						return []db.Build{allBuilds[0]}, db.Pagination{}, nil
+					}
+
+					builds := []db.Build{}
+					for i := len(allBuilds) - 1; i >= 0; i-- {
+						if allBuilds[i].ID() > page.Until {
+							builds = append(builds, allBuilds[i])
+						}
+					}
+
+					if len(builds) > page.Limit {
+						builds = builds[len(builds)-page.Limit:]
+					}
+
+					return builds, db.Pagination{}, nil
+				}
+
+				fakePipeline.DeleteBuildEventsByBuildIDsStub = func(ids []int) error {
+					reapedBuilds = append(reapedBuilds, ids...)
+					return nil
+				}
+
+				fakePipeline.JobsReturns([]db.Job{fakeJob}, nil)
+			})
+
+			It("reaps the builds after the retained succeeded builds over successive runs", func() {
+				for i := 0; i < 3; i++ {
+					Expect(buildLogCollector.Run(context.TODO())).To(Succeed())
+				}
+
+				Expect(reapedBuilds).To(ConsistOf(3, 4, 5, 6, 7, 8, 9, 10, 11, 12))
+				Expect(fakeJob.FirstLoggedBuildID()).To(Equal(13))
+			})
+		})
+
 		Context("when the dashboard job says retain 0 builds", func() {
 			var fakeJob *dbfakes.FakeJob
 
@@ -645,6 +940,15 @@ func sbDrained(id int, drained bool) db.Build {
 	return build
 }
 
+func finishedBuild(id int, status db.BuildStatus, daysAgo int) db.Build {
+	build := new(dbfakes.FakeBuild)
+	build.IDReturns(id)
+	build.IsRunningReturns(false)
+	build.StatusReturns(status)
+	build.EndTimeReturns(time.Now().AddDate(0, 0, -daysAgo))
+	return build
+}
+
 func runningBuild(id int) db.Build {
 	build := new(dbfakes.FakeBuild)
 	build.IDReturns(id)
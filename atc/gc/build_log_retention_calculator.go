@@ -1,47 +1,74 @@
 package gc
 
 import (
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
 type BuildLogRetentionCalculator interface {
-	BuildLogsToRetain(db.Job) int
+	BuildLogsToRetain(db.Job) atc.BuildLogRetention
 }
 
 type buildLogRetentionCalculator struct {
-	defaultBuildLogsToRetain uint64
-	maxBuildLogsToRetain     uint64
+	defaultBuildLogsToRetain     uint64
+	maxBuildLogsToRetain         uint64
+	defaultDaysToRetainBuildLogs uint64
+	maxDaysToRetainBuildLogs     uint64
 }
 
 func NewBuildLogRetentionCalculator(
 	defaultBuildLogsToRetain uint64,
 	maxBuildLogsToRetain uint64,
+	defaultDaysToRetainBuildLogs uint64,
+	maxDaysToRetainBuildLogs uint64,
 ) BuildLogRetentionCalculator {
 	return &buildLogRetentionCalculator{
-		defaultBuildLogsToRetain: defaultBuildLogsToRetain,
-		maxBuildLogsToRetain:     maxBuildLogsToRetain,
+		defaultBuildLogsToRetain:     defaultBuildLogsToRetain,
+		maxBuildLogsToRetain:         maxBuildLogsToRetain,
+		defaultDaysToRetainBuildLogs: defaultDaysToRetainBuildLogs,
+		maxDaysToRetainBuildLogs:     maxDaysToRetainBuildLogs,
 	}
 }
 
-func (blrc *buildLogRetentionCalculator) BuildLogsToRetain(job db.Job) int {
+func (blrc *buildLogRetentionCalculator) BuildLogsToRetain(job db.Job) atc.BuildLogRetention {
 	// What does the job want?
-	buildLogsToRetain := job.Config().BuildLogsToRetain
+	config := job.Config()
 
+	retention := atc.BuildLogRetention{
+		Builds: config.BuildLogsToRetain,
+	}
+
+	if config.BuildLogRetention != nil {
+		retention = *config.BuildLogRetention
+	}
+
+	retention.Builds = withDefaultAndMax(retention.Builds, blrc.defaultBuildLogsToRetain, blrc.maxBuildLogsToRetain)
+	retention.Days = withDefaultAndMax(retention.Days, blrc.defaultDaysToRetainBuildLogs, blrc.maxDaysToRetainBuildLogs)
+
+	// Keeping more succeeded builds than builds would defeat the max
+	if retention.Builds > 0 && retention.MinimumSucceededBuilds > retention.Builds {
+		retention.MinimumSucceededBuilds = retention.Builds
+	}
+
+	return retention
+}
+
+func withDefaultAndMax(value int, defaultValue uint64, maxValue uint64) int {
 	// If not specified, set to default
-	if buildLogsToRetain == 0 {
-		buildLogsToRetain = int(blrc.defaultBuildLogsToRetain)
+	if value == 0 {
+		value = int(defaultValue)
 	}
 
 	// If we don't have a max set, then we're done
-	if blrc.maxBuildLogsToRetain == 0 {
-		return buildLogsToRetain
+	if maxValue == 0 {
+		return value
 	}
 
 	// If we have a value set, and we're less than the max, then return
-	if buildLogsToRetain > 0 && buildLogsToRetain < int(blrc.maxBuildLogsToRetain) {
-		return buildLogsToRetain
+	if value > 0 && value < int(maxValue) {
+		return value
 	}
 
 	// Else, return the max
-	return int(blrc.maxBuildLogsToRetain)
+	return int(maxValue)
 }
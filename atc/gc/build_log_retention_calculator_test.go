@@ -12,25 +12,68 @@ import (
 
 var _ = Describe("BuildLogRetentionCalculator", func() {
 	It("nothing set gives all", func() {
-		Expect(NewBuildLogRetentionCalculator(0, 0).BuildLogsToRetain(makeJob(0))).To(Equal(0))
+		Expect(NewBuildLogRetentionCalculator(0, 0, 0, 0).BuildLogsToRetain(makeJob(0)).Builds).To(Equal(0))
 	})
 	It("nothing set but job gives job", func() {
-		Expect(NewBuildLogRetentionCalculator(0, 0).BuildLogsToRetain(makeJob(3))).To(Equal(3))
+		Expect(NewBuildLogRetentionCalculator(0, 0, 0, 0).BuildLogsToRetain(makeJob(3)).Builds).To(Equal(3))
 	})
 	It("default set gives default", func() {
-		Expect(NewBuildLogRetentionCalculator(5, 0).BuildLogsToRetain(makeJob(0))).To(Equal(5))
+		Expect(NewBuildLogRetentionCalculator(5, 0, 0, 0).BuildLogsToRetain(makeJob(0)).Builds).To(Equal(5))
 	})
 	It("default and job set gives job", func() {
-		Expect(NewBuildLogRetentionCalculator(5, 0).BuildLogsToRetain(makeJob(6))).To(Equal(6))
+		Expect(NewBuildLogRetentionCalculator(5, 0, 0, 0).BuildLogsToRetain(makeJob(6)).Builds).To(Equal(6))
 	})
 	It("default and job set and max set gives max if lower", func() {
-		Expect(NewBuildLogRetentionCalculator(5, 4).BuildLogsToRetain(makeJob(6))).To(Equal(4))
+		Expect(NewBuildLogRetentionCalculator(5, 4, 0, 0).BuildLogsToRetain(makeJob(6)).Builds).To(Equal(4))
 	})
 	It("max only set gives max", func() {
-		Expect(NewBuildLogRetentionCalculator(0, 4).BuildLogsToRetain(makeJob(0))).To(Equal(4))
+		Expect(NewBuildLogRetentionCalculator(0, 4, 0, 0).BuildLogsToRetain(makeJob(0)).Builds).To(Equal(4))
+	})
+
+	Describe("build_log_retention", func() {
+		It("gives the job's retention", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 0, 0).BuildLogsToRetain(makeJobWithRetention(atc.BuildLogRetention{
+				Days:                   7,
+				Builds:                 10,
+				MinimumSucceededBuilds: 2,
+			}))).To(Equal(atc.BuildLogRetention{
+				Days:                   7,
+				Builds:                 10,
+				MinimumSucceededBuilds: 2,
+			}))
+		})
+		It("default days set gives default days", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 5, 0).BuildLogsToRetain(makeJobWithRetention(atc.BuildLogRetention{})).Days).To(Equal(5))
+		})
+		It("default days and job days set gives job days", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 5, 0).BuildLogsToRetain(makeJobWithRetention(atc.BuildLogRetention{Days: 6})).Days).To(Equal(6))
+		})
+		It("max days set gives max days if lower", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 5, 4).BuildLogsToRetain(makeJobWithRetention(atc.BuildLogRetention{Days: 6})).Days).To(Equal(4))
+		})
+		It("max days only set gives max days", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 0, 0, 4).BuildLogsToRetain(makeJob(0)).Days).To(Equal(4))
+		})
+		It("applies the max builds to build_log_retention", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 4, 0, 0).BuildLogsToRetain(makeJobWithRetention(atc.BuildLogRetention{Builds: 6})).Builds).To(Equal(4))
+		})
+		It("does not retain more succeeded builds than builds", func() {
+			Expect(NewBuildLogRetentionCalculator(0, 4, 0, 0).BuildLogsToRetain(makeJobWithRetention(atc.BuildLogRetention{
+				Builds:                 6,
+				MinimumSucceededBuilds: 5,
+			})).MinimumSucceededBuilds).To(Equal(4))
+		})
 	})
 })
 
+func makeJobWithRetention(retention atc.BuildLogRetention) db.Job {
+	rv := new(dbfakes.FakeJob)
+	rv.ConfigReturns(atc.JobConfig{
+		BuildLogRetention: &retention,
+	})
+	return rv
+}
+
 func makeJob(retainAmount int) db.Job {
 	rv := new(dbfakes.FakeJob)
 	rv.ConfigReturns(atc.JobConfig{
@@ -20,17 +20,27 @@ type containerCollector struct {
 	containerRepository         db.ContainerRepository
 	jobRunner                   WorkerJobRunner
 	missingContainerGracePeriod time.Duration
+	batchSize                   int
+	dryRun                      bool
 }
 
+// NewContainerCollector constructs a collector which destroys orphaned,
+// failed and missing containers. At most batchSize orphaned containers are
+// destroyed per run, unless it is 0. In dry-run mode orphaned containers are
+// only reported, and failed and missing containers are left alone.
 func NewContainerCollector(
 	containerRepository db.ContainerRepository,
 	jobRunner WorkerJobRunner,
 	missingContainerGracePeriod time.Duration,
+	batchSize int,
+	dryRun bool,
 ) Collector {
 	return &containerCollector{
 		containerRepository:         containerRepository,
 		jobRunner:                   jobRunner,
 		missingContainerGracePeriod: missingContainerGracePeriod,
+		batchSize:                   batchSize,
+		dryRun:                      dryRun,
 	}
 }
 
@@ -61,16 +71,26 @@ func (c *containerCollector) Run(ctx context.Context) error {
 		logger.Error("failed-to-clean-up-orphaned-containers", err)
 	}
 
+	if c.dryRun {
+		return errs
+	}
+
 	err = c.cleanupFailedContainers(logger.Session("failed-containers"))
 	if err != nil {
 		errs = multierror.Append(errs, err)
 		logger.Error("failed-to-clean-up-failed-containers", err)
 	}
 
-	_, err = c.containerRepository.RemoveMissingContainers(c.missingContainerGracePeriod)
+	missing, err := c.containerRepository.RemoveMissingContainers(c.missingContainerGracePeriod)
 	if err != nil {
 		errs = multierror.Append(errs, err)
 		logger.Error("failed-to-clean-up-missing-containers", err)
+	} else {
+		metric.GarbageCollectionDestroyed{
+			Collector: "container",
+			Kind:      "missing-containers",
+			Count:     missing,
+		}.Emit(logger)
 	}
 
 	return errs
@@ -93,6 +113,12 @@ func (c *containerCollector) cleanupFailedContainers(logger lager.Logger) error
 		Containers: failedContainersLen,
 	}.Emit(logger)
 
+	metric.GarbageCollectionDestroyed{
+		Collector: "container",
+		Kind:      "failed-containers",
+		Count:     failedContainersLen,
+	}.Emit(logger)
+
 	return nil
 }
 
@@ -124,6 +150,29 @@ func (c *containerCollector) cleanupOrphanedContainers(logger lager.Logger) erro
 		Containers: len(destroyingContainers),
 	}.Emit(logger)
 
+	if c.batchSize > 0 && len(createdContainers) > c.batchSize {
+		createdContainers = createdContainers[:c.batchSize]
+	}
+
+	metric.GarbageCollectionDestroyed{
+		Collector: "container",
+		Kind:      "orphaned-containers",
+		Count:     len(createdContainers),
+		DryRun:    c.dryRun,
+	}.Emit(logger)
+
+	if c.dryRun {
+		for _, createdContainer := range createdContainers {
+			logger.Info("would-destroy-container", lager.Data{
+				"container": createdContainer.Handle(),
+				"worker":    createdContainer.WorkerName(),
+				"hijacked":  createdContainer.IsHijacked(),
+			})
+		}
+
+		return nil
+	}
+
 	var workerCreatedContainers = make(map[string][]db.CreatedContainer)
 
 	for _, createdContainer := range createdContainers {
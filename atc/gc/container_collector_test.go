@@ -59,12 +59,16 @@ var _ = Describe("ContainerCollector", func() {
 			fakeContainerRepository,
 			fakeJobRunner,
 			missingContainerGracePeriod,
+			0,
+			false,
 		)
 
 		fakeCollector = gc.NewContainerCollector(
 			fakeContainerRepository,
 			fakeJobRunner,
 			missingContainerGracePeriod,
+			0,
+			false,
 		)
 	})
 
@@ -262,6 +266,29 @@ var _ = Describe("ContainerCollector", func() {
 				})
 			})
 
+			Context("in dry-run mode", func() {
+				BeforeEach(func() {
+					collector = gc.NewContainerCollector(
+						fakeContainerRepository,
+						fakeJobRunner,
+						missingContainerGracePeriod,
+						0,
+						true,
+					)
+				})
+
+				It("does not destroy any containers", func() {
+					Expect(fakeContainerRepository.FindOrphanedContainersCallCount()).To(Equal(1))
+					Expect(createdContainer.DestroyingCallCount()).To(Equal(0))
+					Expect(fakeJobRunner.TryCallCount()).To(Equal(0))
+				})
+
+				It("does not clean up failed or missing containers", func() {
+					Expect(fakeContainerRepository.DestroyFailedContainersCallCount()).To(Equal(0))
+					Expect(fakeContainerRepository.RemoveMissingContainersCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when finding containers for deletion fails", func() {
 				BeforeEach(func() {
 					fakeContainerRepository.FindOrphanedContainersReturns(nil, nil, nil, errors.New("some error"))
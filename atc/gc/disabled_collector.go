@@ -0,0 +1,29 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+)
+
+type disabledCollector struct {
+	name string
+}
+
+// NewDisabledCollector returns a collector which does nothing. It is used in
+// dry-run mode in place of collectors which cannot report what they would
+// destroy without destroying it.
+func NewDisabledCollector(name string) Collector {
+	return &disabledCollector{
+		name: name,
+	}
+}
+
+func (dc *disabledCollector) Run(ctx context.Context) error {
+	lagerctx.FromContext(ctx).Debug("collector-disabled", lager.Data{
+		"collector": dc.name,
+	})
+
+	return nil
+}
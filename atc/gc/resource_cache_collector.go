@@ -3,17 +3,27 @@ package gc
 import (
 	"context"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 )
 
 type resourceCacheCollector struct {
 	cacheLifecycle db.ResourceCacheLifecycle
+	retention      db.ResourceCacheRetention
+	dryRun         bool
 }
 
-func NewResourceCacheCollector(cacheLifecycle db.ResourceCacheLifecycle) Collector {
+func NewResourceCacheCollector(
+	cacheLifecycle db.ResourceCacheLifecycle,
+	retention db.ResourceCacheRetention,
+	dryRun bool,
+) Collector {
 	return &resourceCacheCollector{
 		cacheLifecycle: cacheLifecycle,
+		retention:      retention,
+		dryRun:         dryRun,
 	}
 }
 
@@ -23,5 +33,40 @@ func (rcc *resourceCacheCollector) Run(ctx context.Context) error {
 	logger.Debug("start")
 	defer logger.Debug("done")
 
-	return rcc.cacheLifecycle.CleanUpInvalidCaches(logger)
+	if rcc.dryRun {
+		invalidCacheIDs, err := rcc.cacheLifecycle.FindInvalidCaches(logger, rcc.retention)
+		if err != nil {
+			logger.Error("failed-to-find-invalid-caches", err)
+			return err
+		}
+
+		if len(invalidCacheIDs) > 0 {
+			logger.Info("would-destroy-resource-caches", lager.Data{
+				"count": len(invalidCacheIDs),
+				"ids":   invalidCacheIDs,
+			})
+		}
+
+		metric.GarbageCollectionDestroyed{
+			Collector: "resource-cache",
+			Kind:      "resource-caches",
+			Count:     len(invalidCacheIDs),
+			DryRun:    true,
+		}.Emit(logger)
+
+		return nil
+	}
+
+	deleted, err := rcc.cacheLifecycle.CleanUpInvalidCaches(logger, rcc.retention)
+	if err != nil {
+		return err
+	}
+
+	metric.GarbageCollectionDestroyed{
+		Collector: "resource-cache",
+		Kind:      "resource-caches",
+		Count:     deleted,
+	}.Emit(logger)
+
+	return nil
 }
@@ -20,7 +20,7 @@ var _ = Describe("ResourceCacheCollector", func() {
 	var buildCollector gc.Collector
 
 	BeforeEach(func() {
-		collector = gc.NewResourceCacheCollector(resourceCacheLifecycle)
+		collector = gc.NewResourceCacheCollector(resourceCacheLifecycle, nil, false)
 		buildCollector = gc.NewBuildCollector(buildFactory)
 	})
 
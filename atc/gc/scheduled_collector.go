@@ -0,0 +1,59 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type scheduledCollector struct {
+	name      string
+	collector Collector
+	interval  time.Duration
+	clock     clock.Clock
+
+	lastRun time.Time
+}
+
+// NewScheduledCollector wraps a collector so that it runs at most once per
+// interval, allowing each collector to run less often than the aggregate
+// collector which runs it. An interval of 0 runs the collector every time.
+//
+// The duration of each run is emitted as a metric.
+func NewScheduledCollector(name string, collector Collector, interval time.Duration, clock clock.Clock) Collector {
+	return &scheduledCollector{
+		name:      name,
+		collector: collector,
+		interval:  interval,
+		clock:     clock,
+	}
+}
+
+func (sc *scheduledCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx)
+
+	start := sc.clock.Now()
+	if !sc.lastRun.IsZero() && start.Sub(sc.lastRun) < sc.interval {
+		logger.Debug("skipping-collector", lager.Data{
+			"collector": sc.name,
+			"next-run":  sc.lastRun.Add(sc.interval),
+		})
+
+		return nil
+	}
+
+	sc.lastRun = start
+
+	err := sc.collector.Run(ctx)
+
+	metric.GarbageCollectionDuration{
+		Collector: sc.name,
+		Duration:  sc.clock.Since(start),
+	}.Emit(logger)
+
+	return err
+}
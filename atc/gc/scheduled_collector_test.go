@@ -0,0 +1,75 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/gc/gcfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduled Collector", func() {
+	var (
+		subject Collector
+
+		fakeCollector *gcfakes.FakeCollector
+		fakeClock     *fakeclock.FakeClock
+		interval      time.Duration
+	)
+
+	BeforeEach(func() {
+		fakeCollector = new(gcfakes.FakeCollector)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+		interval = time.Minute
+	})
+
+	JustBeforeEach(func() {
+		subject = NewScheduledCollector("some-collector", fakeCollector, interval, fakeClock)
+	})
+
+	It("runs the collector the first time", func() {
+		Expect(subject.Run(context.TODO())).To(Succeed())
+		Expect(fakeCollector.RunCallCount()).To(Equal(1))
+	})
+
+	It("does not run the collector again until the interval has elapsed", func() {
+		Expect(subject.Run(context.TODO())).To(Succeed())
+
+		fakeClock.Increment(interval - time.Second)
+		Expect(subject.Run(context.TODO())).To(Succeed())
+		Expect(fakeCollector.RunCallCount()).To(Equal(1))
+
+		fakeClock.Increment(time.Second)
+		Expect(subject.Run(context.TODO())).To(Succeed())
+		Expect(fakeCollector.RunCallCount()).To(Equal(2))
+	})
+
+	Context("when the interval is 0", func() {
+		BeforeEach(func() {
+			interval = 0
+		})
+
+		It("runs the collector every time", func() {
+			Expect(subject.Run(context.TODO())).To(Succeed())
+			Expect(subject.Run(context.TODO())).To(Succeed())
+			Expect(fakeCollector.RunCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when the collector fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeCollector.RunReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(subject.Run(context.TODO())).To(Equal(disaster))
+		})
+	})
+})
@@ -14,15 +14,25 @@ import (
 type volumeCollector struct {
 	volumeRepository         db.VolumeRepository
 	missingVolumeGracePeriod time.Duration
+	batchSize                int
+	dryRun                   bool
 }
 
+// NewVolumeCollector constructs a collector which destroys failed, orphaned
+// and missing volumes. At most batchSize orphaned volumes are destroyed per
+// run, unless it is 0. In dry-run mode orphaned volumes are only reported,
+// and failed and missing volumes are left alone.
 func NewVolumeCollector(
 	volumeRepository db.VolumeRepository,
 	missingVolumeGracePeriod time.Duration,
+	batchSize int,
+	dryRun bool,
 ) Collector {
 	return &volumeCollector{
 		volumeRepository:         volumeRepository,
 		missingVolumeGracePeriod: missingVolumeGracePeriod,
+		batchSize:                batchSize,
+		dryRun:                   dryRun,
 	}
 }
 
@@ -34,22 +44,32 @@ func (vc *volumeCollector) Run(ctx context.Context) error {
 
 	var errs error
 
-	err := vc.cleanupFailedVolumes(logger.Session("failed-volumes"))
-	if err != nil {
-		errs = multierror.Append(errs, err)
-		logger.Error("failed-to-clean-up-failed-volumes", err)
+	if !vc.dryRun {
+		err := vc.cleanupFailedVolumes(logger.Session("failed-volumes"))
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			logger.Error("failed-to-clean-up-failed-volumes", err)
+		}
 	}
 
-	err = vc.markOrphanedVolumesAsDestroying(logger.Session("mark-volumes"))
+	err := vc.markOrphanedVolumesAsDestroying(logger.Session("mark-volumes"))
 	if err != nil {
 		errs = multierror.Append(errs, err)
 		logger.Error("failed-to-transition-created-volumes-to-destroying", err)
 	}
 
-	_, err = vc.volumeRepository.RemoveMissingVolumes(vc.missingVolumeGracePeriod)
-	if err != nil {
-		errs = multierror.Append(errs, err)
-		logger.Error("failed-to-clean-up-missing-volumes", err)
+	if !vc.dryRun {
+		missing, err := vc.volumeRepository.RemoveMissingVolumes(vc.missingVolumeGracePeriod)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			logger.Error("failed-to-clean-up-missing-volumes", err)
+		} else {
+			metric.GarbageCollectionDestroyed{
+				Collector: "volume",
+				Kind:      "missing-volumes",
+				Count:     missing,
+			}.Emit(logger)
+		}
 	}
 
 	return errs
@@ -72,6 +92,12 @@ func (vc *volumeCollector) cleanupFailedVolumes(logger lager.Logger) error {
 		Volumes: failedVolumesLen,
 	}.Emit(logger)
 
+	metric.GarbageCollectionDestroyed{
+		Collector: "volume",
+		Kind:      "failed-volumes",
+		Count:     failedVolumesLen,
+	}.Emit(logger)
+
 	return nil
 }
 
@@ -92,6 +118,28 @@ func (vc *volumeCollector) markOrphanedVolumesAsDestroying(logger lager.Logger)
 		Volumes: len(orphanedVolumesHandles),
 	}.Emit(logger)
 
+	if vc.batchSize > 0 && len(orphanedVolumesHandles) > vc.batchSize {
+		orphanedVolumesHandles = orphanedVolumesHandles[:vc.batchSize]
+	}
+
+	metric.GarbageCollectionDestroyed{
+		Collector: "volume",
+		Kind:      "orphaned-volumes",
+		Count:     len(orphanedVolumesHandles),
+		DryRun:    vc.dryRun,
+	}.Emit(logger)
+
+	if vc.dryRun {
+		for _, orphanedVolume := range orphanedVolumesHandles {
+			logger.Info("would-destroy-volume", lager.Data{
+				"volume": orphanedVolume.Handle(),
+				"worker": orphanedVolume.WorkerName(),
+			})
+		}
+
+		return nil
+	}
+
 	for _, orphanedVolume := range orphanedVolumesHandles {
 		// queue
 		vLog := logger.Session("mark-created-as-destroying", lager.Data{
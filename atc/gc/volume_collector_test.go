@@ -38,6 +38,8 @@ var _ = Describe("VolumeCollector", func() {
 		volumeCollector = gc.NewVolumeCollector(
 			volumeRepository,
 			missingVolumeGracePeriod,
+			0,
+			false,
 		)
 	})
 
@@ -73,6 +75,8 @@ var _ = Describe("VolumeCollector", func() {
 				volumeCollector = gc.NewVolumeCollector(
 					fakeVolumeRepository,
 					missingVolumeGracePeriod,
+					0,
+					false,
 				)
 
 				err = volumeCollector.Run(context.TODO())
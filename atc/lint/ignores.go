@@ -0,0 +1,102 @@
+package lint
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+const ignoreDirective = "fly:ignore"
+
+var (
+	ignoreCommentRegexp = regexp.MustCompile(`#\s*` + ignoreDirective + `\s+(.*)$`)
+	subjectRegexp       = regexp.MustCompile(`^\s*(?:-\s+)?(?:name|get|put|task):\s*['"]?([^\s'"#]+)`)
+)
+
+// Ignores are the rules disabled by `# fly:ignore some-rule` comments in a
+// config. A comment at the end of, or on the line above, a line naming a job,
+// resource or step only disables the rules for that part of the config. Any
+// other comment disables the rules for the whole config.
+type Ignores struct {
+	everywhere map[string]bool
+	subjects   map[string]map[string]bool
+}
+
+// ParseIgnores finds the ignore comments in the config. It must be given the
+// config as written, as comments do not survive templating.
+func ParseIgnores(config []byte) Ignores {
+	ignores := Ignores{
+		everywhere: map[string]bool{},
+		subjects:   map[string]map[string]bool{},
+	}
+
+	var pending []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(config))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		var rules []string
+		if match := ignoreCommentRegexp.FindStringSubmatch(line); match != nil {
+			rules = strings.FieldsFunc(match[1], func(r rune) bool {
+				return r == ',' || r == ' ' || r == '\t'
+			})
+		}
+
+		commentOnly := strings.HasPrefix(strings.TrimSpace(line), "#")
+
+		if commentOnly {
+			pending = append(pending, rules...)
+			continue
+		}
+
+		rules = append(pending, rules...)
+		pending = nil
+
+		if len(rules) == 0 {
+			continue
+		}
+
+		if match := subjectRegexp.FindStringSubmatch(line); match != nil {
+			ignores.add(match[1], rules)
+		} else {
+			ignores.add("", rules)
+		}
+	}
+
+	ignores.add("", pending)
+
+	return ignores
+}
+
+// Ignored is true if the finding's rule is disabled for the whole config, or
+// for any job, resource or step along the finding's location.
+func (ignores Ignores) Ignored(finding Finding) bool {
+	if ignores.everywhere[finding.Rule] {
+		return true
+	}
+
+	for _, segment := range strings.Split(finding.Location, ".") {
+		if ignores.subjects[segment][finding.Rule] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ignores Ignores) add(subject string, rules []string) {
+	for _, rule := range rules {
+		if subject == "" {
+			ignores.everywhere[rule] = true
+			continue
+		}
+
+		if ignores.subjects[subject] == nil {
+			ignores.subjects[subject] = map[string]bool{}
+		}
+
+		ignores.subjects[subject][rule] = true
+	}
+}
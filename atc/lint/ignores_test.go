@@ -0,0 +1,47 @@
+package lint_test
+
+import (
+	"github.com/concourse/concourse/atc/lint"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ignores", func() {
+	var ignores lint.Ignores
+
+	BeforeEach(func() {
+		ignores = lint.ParseIgnores([]byte(`# fly:ignore deprecated-aggregate
+resources:
+# fly:ignore unused-resource, plaintext-secret
+- name: some-resource
+  type: git
+- name: some-other-resource # fly:ignore unpinned-image
+
+jobs:
+- name: some-job
+  plan:
+  - get: some-resource # fly:ignore untriggered-get
+  - get: some-other-resource
+`))
+	})
+
+	It("ignores rules for the whole config from comments not naming anything", func() {
+		Expect(ignores.Ignored(lint.Finding{Rule: "deprecated-aggregate", Location: "jobs.some-job.plan[0]"})).To(BeTrue())
+	})
+
+	It("ignores rules for the subject named on the line below the comment", func() {
+		Expect(ignores.Ignored(lint.Finding{Rule: "unused-resource", Location: "resources.some-resource"})).To(BeTrue())
+		Expect(ignores.Ignored(lint.Finding{Rule: "plaintext-secret", Location: "resources.some-resource.source.password"})).To(BeTrue())
+		Expect(ignores.Ignored(lint.Finding{Rule: "unused-resource", Location: "resources.some-other-resource"})).To(BeFalse())
+	})
+
+	It("ignores rules for the subject named on the same line as the comment", func() {
+		Expect(ignores.Ignored(lint.Finding{Rule: "unpinned-image", Location: "resources.some-other-resource"})).To(BeTrue())
+		Expect(ignores.Ignored(lint.Finding{Rule: "untriggered-get", Location: "jobs.some-job.plan[0].get.some-resource"})).To(BeTrue())
+	})
+
+	It("does not ignore other rules", func() {
+		Expect(ignores.Ignored(lint.Finding{Rule: "untriggered-get", Location: "jobs.some-job.plan[1].get.some-other-resource"})).To(BeFalse())
+	})
+})
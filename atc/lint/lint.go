@@ -0,0 +1,72 @@
+package lint
+
+import (
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// AtLeast is true if the severity is as or more severe than min.
+func (severity Severity) AtLeast(min Severity) bool {
+	return severityRanks[severity] >= severityRanks[min]
+}
+
+var severityRanks = map[Severity]int{
+	SeverityInfo:    0,
+	SeverityWarning: 1,
+	SeverityError:   2,
+}
+
+// Finding is a problem found in a config by a rule. Location identifies the
+// offending part of the config in the same style as config validation errors,
+// e.g. jobs.some-job.plan[0].get.some-resource.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Location string   `json:"location"`
+	Message  string   `json:"message"`
+}
+
+// Rule checks a config for one kind of problem. Check only needs to set the
+// Location and Message of each finding; the rule's name and severity are
+// filled in by LintConfig.
+type Rule struct {
+	Name        string
+	Description string
+	Severity    Severity
+
+	Check func(atc.Config) []Finding
+}
+
+// LintConfig runs each rule against the config, returning the findings which
+// are not ignored, ordered by location.
+func LintConfig(config atc.Config, rules []Rule, ignores Ignores) []Finding {
+	findings := []Finding{}
+
+	for _, rule := range rules {
+		for _, finding := range rule.Check(config) {
+			finding.Rule = rule.Name
+			finding.Severity = rule.Severity
+
+			if ignores.Ignored(finding) {
+				continue
+			}
+
+			findings = append(findings, finding)
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Location < findings[j].Location
+	})
+
+	return findings
+}
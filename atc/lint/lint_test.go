@@ -0,0 +1,77 @@
+package lint_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/lint"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LintConfig", func() {
+	var (
+		config   atc.Config
+		rules    []lint.Rule
+		ignores  lint.Ignores
+		findings []lint.Finding
+	)
+
+	BeforeEach(func() {
+		config = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git"},
+			},
+		}
+
+		rules = []lint.Rule{
+			{
+				Name:     "some-rule",
+				Severity: lint.SeverityWarning,
+				Check: func(atc.Config) []lint.Finding {
+					return []lint.Finding{
+						{Location: "resources.some-resource", Message: "some-message"},
+						{Location: "jobs.some-job", Message: "some-other-message"},
+					}
+				},
+			},
+		}
+
+		ignores = lint.Ignores{}
+	})
+
+	JustBeforeEach(func() {
+		findings = lint.LintConfig(config, rules, ignores)
+	})
+
+	It("returns the findings ordered by location, with the rule's name and severity", func() {
+		Expect(findings).To(Equal([]lint.Finding{
+			{Rule: "some-rule", Severity: lint.SeverityWarning, Location: "jobs.some-job", Message: "some-other-message"},
+			{Rule: "some-rule", Severity: lint.SeverityWarning, Location: "resources.some-resource", Message: "some-message"},
+		}))
+	})
+
+	Context("when the rule is ignored for a subject", func() {
+		BeforeEach(func() {
+			ignores = lint.ParseIgnores([]byte(`
+resources:
+- name: some-resource # fly:ignore some-rule
+`))
+		})
+
+		It("omits the findings for the subject", func() {
+			Expect(findings).To(Equal([]lint.Finding{
+				{Rule: "some-rule", Severity: lint.SeverityWarning, Location: "jobs.some-job", Message: "some-other-message"},
+			}))
+		})
+	})
+})
+
+var _ = Describe("Severity", func() {
+	Describe("AtLeast", func() {
+		It("orders info, warning and error", func() {
+			Expect(lint.SeverityError.AtLeast(lint.SeverityWarning)).To(BeTrue())
+			Expect(lint.SeverityWarning.AtLeast(lint.SeverityWarning)).To(BeTrue())
+			Expect(lint.SeverityInfo.AtLeast(lint.SeverityWarning)).To(BeFalse())
+		})
+	})
+})
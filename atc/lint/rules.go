@@ -0,0 +1,324 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// DefaultRules are the rules run by fly lint-pipeline.
+var DefaultRules = []Rule{
+	UnusedResourceRule,
+	UntriggeredGetRule,
+	PlaintextSecretRule,
+	MissingContainerLimitsRule,
+	UnpinnedImageRule,
+	DeprecatedAggregateRule,
+}
+
+var UnusedResourceRule = Rule{
+	Name:        "unused-resource",
+	Description: "Resources which no job gets or puts",
+	Severity:    SeverityWarning,
+
+	Check: func(config atc.Config) []Finding {
+		used := map[string]bool{}
+		for _, step := range configSteps(config) {
+			switch {
+			case step.plan.Get != "":
+				used[resourceName(step.plan.Resource, step.plan.Get)] = true
+			case step.plan.Put != "":
+				used[resourceName(step.plan.Resource, step.plan.Put)] = true
+			}
+		}
+
+		findings := []Finding{}
+		for _, resource := range config.Resources {
+			if !used[resource.Name] {
+				findings = append(findings, Finding{
+					Location: fmt.Sprintf("resources.%s", resource.Name),
+					Message:  "resource is not used by any job",
+				})
+			}
+		}
+
+		return findings
+	},
+}
+
+var UntriggeredGetRule = Rule{
+	Name:        "untriggered-get",
+	Description: "Get steps which neither trigger the job nor constrain its versions with passed",
+	Severity:    SeverityInfo,
+
+	Check: func(config atc.Config) []Finding {
+		findings := []Finding{}
+		for _, step := range configSteps(config) {
+			if step.plan.Get != "" && !step.plan.Trigger && len(step.plan.Passed) == 0 {
+				findings = append(findings, Finding{
+					Location: step.location,
+					Message:  "new versions will not trigger the job and are not constrained by passed",
+				})
+			}
+		}
+
+		return findings
+	},
+}
+
+var secretKeyRegexp = regexp.MustCompile(`(?i)(password|passphrase|secret|token|private_key|access_key|api_key|credentials)`)
+
+var PlaintextSecretRule = Rule{
+	Name:        "plaintext-secret",
+	Description: "Credentials in a source which are not provided by ((vars))",
+	Severity:    SeverityError,
+
+	Check: func(config atc.Config) []Finding {
+		findings := []Finding{}
+
+		for _, resource := range config.Resources {
+			findings = append(findings, plaintextSecrets(fmt.Sprintf("resources.%s.source", resource.Name), resource.Source)...)
+		}
+
+		for _, resourceType := range config.ResourceTypes {
+			findings = append(findings, plaintextSecrets(fmt.Sprintf("resource_types.%s.source", resourceType.Name), resourceType.Source)...)
+		}
+
+		for _, step := range configSteps(config) {
+			if step.plan.TaskConfig != nil && step.plan.TaskConfig.ImageResource != nil {
+				findings = append(findings, plaintextSecrets(step.location+".config.image_resource.source", step.plan.TaskConfig.ImageResource.Source)...)
+			}
+		}
+
+		return findings
+	},
+}
+
+var MissingContainerLimitsRule = Rule{
+	Name:        "missing-container-limits",
+	Description: "Tasks which do not limit the CPU or memory of their container",
+	Severity:    SeverityInfo,
+
+	Check: func(config atc.Config) []Finding {
+		findings := []Finding{}
+		for _, step := range configSteps(config) {
+			if step.plan.TaskConfig == nil || step.plan.TaskConfigPath != "" {
+				continue
+			}
+
+			limits := step.plan.TaskConfig.Limits
+			if limits.CPU == nil && limits.Memory == nil {
+				findings = append(findings, Finding{
+					Location: step.location,
+					Message:  "task does not set container_limits",
+				})
+			}
+		}
+
+		return findings
+	},
+}
+
+var UnpinnedImageRule = Rule{
+	Name:        "unpinned-image",
+	Description: "Task image resources which do not pin a version, digest or tag",
+	Severity:    SeverityWarning,
+
+	Check: func(config atc.Config) []Finding {
+		findings := []Finding{}
+		for _, step := range configSteps(config) {
+			if step.plan.TaskConfig == nil || step.plan.ImageArtifactName != "" {
+				continue
+			}
+
+			imageResource := step.plan.TaskConfig.ImageResource
+			if imageResource == nil || imagePinned(*imageResource) {
+				continue
+			}
+
+			findings = append(findings, Finding{
+				Location: step.location,
+				Message:  "image_resource does not pin a version, and will use whatever is latest",
+			})
+		}
+
+		return findings
+	},
+}
+
+var DeprecatedAggregateRule = Rule{
+	Name:        "deprecated-aggregate",
+	Description: "Use of the deprecated aggregate step",
+	Severity:    SeverityWarning,
+
+	Check: func(config atc.Config) []Finding {
+		findings := []Finding{}
+		for _, step := range configSteps(config) {
+			if step.plan.Aggregate != nil {
+				findings = append(findings, Finding{
+					Location: step.location,
+					Message:  "aggregate steps are deprecated",
+				})
+			}
+		}
+
+		return findings
+	},
+}
+
+type step struct {
+	location string
+	plan     atc.PlanConfig
+}
+
+// configSteps returns every step of every job, including hooks and the steps
+// nested within other steps.
+func configSteps(config atc.Config) []step {
+	steps := []step{}
+
+	for _, job := range config.Jobs {
+		identifier := fmt.Sprintf("jobs.%s", job.Name)
+
+		for i, plan := range job.Plan {
+			steps = collectSteps(steps, fmt.Sprintf("%s.plan[%d]", identifier, i), plan)
+		}
+
+		hooks := []struct {
+			name string
+			plan *atc.PlanConfig
+		}{
+			{"abort", job.Abort},
+			{"failure", job.Failure},
+			{"ensure", job.Ensure},
+			{"success", job.Success},
+		}
+
+		for _, hook := range hooks {
+			if hook.plan != nil {
+				steps = collectSteps(steps, identifier+"."+hook.name, *hook.plan)
+			}
+		}
+	}
+
+	return steps
+}
+
+func collectSteps(steps []step, identifier string, plan atc.PlanConfig) []step {
+	switch {
+	case plan.Get != "":
+		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
+	case plan.Put != "":
+		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
+	case plan.Task != "":
+		identifier = fmt.Sprintf("%s.task.%s", identifier, plan.Task)
+	}
+
+	steps = append(steps, step{location: identifier, plan: plan})
+
+	if plan.Do != nil {
+		for i, nested := range *plan.Do {
+			steps = collectSteps(steps, fmt.Sprintf("%s[%d]", identifier, i), nested)
+		}
+	}
+
+	if plan.Aggregate != nil {
+		for i, nested := range *plan.Aggregate {
+			steps = collectSteps(steps, fmt.Sprintf("%s.aggregate[%d]", identifier, i), nested)
+		}
+	}
+
+	if plan.Try != nil {
+		steps = collectSteps(steps, identifier+".try", *plan.Try)
+	}
+
+	if plan.Abort != nil {
+		steps = collectSteps(steps, identifier+".abort", *plan.Abort)
+	}
+
+	if plan.Failure != nil {
+		steps = collectSteps(steps, identifier+".failure", *plan.Failure)
+	}
+
+	if plan.Ensure != nil {
+		steps = collectSteps(steps, identifier+".ensure", *plan.Ensure)
+	}
+
+	if plan.Success != nil {
+		steps = collectSteps(steps, identifier+".success", *plan.Success)
+	}
+
+	return steps
+}
+
+func resourceName(resource string, name string) string {
+	if resource != "" {
+		return resource
+	}
+
+	return name
+}
+
+func plaintextSecrets(identifier string, value interface{}) []Finding {
+	findings := []Finding{}
+
+	var keys []string
+	values := map[string]interface{}{}
+
+	switch v := value.(type) {
+	case atc.Source:
+		for key, val := range v {
+			keys = append(keys, key)
+			values[key] = val
+		}
+	case map[string]interface{}:
+		for key, val := range v {
+			keys = append(keys, key)
+			values[key] = val
+		}
+	case map[interface{}]interface{}:
+		for key, val := range v {
+			name := fmt.Sprintf("%v", key)
+			keys = append(keys, name)
+			values[name] = val
+		}
+	default:
+		return findings
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		location := identifier + "." + key
+
+		if str, ok := values[key].(string); ok {
+			if str != "" && secretKeyRegexp.MatchString(key) && !strings.Contains(str, "((") {
+				findings = append(findings, Finding{
+					Location: location,
+					Message:  fmt.Sprintf("%s looks like a credential, but is not provided by a ((var))", key),
+				})
+			}
+
+			continue
+		}
+
+		findings = append(findings, plaintextSecrets(location, values[key])...)
+	}
+
+	return findings
+}
+
+func imagePinned(imageResource atc.ImageResource) bool {
+	if imageResource.Version != nil {
+		return true
+	}
+
+	if digest, ok := imageResource.Source["digest"].(string); ok && digest != "" {
+		return true
+	}
+
+	tag, ok := imageResource.Source["tag"].(string)
+	return ok && tag != "" && tag != "latest"
+}
@@ -0,0 +1,137 @@
+package lint_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/lint"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rules", func() {
+	var (
+		cpu    uint64 = 1024
+		config atc.Config
+	)
+
+	BeforeEach(func() {
+		config = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{
+					Name: "some-resource",
+					Type: "git",
+					Source: atc.Source{
+						"uri":         "https://example.com",
+						"private_key": "((private-key))",
+					},
+				},
+				{
+					Name: "some-unused-resource",
+					Type: "s3",
+					Source: atc.Source{
+						"access_key_id":     "AKIA",
+						"secret_access_key": "((secret))",
+						"nested":            map[interface{}]interface{}{"password": "hunter2"},
+					},
+				},
+			},
+			Jobs: atc.JobConfigs{
+				{
+					Name: "some-job",
+					Plan: atc.PlanSequence{
+						{
+							Aggregate: &atc.PlanSequence{
+								{Get: "some-resource", Trigger: true},
+								{Get: "some-input", Resource: "some-resource"},
+							},
+						},
+						{
+							Task: "some-task",
+							TaskConfig: &atc.TaskConfig{
+								ImageResource: &atc.ImageResource{
+									Type:   "registry-image",
+									Source: atc.Source{"repository": "ubuntu"},
+								},
+							},
+						},
+						{
+							Task: "some-limited-task",
+							TaskConfig: &atc.TaskConfig{
+								Limits: atc.ContainerLimits{CPU: &cpu},
+								ImageResource: &atc.ImageResource{
+									Type:   "registry-image",
+									Source: atc.Source{"repository": "ubuntu", "tag": "18.04"},
+								},
+							},
+						},
+					},
+					Success: &atc.PlanConfig{
+						Put: "some-resource",
+					},
+				},
+			},
+		}
+	})
+
+	check := func(rule lint.Rule) []lint.Finding {
+		return lint.LintConfig(config, []lint.Rule{rule}, lint.Ignores{})
+	}
+
+	locations := func(findings []lint.Finding) []string {
+		locations := []string{}
+		for _, finding := range findings {
+			locations = append(locations, finding.Location)
+		}
+
+		return locations
+	}
+
+	Describe("UnusedResourceRule", func() {
+		It("finds resources which no step gets or puts", func() {
+			Expect(locations(check(lint.UnusedResourceRule))).To(Equal([]string{
+				"resources.some-unused-resource",
+			}))
+		})
+	})
+
+	Describe("UntriggeredGetRule", func() {
+		It("finds get steps with neither trigger nor passed", func() {
+			Expect(locations(check(lint.UntriggeredGetRule))).To(Equal([]string{
+				"jobs.some-job.plan[0].aggregate[1].get.some-input",
+			}))
+		})
+	})
+
+	Describe("PlaintextSecretRule", func() {
+		It("finds credential-like source fields which are not ((vars))", func() {
+			Expect(locations(check(lint.PlaintextSecretRule))).To(Equal([]string{
+				"resources.some-unused-resource.source.access_key_id",
+				"resources.some-unused-resource.source.nested.password",
+			}))
+		})
+	})
+
+	Describe("MissingContainerLimitsRule", func() {
+		It("finds inline task configs without container limits", func() {
+			Expect(locations(check(lint.MissingContainerLimitsRule))).To(Equal([]string{
+				"jobs.some-job.plan[1].task.some-task",
+			}))
+		})
+	})
+
+	Describe("UnpinnedImageRule", func() {
+		It("finds image resources without a version, digest or tag", func() {
+			Expect(locations(check(lint.UnpinnedImageRule))).To(Equal([]string{
+				"jobs.some-job.plan[1].task.some-task",
+			}))
+		})
+	})
+
+	Describe("DeprecatedAggregateRule", func() {
+		It("finds aggregate steps", func() {
+			Expect(locations(check(lint.DeprecatedAggregateRule))).To(Equal([]string{
+				"jobs.some-job.plan[0]",
+			}))
+		})
+	})
+})
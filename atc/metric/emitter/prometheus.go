@@ -30,6 +30,9 @@ type PrometheusEmitter struct {
 
 	errorLogs *prometheus.CounterVec
 
+	gcCollectorDuration *prometheus.HistogramVec
+	gcDestroyed         *prometheus.CounterVec
+
 	httpRequestsDuration *prometheus.HistogramVec
 
 	locksHeld *prometheus.GaugeVec
@@ -258,6 +261,29 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(dbConnections)
 
+	// gc metrics
+	gcCollectorDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "gc",
+			Name:      "collector_duration_seconds",
+			Help:      "Time taken by each garbage collector",
+		},
+		[]string{"collector"},
+	)
+	prometheus.MustRegister(gcCollectorDuration)
+
+	gcDestroyed := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "gc",
+			Name:      "destroyed_total",
+			Help:      "Number of objects destroyed by each garbage collector, or which would have been in dry-run mode",
+		},
+		[]string{"collector", "kind", "dry_run"},
+	)
+	prometheus.MustRegister(gcDestroyed)
+
 	resourceChecksVec := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "concourse",
@@ -291,6 +317,9 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 		errorLogs: errorLogs,
 
+		gcCollectorDuration: gcCollectorDuration,
+		gcDestroyed:         gcDestroyed,
+
 		httpRequestsDuration: httpRequestsDuration,
 
 		locksHeld: locksHeld,
@@ -350,6 +379,10 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.databaseMetrics(logger, event)
 	case "resource checked":
 		emitter.resourceMetric(logger, event)
+	case "gc: collector duration (ms)":
+		emitter.gcCollectorDurationMetric(logger, event)
+	case "gc: destroyed":
+		emitter.gcDestroyedMetric(logger, event)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -585,6 +618,39 @@ func (emitter *PrometheusEmitter) resourceMetric(logger lager.Logger, event metr
 	emitter.resourceChecksVec.WithLabelValues(team, pipeline).Inc()
 }
 
+func (emitter *PrometheusEmitter) gcCollectorDurationMetric(logger lager.Logger, event metric.Event) {
+	collector, exists := event.Attributes["collector"]
+	if !exists {
+		logger.Error("failed-to-find-collector-in-event", fmt.Errorf("expected collector to exist in event.Attributes"))
+		return
+	}
+
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("gc-collector-duration-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	// seconds are the standard prometheus base unit for time
+	emitter.gcCollectorDuration.WithLabelValues(collector).Observe(duration / 1000)
+}
+
+func (emitter *PrometheusEmitter) gcDestroyedMetric(logger lager.Logger, event metric.Event) {
+	collector, exists := event.Attributes["collector"]
+	if !exists {
+		logger.Error("failed-to-find-collector-in-event", fmt.Errorf("expected collector to exist in event.Attributes"))
+		return
+	}
+
+	count, ok := event.Value.(int)
+	if !ok {
+		logger.Error("gc-destroyed-type-mismatch", fmt.Errorf("expected event.Value to be an int"))
+		return
+	}
+
+	emitter.gcDestroyed.WithLabelValues(collector, event.Attributes["kind"], event.Attributes["dry_run"]).Add(float64(count))
+}
+
 // updateLastSeen tracks for each worker when it last received a metric event.
 func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	emitter.mu.Lock()
@@ -11,6 +11,7 @@ import (
 )
 
 var Databases []db.Conn
+var DatabaseReplicas []db.ReplicaConn
 var DatabaseQueries = Meter(0)
 
 var ContainersCreated = Meter(0)
@@ -22,6 +23,9 @@ var FailedVolumes = Meter(0)
 var ContainersDeleted = Meter(0)
 var VolumesDeleted = Meter(0)
 
+var CredentialCacheHits = Meter(0)
+var CredentialCacheMisses = Meter(0)
+
 type SchedulingFullDuration struct {
 	PipelineName string
 	Duration     time.Duration
@@ -299,6 +303,48 @@ func (event GarbageCollectionContainerCollectorJobDropped) Emit(logger lager.Log
 	)
 }
 
+type GarbageCollectionDuration struct {
+	Collector string
+	Duration  time.Duration
+}
+
+func (event GarbageCollectionDuration) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("gc-collector-duration"),
+		Event{
+			Name:  "gc: collector duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"collector": event.Collector,
+			},
+		},
+	)
+}
+
+type GarbageCollectionDestroyed struct {
+	Collector string
+	Kind      string
+	Count     int
+	DryRun    bool
+}
+
+func (event GarbageCollectionDestroyed) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("gc-collector-destroyed"),
+		Event{
+			Name:  "gc: destroyed",
+			Value: event.Count,
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"collector": event.Collector,
+				"kind":      event.Kind,
+				"dry_run":   strconv.FormatBool(event.DryRun),
+			},
+		},
+	)
+}
+
 type BuildStarted struct {
 	PipelineName string
 	JobName      string
@@ -536,6 +582,8 @@ func (event WorkersState) Emit(logger lager.Logger) {
 			numericState = 4
 		case db.WorkerStateRunning:
 			numericState = 5
+		case db.WorkerStateCordoned:
+			numericState = 6
 		}
 
 		emit(
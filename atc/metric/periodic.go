@@ -53,6 +53,27 @@ func tick(logger lager.Logger) {
 		}
 	}
 
+	for _, replicas := range DatabaseReplicas {
+		for _, replica := range replicas.ReplicaLags() {
+			state := EventStateOK
+			if !replica.InSync {
+				state = EventStateWarning
+			}
+
+			emit(
+				logger.Session("database-replica-lag"),
+				Event{
+					Name:  "database replica lag (ms)",
+					Value: ms(replica.Lag),
+					State: state,
+					Attributes: map[string]string{
+						"ConnectionName": replica.Name,
+					},
+				},
+			)
+		}
+	}
+
 	emit(
 		logger.Session("containers-deleted"),
 		Event{
@@ -107,6 +128,24 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	emit(
+		logger.Session("credential-cache-hits"),
+		Event{
+			Name:  "credential cache hits",
+			Value: CredentialCacheHits.Delta(),
+			State: EventStateOK,
+		},
+	)
+
+	emit(
+		logger.Session("credential-cache-misses"),
+		Event{
+			Name:  "credential cache misses",
+			Value: CredentialCacheMisses.Delta(),
+			State: EventStateOK,
+		},
+	)
+
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
 
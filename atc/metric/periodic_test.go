@@ -35,6 +35,13 @@ var _ = Describe("Periodic emission of metrics", func() {
 		b := &dbfakes.FakeConn{}
 		b.NameReturns("B")
 		metric.Databases = []db.Conn{a, b}
+
+		replicas := &dbfakes.FakeReplicaConn{}
+		replicas.ReplicaLagsReturns([]db.ReplicaLag{
+			{Name: "A-replica-0", Lag: 2 * time.Second, InSync: true},
+			{Name: "A-replica-1", Lag: time.Minute, InSync: false},
+		})
+		metric.DatabaseReplicas = []db.ReplicaConn{replicas}
 		metric.Initialize(nil, "test", map[string]string{})
 
 		process = ifrit.Invoke(metric.PeriodicallyEmit(lager.NewLogger("dont care"), 250*time.Millisecond))
@@ -44,6 +51,7 @@ var _ = Describe("Periodic emission of metrics", func() {
 		process.Signal(os.Interrupt)
 		<-process.Wait()
 		metric.Deinitialize(nil)
+		metric.DatabaseReplicas = nil
 	})
 
 	It("emits database queries", func() {
@@ -80,4 +88,31 @@ var _ = Describe("Periodic emission of metrics", func() {
 			),
 		)
 	})
+
+	It("emits the lag of each database replica", func() {
+		Eventually(emitter.EmitCallCount).Should(BeNumerically(">=", 1))
+		Expect(emitter.Invocations()["Emit"]).To(
+			ContainElement(
+				ContainElement(
+					MatchFields(IgnoreExtras, Fields{
+						"Name":       Equal("database replica lag (ms)"),
+						"Value":      Equal(float64(2000)),
+						"State":      Equal(metric.EventStateOK),
+						"Attributes": Equal(map[string]string{"ConnectionName": "A-replica-0"}),
+					}),
+				),
+			),
+		)
+		Expect(emitter.Invocations()["Emit"]).To(
+			ContainElement(
+				ContainElement(
+					MatchFields(IgnoreExtras, Fields{
+						"Name":       Equal("database replica lag (ms)"),
+						"State":      Equal(metric.EventStateWarning),
+						"Attributes": Equal(map[string]string{"ConnectionName": "A-replica-1"}),
+					}),
+				),
+			),
+		)
+	})
 })
@@ -78,6 +78,8 @@ type GetPlan struct {
 	VersionFrom *PlanID  `json:"version_from,omitempty"`
 	Tags        Tags     `json:"tags,omitempty"`
 
+	WorkerSelector WorkerSelector `json:"worker_selector,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
@@ -90,6 +92,8 @@ type PutPlan struct {
 	Tags     Tags     `json:"tags,omitempty"`
 	Inputs   []string `json:"inputs,omitempty"`
 
+	WorkerSelector WorkerSelector `json:"worker_selector,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
@@ -99,6 +103,8 @@ type TaskPlan struct {
 	Privileged bool `json:"privileged"`
 	Tags       Tags `json:"tags,omitempty"`
 
+	WorkerSelector WorkerSelector `json:"worker_selector,omitempty"`
+
 	ConfigPath string      `json:"config_path,omitempty"`
 	Config     *TaskConfig `json:"config,omitempty"`
 	Vars       Params      `json:"vars,omitempty"`
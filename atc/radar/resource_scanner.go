@@ -285,6 +285,8 @@ func (scanner *resourceScanner) check(
 		Tags:          savedResource.Tags(),
 		ResourceTypes: resourceTypes,
 		TeamID:        scanner.dbPipeline.TeamID(),
+
+		WorkerSelector: savedResource.WorkerSelector(),
 	}
 
 	res, err := scanner.resourceFactory.NewResource(
@@ -108,6 +108,7 @@ var _ = Describe("ResourceScanner", func() {
 		fakeDBResource.TypeReturns("git")
 		fakeDBResource.SourceReturns(atc.Source{"uri": "((source-params))"})
 		fakeDBResource.TagsReturns(atc.Tags{"some-tag"})
+		fakeDBResource.WorkerSelectorReturns(atc.WorkerSelector{"arch": "arm64"})
 		fakeDBResource.SetResourceConfigReturns(fakeResourceConfigScope, nil)
 
 		fakeDBPipeline.ResourceReturns(fakeDBResource, true, nil)
@@ -200,6 +201,8 @@ var _ = Describe("ResourceScanner", func() {
 					Tags:          atc.Tags{"some-tag"},
 					ResourceTypes: creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{versionedResourceType}),
 					TeamID:        123,
+
+					WorkerSelector: atc.WorkerSelector{"arch": "arm64"},
 				}))
 				Expect(resourceTypes).To(Equal(creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{
 					versionedResourceType,
@@ -548,6 +551,8 @@ var _ = Describe("ResourceScanner", func() {
 					Tags:          atc.Tags{"some-tag"},
 					ResourceTypes: creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{versionedResourceType}),
 					TeamID:        123,
+
+					WorkerSelector: atc.WorkerSelector{"arch": "arm64"},
 				}))
 				Expect(resourceTypes).To(Equal(creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{
 					versionedResourceType,
@@ -18,6 +18,7 @@ type FetchSourceProviderFactory interface {
 		session Session,
 		metadata Metadata,
 		tags atc.Tags,
+		workerSelector atc.WorkerSelector,
 		teamID int,
 		resourceTypes creds.VersionedResourceTypes,
 		resourceInstance ResourceInstance,
@@ -59,6 +60,7 @@ func (f *fetchSourceProviderFactory) NewFetchSourceProvider(
 	session Session,
 	metadata Metadata,
 	tags atc.Tags,
+	workerSelector atc.WorkerSelector,
 	teamID int,
 	resourceTypes creds.VersionedResourceTypes,
 	resourceInstance ResourceInstance,
@@ -69,6 +71,7 @@ func (f *fetchSourceProviderFactory) NewFetchSourceProvider(
 		session:                session,
 		metadata:               metadata,
 		tags:                   tags,
+		workerSelector:         workerSelector,
 		teamID:                 teamID,
 		resourceTypes:          resourceTypes,
 		resourceInstance:       resourceInstance,
@@ -83,6 +86,7 @@ type fetchSourceProvider struct {
 	session                Session
 	metadata               Metadata
 	tags                   atc.Tags
+	workerSelector         atc.WorkerSelector
 	teamID                 int
 	resourceTypes          creds.VersionedResourceTypes
 	resourceInstance       ResourceInstance
@@ -97,6 +101,8 @@ func (f *fetchSourceProvider) Get() (FetchSource, error) {
 		Tags:          f.tags,
 		TeamID:        f.teamID,
 		ResourceTypes: f.resourceTypes,
+
+		WorkerSelector: f.workerSelector,
 	}
 
 	chosenWorker, err := f.workerClient.Satisfying(f.logger.Session("fetch-source-provider"), resourceSpec)
@@ -111,6 +117,7 @@ func (f *fetchSourceProvider) Get() (FetchSource, error) {
 		chosenWorker,
 		f.resourceTypes,
 		f.tags,
+		f.workerSelector,
 		f.teamID,
 		f.session,
 		f.metadata,
@@ -29,6 +29,7 @@ var _ = Describe("FetchSourceProvider", func() {
 		metadata                 = resource.EmptyMetadata{}
 		session                  = resource.Session{}
 		tags                     atc.Tags
+		workerSelector           atc.WorkerSelector
 		resourceTypes            creds.VersionedResourceTypes
 		teamID                   = 3
 		fakeResourceCacheFactory *dbfakes.FakeResourceCacheFactory
@@ -43,6 +44,7 @@ var _ = Describe("FetchSourceProvider", func() {
 		logger = lagertest.NewTestLogger("test")
 		resourceInstance = new(resourcefakes.FakeResourceInstance)
 		tags = atc.Tags{"some", "tags"}
+		workerSelector = atc.WorkerSelector{"arch": "arm64"}
 
 		variables := template.StaticVariables{
 			"secret-repository": "repository",
@@ -69,6 +71,7 @@ var _ = Describe("FetchSourceProvider", func() {
 			session,
 			metadata,
 			tags,
+			workerSelector,
 			teamID,
 			resourceTypes,
 			resourceInstance,
@@ -87,6 +90,8 @@ var _ = Describe("FetchSourceProvider", func() {
 				Tags:          tags,
 				TeamID:        teamID,
 				ResourceTypes: resourceTypes,
+
+				WorkerSelector: workerSelector,
 			}))
 		})
 
@@ -108,6 +113,7 @@ var _ = Describe("FetchSourceProvider", func() {
 					fakeWorker,
 					resourceTypes,
 					tags,
+					workerSelector,
 					teamID,
 					session,
 					metadata,
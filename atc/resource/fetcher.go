@@ -27,6 +27,7 @@ type Fetcher interface {
 		logger lager.Logger,
 		session Session,
 		tags atc.Tags,
+		workerSelector atc.WorkerSelector,
 		teamID int,
 		resourceTypes creds.VersionedResourceTypes,
 		resourceInstance ResourceInstance,
@@ -58,6 +59,7 @@ func (f *fetcher) Fetch(
 	logger lager.Logger,
 	session Session,
 	tags atc.Tags,
+	workerSelector atc.WorkerSelector,
 	teamID int,
 	resourceTypes creds.VersionedResourceTypes,
 	resourceInstance ResourceInstance,
@@ -69,6 +71,7 @@ func (f *fetcher) Fetch(
 		session,
 		metadata,
 		tags,
+		workerSelector,
 		teamID,
 		resourceTypes,
 		resourceInstance,
@@ -16,6 +16,7 @@ type resourceInstanceFetchSource struct {
 	worker                 worker.Worker
 	resourceTypes          creds.VersionedResourceTypes
 	tags                   atc.Tags
+	workerSelector         atc.WorkerSelector
 	teamID                 int
 	session                Session
 	metadata               Metadata
@@ -29,6 +30,7 @@ func NewResourceInstanceFetchSource(
 	worker worker.Worker,
 	resourceTypes creds.VersionedResourceTypes,
 	tags atc.Tags,
+	workerSelector atc.WorkerSelector,
 	teamID int,
 	session Session,
 	metadata Metadata,
@@ -41,6 +43,7 @@ func NewResourceInstanceFetchSource(
 		worker:                 worker,
 		resourceTypes:          resourceTypes,
 		tags:                   tags,
+		workerSelector:         workerSelector,
 		teamID:                 teamID,
 		session:                session,
 		metadata:               metadata,
@@ -115,6 +118,8 @@ func (s *resourceInstanceFetchSource) Create(ctx context.Context) (VersionedSour
 		Tags:          s.tags,
 		TeamID:        s.teamID,
 		ResourceTypes: s.resourceTypes,
+
+		WorkerSelector: s.workerSelector,
 	}
 
 	resourceFactory := NewResourceFactory(s.worker)
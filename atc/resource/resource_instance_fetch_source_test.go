@@ -107,6 +107,7 @@ var _ = Describe("ResourceInstanceFetchSource", func() {
 			fakeWorker,
 			resourceTypes,
 			atc.Tags{},
+			nil,
 			42,
 			resource.Session{},
 			resource.EmptyMetadata{},
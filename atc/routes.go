@@ -66,17 +66,23 @@ const (
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
 
-	RegisterWorker  = "RegisterWorker"
-	LandWorker      = "LandWorker"
-	RetireWorker    = "RetireWorker"
-	PruneWorker     = "PruneWorker"
-	HeartbeatWorker = "HeartbeatWorker"
-	ListWorkers     = "ListWorkers"
-	DeleteWorker    = "DeleteWorker"
+	RegisterWorker   = "RegisterWorker"
+	LandWorker       = "LandWorker"
+	RetireWorker     = "RetireWorker"
+	CordonWorker     = "CordonWorker"
+	UncordonWorker   = "UncordonWorker"
+	PruneWorker      = "PruneWorker"
+	HeartbeatWorker  = "HeartbeatWorker"
+	ConnectWorker    = "ConnectWorker"
+	ListWorkerBuilds = "ListWorkerBuilds"
+	ListWorkers      = "ListWorkers"
+	DeleteWorker     = "DeleteWorker"
 
 	SetLogLevel = "SetLogLevel"
 	GetLogLevel = "GetLogLevel"
 
+	GetEncryptionKeyRotation = "GetEncryptionKeyRotation"
+
 	DownloadCLI  = "DownloadCLI"
 	GetInfo      = "Info"
 	GetInfoCreds = "InfoCreds"
@@ -84,6 +90,8 @@ const (
 	ListContainers           = "ListContainers"
 	GetContainer             = "GetContainer"
 	HijackContainer          = "HijackContainer"
+	StreamOutContainerFiles  = "StreamOutContainerFiles"
+	StreamInContainerFiles   = "StreamInContainerFiles"
 	ListDestroyingContainers = "ListDestroyingContainers"
 	ReportWorkerContainers   = "ReportWorkerContainers"
 
@@ -97,13 +105,15 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
-	SendInputToBuildPlan    = "SendInputToBuildPlan"
-	ReadOutputFromBuildPlan = "ReadOutputFromBuildPlan"
+	SendInputToBuildPlan      = "SendInputToBuildPlan"
+	ReadOutputFromBuildPlan   = "ReadOutputFromBuildPlan"
+	ReadArtifactFromBuildStep = "ReadArtifactFromBuildStep"
 )
 
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+	SaveConfigDryRun        = "dry_run"
 )
 
 var Routes = rata.Routes([]rata.Route{
@@ -117,6 +127,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/plan", Method: "GET", Name: GetBuildPlan},
 	{Path: "/api/v1/builds/:build_id/plan/:plan_id/input", Method: "PUT", Name: SendInputToBuildPlan},
 	{Path: "/api/v1/builds/:build_id/plan/:plan_id/output", Method: "GET", Name: ReadOutputFromBuildPlan},
+	{Path: "/api/v1/builds/:build_id/steps/:step_name/artifacts/:artifact_name", Method: "GET", Name: ReadArtifactFromBuildStep},
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
@@ -175,13 +186,19 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/workers", Method: "POST", Name: RegisterWorker},
 	{Path: "/api/v1/workers/:worker_name/land", Method: "PUT", Name: LandWorker},
 	{Path: "/api/v1/workers/:worker_name/retire", Method: "PUT", Name: RetireWorker},
+	{Path: "/api/v1/workers/:worker_name/cordon", Method: "PUT", Name: CordonWorker},
+	{Path: "/api/v1/workers/:worker_name/uncordon", Method: "PUT", Name: UncordonWorker},
 	{Path: "/api/v1/workers/:worker_name/prune", Method: "PUT", Name: PruneWorker},
 	{Path: "/api/v1/workers/:worker_name/heartbeat", Method: "PUT", Name: HeartbeatWorker},
+	{Path: "/api/v1/workers/:worker_name/connect", Method: "GET", Name: ConnectWorker},
+	{Path: "/api/v1/workers/:worker_name/builds", Method: "GET", Name: ListWorkerBuilds},
 	{Path: "/api/v1/workers/:worker_name", Method: "DELETE", Name: DeleteWorker},
 
 	{Path: "/api/v1/log-level", Method: "GET", Name: GetLogLevel},
 	{Path: "/api/v1/log-level", Method: "PUT", Name: SetLogLevel},
 
+	{Path: "/api/v1/encryption/rotation", Method: "GET", Name: GetEncryptionKeyRotation},
+
 	{Path: "/api/v1/cli", Method: "GET", Name: DownloadCLI},
 	{Path: "/api/v1/info", Method: "GET", Name: GetInfo},
 	{Path: "/api/v1/info/creds", Method: "GET", Name: GetInfoCreds},
@@ -191,6 +208,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
 	{Path: "/api/v1/teams/:team_name/containers/:id", Method: "GET", Name: GetContainer},
 	{Path: "/api/v1/teams/:team_name/containers/:id/hijack", Method: "GET", Name: HijackContainer},
+	{Path: "/api/v1/teams/:team_name/containers/:id/files", Method: "GET", Name: StreamOutContainerFiles},
+	{Path: "/api/v1/teams/:team_name/containers/:id/files", Method: "PUT", Name: StreamInContainerFiles},
 
 	{Path: "/api/v1/teams/:team_name/volumes", Method: "GET", Name: ListVolumes},
 	{Path: "/api/v1/volumes/destroying", Method: "GET", Name: ListDestroyingVolumes},
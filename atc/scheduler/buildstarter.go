@@ -24,7 +24,7 @@ type BuildStarter interface {
 //go:generate counterfeiter . BuildFactory
 
 type BuildFactory interface {
-	Create(atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []db.BuildInput) (atc.Plan, error)
+	Create(atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []atc.BuildInput) (atc.Plan, error)
 }
 
 func NewBuildStarter(
@@ -175,7 +175,15 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		})
 	}
 
-	plan, err := s.factory.Create(job.Config(), resourceConfigs, resourceTypes, buildInputs)
+	planInputs := []atc.BuildInput{}
+	for _, input := range buildInputs {
+		planInputs = append(planInputs, atc.BuildInput{
+			Name:    input.Name,
+			Version: input.Version,
+		})
+	}
+
+	plan, err := s.factory.Create(job.Config(), resourceConfigs, resourceTypes, planInputs)
 	if err != nil {
 		// Don't use ErrorBuild because it logs a build event, and this build hasn't started
 		err := nextPendingBuild.Finish(db.BuildStatusErrored)
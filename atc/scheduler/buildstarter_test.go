@@ -440,7 +440,7 @@ var _ = Describe("BuildStarter", func() {
 									Expect(actualJobConfig).To(Equal(atc.JobConfig{Name: "some-job"}))
 									Expect(actualResourceConfigs).To(Equal(atc.ResourceConfigs{{Name: "some-resource"}}))
 									Expect(actualResourceTypes).To(Equal(versionedResourceTypes))
-									Expect(actualBuildInputs).To(Equal([]db.BuildInput{{Name: "some-input"}}))
+									Expect(actualBuildInputs).To(Equal([]atc.BuildInput{{Name: "some-input"}}))
 								})
 
 								Context("when marking the build as errored fails", func() {
@@ -482,19 +482,19 @@ var _ = Describe("BuildStarter", func() {
 									Expect(actualJobConfig).To(Equal(atc.JobConfig{Name: "some-job"}))
 									Expect(actualResourceConfigs).To(Equal(atc.ResourceConfigs{{Name: "some-resource"}}))
 									Expect(actualResourceTypes).To(Equal(versionedResourceTypes))
-									Expect(actualBuildInputs).To(Equal([]db.BuildInput{{Name: "some-input"}}))
+									Expect(actualBuildInputs).To(Equal([]atc.BuildInput{{Name: "some-input"}}))
 
 									actualJobConfig, actualResourceConfigs, actualResourceTypes, actualBuildInputs = fakeFactory.CreateArgsForCall(1)
 									Expect(actualJobConfig).To(Equal(atc.JobConfig{Name: "some-job"}))
 									Expect(actualResourceConfigs).To(Equal(atc.ResourceConfigs{{Name: "some-resource"}}))
 									Expect(actualResourceTypes).To(Equal(versionedResourceTypes))
-									Expect(actualBuildInputs).To(Equal([]db.BuildInput{{Name: "some-input"}}))
+									Expect(actualBuildInputs).To(Equal([]atc.BuildInput{{Name: "some-input"}}))
 
 									actualJobConfig, actualResourceConfigs, actualResourceTypes, actualBuildInputs = fakeFactory.CreateArgsForCall(2)
 									Expect(actualJobConfig).To(Equal(atc.JobConfig{Name: "some-job"}))
 									Expect(actualResourceConfigs).To(Equal(atc.ResourceConfigs{{Name: "some-resource"}}))
 									Expect(actualResourceTypes).To(Equal(versionedResourceTypes))
-									Expect(actualBuildInputs).To(Equal([]db.BuildInput{{Name: "some-input"}}))
+									Expect(actualBuildInputs).To(Equal([]atc.BuildInput{{Name: "some-input"}}))
 								})
 
 								Context("when creating the engine build fails", func() {
@@ -4,7 +4,6 @@ import (
 	"errors"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/atc/db"
 )
 
 var ErrResourceNotFound = errors.New("resource not found")
@@ -12,7 +11,7 @@ var ErrResourceNotFound = errors.New("resource not found")
 //go:generate counterfeiter . BuildFactory
 
 type BuildFactory interface {
-	Create(atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []db.BuildInput) (atc.Plan, error)
+	Create(atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []atc.BuildInput) (atc.Plan, error)
 }
 
 type buildFactory struct {
@@ -31,7 +30,7 @@ func (factory *buildFactory) Create(
 	job atc.JobConfig,
 	resources atc.ResourceConfigs,
 	resourceTypes atc.VersionedResourceTypes,
-	inputs []db.BuildInput,
+	inputs []atc.BuildInput,
 ) (atc.Plan, error) {
 	plan, err := factory.constructPlanFromJob(job, resources, resourceTypes, inputs)
 	if err != nil {
@@ -51,7 +50,7 @@ func (factory *buildFactory) constructPlanFromJob(
 	job atc.JobConfig,
 	resources atc.ResourceConfigs,
 	resourceTypes atc.VersionedResourceTypes,
-	inputs []db.BuildInput,
+	inputs []atc.BuildInput,
 ) (atc.Plan, error) {
 	planSequence := job.Plan
 
@@ -71,7 +70,7 @@ func (factory *buildFactory) do(
 	planSequence atc.PlanSequence,
 	resources atc.ResourceConfigs,
 	resourceTypes atc.VersionedResourceTypes,
-	inputs []db.BuildInput,
+	inputs []atc.BuildInput,
 ) (atc.Plan, error) {
 	do := atc.DoPlan{}
 
@@ -97,7 +96,7 @@ func (factory *buildFactory) constructPlanFromConfig(
 	planConfig atc.PlanConfig,
 	resources atc.ResourceConfigs,
 	resourceTypes atc.VersionedResourceTypes,
-	inputs []db.BuildInput,
+	inputs []atc.BuildInput,
 ) (atc.Plan, error) {
 	var plan atc.Plan
 	var err error
@@ -135,7 +134,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 	planConfig atc.PlanConfig,
 	resources atc.ResourceConfigs,
 	resourceTypes atc.VersionedResourceTypes,
-	inputs []db.BuildInput,
+	inputs []atc.BuildInput,
 ) (atc.Plan, error) {
 	var plan atc.Plan
 	var err error
@@ -173,6 +172,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Params:   planConfig.Params,
 			Tags:     planConfig.Tags,
 
+			WorkerSelector: planConfig.WorkerSelector,
+
 			VersionedResourceTypes: resourceTypes,
 		}
 
@@ -192,6 +193,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Tags:   planConfig.Tags,
 			Source: resource.Source,
 
+			WorkerSelector: planConfig.WorkerSelector,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 
@@ -215,7 +218,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 		var version atc.Version
 		for _, input := range inputs {
 			if input.Name == name {
-				version = atc.Version(input.Version)
+				version = input.Version
 				break
 			}
 		}
@@ -229,6 +232,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Version:  &version,
 			Tags:     planConfig.Tags,
 
+			WorkerSelector: planConfig.WorkerSelector,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 
@@ -245,6 +250,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			OutputMapping:     planConfig.OutputMapping,
 			ImageArtifactName: planConfig.ImageArtifactName,
 
+			WorkerSelector: planConfig.WorkerSelector,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 	case planConfig.Try != nil:
@@ -297,7 +304,7 @@ type constructionParams struct {
 	hooks         atc.Hooks
 	resources     atc.ResourceConfigs
 	resourceTypes atc.VersionedResourceTypes
-	inputs        []db.BuildInput
+	inputs        []atc.BuildInput
 }
 
 func (factory *buildFactory) applyHooks(cp constructionParams) (atc.Plan, error) {
@@ -75,6 +75,38 @@ var _ = Describe("Factory Get", func() {
 		})
 	})
 
+	Context("with a get with a worker selector", func() {
+		BeforeEach(func() {
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Get:            "some-get",
+						Resource:       "some-resource",
+						WorkerSelector: atc.WorkerSelector{"arch": "arm64"},
+					},
+				},
+			}
+		})
+
+		It("returns a plan with the worker selector", func() {
+			actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.GetPlan{
+				Type:     "git",
+				Name:     "some-get",
+				Resource: "some-resource",
+				Source: atc.Source{
+					"uri": "git://some-resource",
+				},
+				Version:                &version,
+				WorkerSelector:         atc.WorkerSelector{"arch": "arm64"},
+				VersionedResourceTypes: resourceTypes,
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
 	Context("with a get for a non-existent resource", func() {
 		BeforeEach(func() {
 			input = atc.JobConfig{
@@ -5,18 +5,17 @@ import (
 	sync "sync"
 
 	atc "github.com/concourse/concourse/atc"
-	db "github.com/concourse/concourse/atc/db"
 	factory "github.com/concourse/concourse/atc/scheduler/factory"
 )
 
 type FakeBuildFactory struct {
-	CreateStub        func(atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []db.BuildInput) (atc.Plan, error)
+	CreateStub        func(atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []atc.BuildInput) (atc.Plan, error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
 		arg1 atc.JobConfig
 		arg2 atc.ResourceConfigs
 		arg3 atc.VersionedResourceTypes
-		arg4 []db.BuildInput
+		arg4 []atc.BuildInput
 	}
 	createReturns struct {
 		result1 atc.Plan
@@ -30,10 +29,10 @@ type FakeBuildFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBuildFactory) Create(arg1 atc.JobConfig, arg2 atc.ResourceConfigs, arg3 atc.VersionedResourceTypes, arg4 []db.BuildInput) (atc.Plan, error) {
-	var arg4Copy []db.BuildInput
+func (fake *FakeBuildFactory) Create(arg1 atc.JobConfig, arg2 atc.ResourceConfigs, arg3 atc.VersionedResourceTypes, arg4 []atc.BuildInput) (atc.Plan, error) {
+	var arg4Copy []atc.BuildInput
 	if arg4 != nil {
-		arg4Copy = make([]db.BuildInput, len(arg4))
+		arg4Copy = make([]atc.BuildInput, len(arg4))
 		copy(arg4Copy, arg4)
 	}
 	fake.createMutex.Lock()
@@ -42,7 +41,7 @@ func (fake *FakeBuildFactory) Create(arg1 atc.JobConfig, arg2 atc.ResourceConfig
 		arg1 atc.JobConfig
 		arg2 atc.ResourceConfigs
 		arg3 atc.VersionedResourceTypes
-		arg4 []db.BuildInput
+		arg4 []atc.BuildInput
 	}{arg1, arg2, arg3, arg4Copy})
 	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3, arg4Copy})
 	fake.createMutex.Unlock()
@@ -62,13 +61,13 @@ func (fake *FakeBuildFactory) CreateCallCount() int {
 	return len(fake.createArgsForCall)
 }
 
-func (fake *FakeBuildFactory) CreateCalls(stub func(atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []db.BuildInput) (atc.Plan, error)) {
+func (fake *FakeBuildFactory) CreateCalls(stub func(atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []atc.BuildInput) (atc.Plan, error)) {
 	fake.createMutex.Lock()
 	defer fake.createMutex.Unlock()
 	fake.CreateStub = stub
 }
 
-func (fake *FakeBuildFactory) CreateArgsForCall(i int) (atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []db.BuildInput) {
+func (fake *FakeBuildFactory) CreateArgsForCall(i int) (atc.JobConfig, atc.ResourceConfigs, atc.VersionedResourceTypes, []atc.BuildInput) {
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	argsForCall := fake.createArgsForCall[i]
@@ -120,6 +120,10 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if _, err := resource.WorkerSelector.Requirements(); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s.worker_selector is invalid: %s", identifier, err))
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -224,6 +228,10 @@ func validateJobs(c Config) ([]Warning, error) {
 			)
 		}
 
+		if job.BuildLogRetention != nil {
+			errorMessages = append(errorMessages, validateBuildLogRetention(identifier, job)...)
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -543,6 +551,11 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if _, err := plan.WorkerSelector.Requirements(); err != nil {
+		subIdentifier := fmt.Sprintf("%s.worker_selector", identifier)
+		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" is invalid: %s", err))
+	}
+
 	return warnings, errorMessages
 }
 
@@ -600,3 +613,50 @@ func compositeErr(errorMessages []string) error {
 
 	return errors.New(strings.Join(errorMessages, "\n"))
 }
+
+func validateBuildLogRetention(identifier string, job JobConfig) []string {
+	errorMessages := []string{}
+
+	retention := job.BuildLogRetention
+
+	if job.BuildLogsToRetain != 0 {
+		errorMessages = append(
+			errorMessages,
+			identifier+" has both build_logs_to_retain and build_log_retention",
+		)
+	}
+
+	if retention.Days < 0 {
+		errorMessages = append(
+			errorMessages,
+			identifier+fmt.Sprintf(" has negative build_log_retention.days: %d", retention.Days),
+		)
+	}
+
+	if retention.Builds < 0 {
+		errorMessages = append(
+			errorMessages,
+			identifier+fmt.Sprintf(" has negative build_log_retention.builds: %d", retention.Builds),
+		)
+	}
+
+	if retention.MinimumSucceededBuilds < 0 {
+		errorMessages = append(
+			errorMessages,
+			identifier+fmt.Sprintf(" has negative build_log_retention.minimum_succeeded_builds: %d", retention.MinimumSucceededBuilds),
+		)
+	}
+
+	if retention.Builds > 0 && retention.MinimumSucceededBuilds > retention.Builds {
+		errorMessages = append(
+			errorMessages,
+			identifier+fmt.Sprintf(
+				" has build_log_retention.minimum_succeeded_builds (%d) greater than build_log_retention.builds (%d)",
+				retention.MinimumSucceededBuilds,
+				retention.Builds,
+			),
+		)
+	}
+
+	return errorMessages
+}
@@ -190,6 +190,18 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource has an invalid worker selector", func() {
+			BeforeEach(func() {
+				config.Resources[0].WorkerSelector = WorkerSelector{"zone in": "a"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.worker_selector is invalid: selector 'zone in' must have a list of values"))
+			})
+		})
+
 		Context("when two resources have the same name", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, config.Resources...)
@@ -459,6 +471,55 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has both build_logs_to_retain and build_log_retention", func() {
+			BeforeEach(func() {
+				job.BuildLogsToRetain = 10
+				job.BuildLogRetention = &BuildLogRetention{Builds: 10}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has both build_logs_to_retain and build_log_retention"))
+			})
+		})
+
+		Context("when a job has a negative build_log_retention", func() {
+			BeforeEach(func() {
+				job.BuildLogRetention = &BuildLogRetention{
+					Days:                   -1,
+					Builds:                 -2,
+					MinimumSucceededBuilds: -3,
+				}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has negative build_log_retention.days: -1"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has negative build_log_retention.builds: -2"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has negative build_log_retention.minimum_succeeded_builds: -3"))
+			})
+		})
+
+		Context("when a job retains more succeeded builds than builds", func() {
+			BeforeEach(func() {
+				job.BuildLogRetention = &BuildLogRetention{
+					Builds:                 2,
+					MinimumSucceededBuilds: 3,
+				}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has build_log_retention.minimum_succeeded_builds (3) greater than build_log_retention.builds (2)"))
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.Plan = append(job.Plan, PlanConfig{
@@ -598,6 +659,24 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a plan has an invalid worker selector", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:           "lol",
+						TaskConfigPath: "task.yml",
+						WorkerSelector: WorkerSelector{"arch ~=": "arm64"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol.worker_selector is invalid: unknown operator '~=' in selector 'arch ~='"))
+				})
+			})
+
 			Context("when a get plan has task-only fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
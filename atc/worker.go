@@ -21,14 +21,20 @@ type Worker struct {
 
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
-	Platform  string   `json:"platform"`
-	Tags      []string `json:"tags"`
-	Team      string   `json:"team"`
-	Name      string   `json:"name"`
-	Version   string   `json:"version"`
-	StartTime int64    `json:"start_time"`
-	Ephemeral bool     `json:"ephemeral"`
-	State     string   `json:"state"`
+	Platform  string            `json:"platform"`
+	Tags      []string          `json:"tags"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Team      string            `json:"team"`
+	Name      string            `json:"name"`
+	Version   string            `json:"version"`
+	StartTime int64             `json:"start_time"`
+	Ephemeral bool              `json:"ephemeral"`
+	State     string            `json:"state"`
+
+	CordonedBy   string `json:"cordoned_by,omitempty"`
+	CordonReason string `json:"cordon_reason,omitempty"`
+
+	Health *WorkerHealth `json:"health,omitempty"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -47,6 +53,30 @@ func (w Worker) Validate() error {
 	return nil
 }
 
+// WorkerHealth contains the results of the health checks run by the worker
+// itself, reported with each heartbeat.
+type WorkerHealth struct {
+	CheckedAt int64 `json:"checked_at"`
+
+	DiskFreeBytes  uint64 `json:"disk_free_bytes,omitempty"`
+	DiskTotalBytes uint64 `json:"disk_total_bytes,omitempty"`
+
+	InodesFree  uint64 `json:"inodes_free,omitempty"`
+	InodesTotal uint64 `json:"inodes_total,omitempty"`
+
+	Volumes int `json:"volumes"`
+
+	CanaryError string `json:"canary_error,omitempty"`
+
+	// Breaches describes each configured threshold that the worker has
+	// exceeded. A worker with breaches should not be given any new work.
+	Breaches []string `json:"breaches,omitempty"`
+}
+
+func (health WorkerHealth) Healthy() bool {
+	return len(health.Breaches) == 0
+}
+
 type WorkerResourceType struct {
 	Type                 string `json:"type"`
 	Image                string `json:"image"`
@@ -55,6 +85,10 @@ type WorkerResourceType struct {
 	UniqueVersionHistory bool   `json:"unique_version_history"`
 }
 
+type CordonWorkerRequest struct {
+	Reason string `json:"reason"`
+}
+
 type PruneWorkerResponseBody struct {
 	Stderr string `json:"stderr"`
 }
@@ -15,6 +15,9 @@ type WorkerSpec struct {
 	Tags          []string
 	TeamID        int
 	ResourceTypes creds.VersionedResourceTypes
+
+	// Selects workers by their labels.
+	WorkerSelector atc.WorkerSelector
 }
 
 type ContainerSpec struct {
@@ -101,5 +104,9 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if len(spec.WorkerSelector) > 0 {
+		attrs = append(attrs, fmt.Sprintf("worker selector '%s'", spec.WorkerSelector))
+	}
+
 	return strings.Join(attrs, ", ")
 }
@@ -359,7 +359,7 @@ var _ = Describe("Image", func() {
 
 							It("fetches resource with correct session", func() {
 								Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(1))
-								_, _, session, tags, actualTeamID, actualCustomTypes, resourceInstance, metadata, delegate := fakeResourceFetcher.FetchArgsForCall(0)
+								_, _, session, tags, workerSelector, actualTeamID, actualCustomTypes, resourceInstance, metadata, delegate := fakeResourceFetcher.FetchArgsForCall(0)
 								Expect(metadata).To(Equal(resource.EmptyMetadata{}))
 								Expect(session).To(Equal(resource.Session{
 									Metadata: db.ContainerMetadata{
@@ -367,6 +367,7 @@ var _ = Describe("Image", func() {
 									},
 								}))
 								Expect(tags).To(Equal(atc.Tags{"worker", "tags"}))
+								Expect(workerSelector).To(BeNil())
 								Expect(actualTeamID).To(Equal(teamID))
 								Expect(resourceInstance).To(Equal(resource.NewResourceInstance(
 									"docker",
@@ -575,7 +576,7 @@ var _ = Describe("Image", func() {
 
 					It("fetches resource with correct session", func() {
 						Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(1))
-						_, _, session, tags, actualTeamID, actualCustomTypes, resourceInstance, metadata, delegate := fakeResourceFetcher.FetchArgsForCall(0)
+						_, _, session, tags, workerSelector, actualTeamID, actualCustomTypes, resourceInstance, metadata, delegate := fakeResourceFetcher.FetchArgsForCall(0)
 						Expect(metadata).To(Equal(resource.EmptyMetadata{}))
 						Expect(session).To(Equal(resource.Session{
 							Metadata: db.ContainerMetadata{
@@ -583,6 +584,7 @@ var _ = Describe("Image", func() {
 							},
 						}))
 						Expect(tags).To(Equal(atc.Tags{"worker", "tags"}))
+						Expect(workerSelector).To(BeNil())
 						Expect(actualTeamID).To(Equal(teamID))
 						Expect(resourceInstance).To(Equal(resource.NewResourceInstance(
 							"docker",
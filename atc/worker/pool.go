@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -44,12 +46,43 @@ var (
 	ErrNoWorkers = errors.New("no workers")
 )
 
+// maxClosestWorkers is the number of workers listed in a
+// NoCompatibleWorkersError when no worker matches the worker selector.
+const maxClosestWorkers = 3
+
 type NoCompatibleWorkersError struct {
 	Spec WorkerSpec
+
+	// Workers which satisfy everything but the worker selector, ordered by the
+	// number of unmet label requirements.
+	ClosestWorkers []ClosestWorker
+}
+
+// ClosestWorker is a worker which only failed to satisfy a spec because of
+// its labels.
+type ClosestWorker struct {
+	Name  string
+	Unmet []atc.LabelRequirement
 }
 
 func (err NoCompatibleWorkersError) Error() string {
-	return fmt.Sprintf("no workers satisfying: %s", err.Spec.Description())
+	message := fmt.Sprintf("no workers satisfying: %s", err.Spec.Description())
+
+	if len(err.ClosestWorkers) > 0 {
+		closest := []string{}
+		for _, worker := range err.ClosestWorkers {
+			unmet := []string{}
+			for _, requirement := range worker.Unmet {
+				unmet = append(unmet, requirement.String())
+			}
+
+			closest = append(closest, fmt.Sprintf("%s (unmet: %s)", worker.Name, strings.Join(unmet, ", ")))
+		}
+
+		message += "; closest workers: " + strings.Join(closest, "; ")
+	}
+
+	return message
 }
 
 type pool struct {
@@ -79,6 +112,7 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 
 	compatibleTeamWorkers := []Worker{}
 	compatibleGeneralWorkers := []Worker{}
+	closestWorkers := []ClosestWorker{}
 	for _, worker := range workers {
 		satisfyingWorker, err := worker.Satisfying(logger, spec)
 		if err == nil {
@@ -87,6 +121,14 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 			} else {
 				compatibleGeneralWorkers = append(compatibleGeneralWorkers, satisfyingWorker)
 			}
+		} else if err == ErrMismatchedLabels {
+			unmet, err := spec.WorkerSelector.Unmatched(worker.Labels())
+			if err == nil {
+				closestWorkers = append(closestWorkers, ClosestWorker{
+					Name:  worker.Name(),
+					Unmet: unmet,
+				})
+			}
 		}
 	}
 
@@ -98,9 +140,27 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 		return compatibleGeneralWorkers, nil
 	}
 
-	return nil, NoCompatibleWorkersError{
+	noCompatibleWorkersErr := NoCompatibleWorkersError{
 		Spec: spec,
 	}
+
+	if len(closestWorkers) > 0 {
+		sort.SliceStable(closestWorkers, func(i, j int) bool {
+			if len(closestWorkers[i].Unmet) == len(closestWorkers[j].Unmet) {
+				return closestWorkers[i].Name < closestWorkers[j].Name
+			}
+
+			return len(closestWorkers[i].Unmet) < len(closestWorkers[j].Unmet)
+		})
+
+		if len(closestWorkers) > maxClosestWorkers {
+			closestWorkers = closestWorkers[:maxClosestWorkers]
+		}
+
+		noCompatibleWorkersErr.ClosestWorkers = closestWorkers
+	}
+
+	return nil, noCompatibleWorkersErr
 }
 
 func (pool *pool) Satisfying(logger lager.Logger, spec WorkerSpec) (Worker, error) {
@@ -132,6 +192,11 @@ func (pool *pool) FindOrCreateContainer(
 		return nil, err
 	}
 
+	// the worker may no longer match the selector if its labels have changed
+	if found && !workerSpec.WorkerSelector.Matches(worker.Labels()) {
+		found = false
+	}
+
 	if !found {
 		compatibleWorkers, err := pool.allSatisfying(logger, workerSpec)
 		if err != nil {
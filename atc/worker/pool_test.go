@@ -131,6 +131,81 @@ var _ = Describe("Pool", func() {
 					}))
 				})
 			})
+
+			Context("when no workers match the worker selector", func() {
+				var workerD *workerfakes.FakeWorker
+
+				BeforeEach(func() {
+					spec.WorkerSelector = atc.WorkerSelector{
+						"arch":    "arm64",
+						"zone in": []interface{}{"a", "b"},
+					}
+
+					workerD = new(workerfakes.FakeWorker)
+					fakeProvider.RunningWorkersReturns([]Worker{workerA, workerB, workerC, workerD}, nil)
+
+					workerA.NameReturns("worker-a")
+					workerA.LabelsReturns(map[string]string{})
+					workerA.SatisfyingReturns(nil, ErrMismatchedLabels)
+
+					workerB.NameReturns("worker-b")
+					workerB.LabelsReturns(map[string]string{"arch": "amd64", "zone": "a"})
+					workerB.SatisfyingReturns(nil, ErrMismatchedLabels)
+
+					workerD.NameReturns("worker-d")
+					workerD.LabelsReturns(map[string]string{"arch": "amd64", "zone": "c"})
+					workerD.SatisfyingReturns(nil, ErrMismatchedLabels)
+				})
+
+				It("lists the closest workers, excluding those which failed for other reasons", func() {
+					Expect(satisfyingErr).To(Equal(NoCompatibleWorkersError{
+						Spec: spec,
+						ClosestWorkers: []ClosestWorker{
+							{
+								Name: "worker-b",
+								Unmet: []atc.LabelRequirement{
+									{Label: "arch", Operator: atc.SelectorEquals, Values: []string{"arm64"}},
+								},
+							},
+							{
+								Name: "worker-a",
+								Unmet: []atc.LabelRequirement{
+									{Label: "arch", Operator: atc.SelectorEquals, Values: []string{"arm64"}},
+									{Label: "zone", Operator: atc.SelectorIn, Values: []string{"a", "b"}},
+								},
+							},
+							{
+								Name: "worker-d",
+								Unmet: []atc.LabelRequirement{
+									{Label: "arch", Operator: atc.SelectorEquals, Values: []string{"arm64"}},
+									{Label: "zone", Operator: atc.SelectorIn, Values: []string{"a", "b"}},
+								},
+							},
+						},
+					}))
+				})
+
+				It("describes the unmet requirements in the error", func() {
+					Expect(satisfyingErr.Error()).To(ContainSubstring("worker selector 'arch=arm64, zone in (a, b)'"))
+					Expect(satisfyingErr.Error()).To(ContainSubstring("closest workers: worker-b (unmet: arch=arm64); worker-a"))
+				})
+
+				Context("when more workers are close than can be listed", func() {
+					BeforeEach(func() {
+						workerC.NameReturns("worker-c")
+						workerC.LabelsReturns(map[string]string{"zone": "b"})
+						workerC.SatisfyingReturns(nil, ErrMismatchedLabels)
+					})
+
+					It("lists only the three closest", func() {
+						closest := satisfyingErr.(NoCompatibleWorkersError).ClosestWorkers
+						Expect(closest).To(HaveLen(3))
+						Expect(closest[0].Name).To(Equal("worker-b"))
+						Expect(closest[1].Name).To(Equal("worker-c"))
+						Expect(closest[2].Name).To(Equal("worker-a"))
+					})
+				})
+			})
 		})
 
 		Context("with no workers", func() {
@@ -342,6 +417,22 @@ var _ = Describe("Pool", func() {
 				Expect(actualTags).To(Equal(atc.Tags{"some-tag"}))
 				Expect(actualOwner).To(Equal(fakeOwner))
 			})
+
+			Context("when the worker no longer matches the worker selector", func() {
+				BeforeEach(func() {
+					workerSpec.WorkerSelector = atc.WorkerSelector{"arch": "arm64"}
+					fakeWorker.LabelsReturns(map[string]string{"arch": "amd64"})
+
+					fakeProvider.RunningWorkersReturns([]Worker{compatibleWorker}, nil)
+					fakeStrategy.ChooseReturns(compatibleWorker, nil)
+				})
+
+				It("creates the container on a newly chosen worker", func() {
+					Expect(createErr).NotTo(HaveOccurred())
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(BeZero())
+					Expect(compatibleWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+			})
 		})
 
 		Context("when no worker is found with the container", func() {
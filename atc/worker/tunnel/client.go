@@ -0,0 +1,162 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"golang.org/x/net/http2"
+)
+
+// Client runs on the ATC, opening streams to the worker over the tunnel.
+type Client struct {
+	logger lager.Logger
+
+	cc *http2.ClientConn
+}
+
+// NewClient performs the HTTP/2 handshake with the worker over the given
+// connection.
+func NewClient(logger lager.Logger, conn net.Conn) (*Client, error) {
+	h2 := &http2.Transport{}
+
+	cc, err := h2.NewClientConn(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		logger: logger,
+		cc:     cc,
+	}, nil
+}
+
+// Dial opens a stream to the given target on the worker.
+func (client *Client) Dial(target string) (io.ReadWriteCloser, error) {
+	pr, pw := io.Pipe()
+
+	req := &http.Request{
+		Method:        "CONNECT",
+		URL:           &url.URL{Host: target},
+		Host:          target,
+		Header:        http.Header{},
+		Body:          pr,
+		ContentLength: -1,
+	}
+
+	res, err := client.cc.RoundTrip(req)
+	if err != nil {
+		pw.Close()
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		pw.Close()
+		return nil, fmt.Errorf("bad response opening stream to %s: %s", target, res.Status)
+	}
+
+	return &stream{
+		ReadCloser: res.Body,
+		writer:     pw,
+	}, nil
+}
+
+// Forward accepts connections from the listener and forwards each one through
+// a new stream to the given target, until the listener is closed.
+func (client *Client) Forward(listener net.Listener, target string) {
+	logger := client.logger.Session("forward", lager.Data{
+		"target": target,
+		"addr":   listener.Addr().String(),
+	})
+
+	for {
+		localConn, err := listener.Accept()
+		if err != nil {
+			logger.Debug("stopped-accepting", lager.Data{"error": err.Error()})
+			return
+		}
+
+		go client.handleConn(logger, localConn, target)
+	}
+}
+
+// Wait blocks until the worker stops responding to pings, checking on the
+// given interval, or the context is done.
+func (client *Client) Wait(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(ctx, interval)
+			err := client.cc.Ping(pingCtx)
+			cancel()
+
+			if err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Close tears down the tunnel, interrupting any in-flight streams.
+func (client *Client) Close() error {
+	return client.cc.Close()
+}
+
+func (client *Client) handleConn(logger lager.Logger, localConn net.Conn, target string) {
+	defer localConn.Close()
+
+	remote, err := client.Dial(target)
+	if err != nil {
+		logger.Error("failed-to-open-stream", err)
+		return
+	}
+
+	defer remote.Close()
+
+	wg := new(sync.WaitGroup)
+
+	pipe := func(to io.WriteCloser, from io.ReadCloser) {
+		// if either end breaks, close both ends to ensure they're both unblocked
+		defer to.Close()
+		defer from.Close()
+		defer wg.Done()
+
+		io.Copy(to, from)
+	}
+
+	wg.Add(1)
+	go pipe(localConn, remote)
+
+	wg.Add(1)
+	go pipe(remote, localConn)
+
+	wg.Wait()
+}
+
+type stream struct {
+	io.ReadCloser
+
+	writer *io.PipeWriter
+}
+
+func (s *stream) Write(p []byte) (int, error) {
+	return s.writer.Write(p)
+}
+
+func (s *stream) Close() error {
+	s.writer.Close()
+	return s.ReadCloser.Close()
+}
@@ -0,0 +1,115 @@
+package tunnel
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"code.cloudfoundry.org/lager"
+	"golang.org/x/net/http2"
+)
+
+// Target is a local address to which streams are forwarded.
+type Target struct {
+	Network string
+	Addr    string
+}
+
+// Server runs on the worker, serving the streams opened by the ATC over the
+// tunnel by forwarding them to the configured targets.
+type Server struct {
+	Logger lager.Logger
+
+	Targets map[string]Target
+
+	active int64
+}
+
+// Serve handles streams over the given connection until it is closed.
+func (server *Server) Serve(conn net.Conn) {
+	h2 := &http2.Server{}
+	h2.ServeConn(conn, &http2.ServeConnOpts{
+		Handler: server,
+	})
+}
+
+// ActiveStreams returns the number of streams currently being forwarded.
+func (server *Server) ActiveStreams() int {
+	return int(atomic.LoadInt64(&server.active))
+}
+
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := server.Logger.Session("stream", lager.Data{
+		"target": r.Host,
+	})
+
+	if r.Method != "CONNECT" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	target, found := server.Targets[r.Host]
+	if !found {
+		logger.Info("unknown-target")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	atomic.AddInt64(&server.active, 1)
+	defer atomic.AddInt64(&server.active, -1)
+
+	localConn, err := net.Dial(target.Network, target.Addr)
+	if err != nil {
+		logger.Error("failed-to-dial", err)
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	defer localConn.Close()
+
+	w.WriteHeader(http.StatusOK)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		logger.Info("response-not-flushable")
+		return
+	}
+
+	flusher.Flush()
+
+	wg := new(sync.WaitGroup)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer localConn.Close()
+
+		io.Copy(localConn, r.Body)
+	}()
+
+	io.Copy(flushWriter{w, flusher}, localConn)
+
+	// ensure the copy from the stream is unblocked if the local end went away
+	r.Body.Close()
+
+	wg.Wait()
+}
+
+type flushWriter struct {
+	io.Writer
+
+	flusher http.Flusher
+}
+
+func (w flushWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	if err != nil {
+		return n, err
+	}
+
+	w.flusher.Flush()
+
+	return n, nil
+}
@@ -0,0 +1,44 @@
+// Package tunnel implements the connection used by workers that register
+// directly with the ATC rather than through the TSA.
+//
+// The worker dials the ATC and upgrades an HTTP/1.1 request, after which the
+// roles are reversed: the worker serves HTTP/2 over the connection and the ATC
+// acts as the client, opening a CONNECT stream for every connection it makes
+// to the worker's Garden or Baggageclaim server. This multiplexes all traffic
+// over one long-lived, worker-initiated connection.
+package tunnel
+
+import (
+	"bufio"
+	"net"
+)
+
+// Protocol is the value of the Upgrade header used to establish a tunnel.
+const Protocol = "concourse-worker-tunnel"
+
+// These headers are set on the upgrade response, informing the worker of the
+// addresses through which the ATCs will reach its Garden and Baggageclaim
+// servers.
+const (
+	GardenAddrHeader      = "X-Concourse-Garden-Addr"
+	BaggageclaimURLHeader = "X-Concourse-Baggageclaim-Url"
+)
+
+// These names are used as the authority of each CONNECT stream to specify
+// which component the stream should be forwarded to.
+const (
+	Garden       = "garden"
+	Baggageclaim = "baggageclaim"
+)
+
+// BufferedConn wraps a net.Conn whose first bytes may already have been
+// consumed into a buffer, e.g. while reading the upgrade request or response.
+type BufferedConn struct {
+	net.Conn
+
+	Reader *bufio.Reader
+}
+
+func (conn BufferedConn) Read(p []byte) (int, error) {
+	return conn.Reader.Read(p)
+}
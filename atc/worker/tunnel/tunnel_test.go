@@ -0,0 +1,153 @@
+package tunnel_test
+
+import (
+	"bufio"
+	"io"
+	"net"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/worker/tunnel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tunnel", func() {
+	var (
+		targetListener net.Listener
+
+		workerConn net.Conn
+		atcConn    net.Conn
+
+		server *tunnel.Server
+		client *tunnel.Client
+	)
+
+	BeforeEach(func() {
+		var err error
+		targetListener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		go func() {
+			for {
+				conn, err := targetListener.Accept()
+				if err != nil {
+					return
+				}
+
+				go func() {
+					defer conn.Close()
+
+					line, err := bufio.NewReader(conn).ReadString('\n')
+					if err != nil {
+						return
+					}
+
+					conn.Write([]byte("echo: " + line))
+				}()
+			}
+		}()
+
+		tunnelListener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		defer tunnelListener.Close()
+
+		accepted := make(chan net.Conn, 1)
+		go func() {
+			defer GinkgoRecover()
+
+			conn, err := tunnelListener.Accept()
+			Expect(err).ToNot(HaveOccurred())
+
+			accepted <- conn
+		}()
+
+		workerConn, err = net.Dial("tcp", tunnelListener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+
+		atcConn = <-accepted
+
+		server = &tunnel.Server{
+			Logger: lagertest.NewTestLogger("worker"),
+			Targets: map[string]tunnel.Target{
+				tunnel.Garden: {
+					Network: "tcp",
+					Addr:    targetListener.Addr().String(),
+				},
+			},
+		}
+
+		go server.Serve(workerConn)
+
+		client, err = tunnel.NewClient(lagertest.NewTestLogger("atc"), atcConn)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		client.Close()
+		workerConn.Close()
+		targetListener.Close()
+	})
+
+	Describe("Dial", func() {
+		It("opens a stream to the target on the worker", func() {
+			stream, err := client.Dial(tunnel.Garden)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer stream.Close()
+
+			Eventually(server.ActiveStreams).Should(Equal(1))
+
+			_, err = stream.Write([]byte("hello\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			line, err := bufio.NewReader(stream).ReadString('\n')
+			Expect(err).ToNot(HaveOccurred())
+			Expect(line).To(Equal("echo: hello\n"))
+
+			Eventually(server.ActiveStreams).Should(BeZero())
+		})
+
+		Context("when the target is unknown", func() {
+			It("returns an error", func() {
+				_, err := client.Dial(tunnel.Baggageclaim)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Forward", func() {
+		var listener net.Listener
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+
+			go client.Forward(listener, tunnel.Garden)
+		})
+
+		AfterEach(func() {
+			listener.Close()
+		})
+
+		It("forwards each accepted connection through the tunnel", func() {
+			for i := 0; i < 3; i++ {
+				conn, err := net.Dial("tcp", listener.Addr().String())
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = conn.Write([]byte("hello\n"))
+				Expect(err).ToNot(HaveOccurred())
+
+				line, err := bufio.NewReader(conn).ReadString('\n')
+				Expect(err).ToNot(HaveOccurred())
+				Expect(line).To(Equal("echo: hello\n"))
+
+				_, err = conn.Read(make([]byte, 1))
+				Expect(err).To(Equal(io.EOF))
+
+				conn.Close()
+			}
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 var ErrUnsupportedResourceType = errors.New("unsupported resource type")
 var ErrIncompatiblePlatform = errors.New("incompatible platform")
 var ErrMismatchedTags = errors.New("mismatched tags")
+var ErrMismatchedLabels = errors.New("mismatched labels")
 var ErrTeamMismatch = errors.New("mismatched team")
 var ErrNotImplemented = errors.New("Not implemented")
 
@@ -36,6 +38,7 @@ type Worker interface {
 	Name() string
 	ResourceTypes() []atc.WorkerResourceType
 	Tags() atc.Tags
+	Labels() map[string]string
 	Uptime() time.Duration
 	IsOwnedByTeam() bool
 	Ephemeral() bool
@@ -205,6 +208,10 @@ func (worker *gardenWorker) Tags() atc.Tags {
 	return worker.dbWorker.Tags()
 }
 
+func (worker *gardenWorker) Labels() map[string]string {
+	return worker.dbWorker.Labels()
+}
+
 func (worker *gardenWorker) Ephemeral() bool {
 	return worker.dbWorker.Ephemeral()
 }
@@ -249,6 +256,10 @@ func (worker *gardenWorker) Satisfying(logger lager.Logger, spec WorkerSpec) (Wo
 		return nil, ErrMismatchedTags
 	}
 
+	if !spec.WorkerSelector.Matches(worker.dbWorker.Labels()) {
+		return nil, ErrMismatchedLabels
+	}
+
 	return worker, nil
 }
 
@@ -276,6 +287,19 @@ func (worker *gardenWorker) Description() string {
 		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	labels := worker.dbWorker.Labels()
+
+	names := []string{}
+	for name := range labels {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("label '%s=%s'", name, labels[name]))
+	}
+
 	return strings.Join(messages, ", ")
 }
 
@@ -27,6 +27,7 @@ var _ = Describe("Worker", func() {
 		resourceTypes         []atc.WorkerResourceType
 		platform              string
 		tags                  atc.Tags
+		labels                map[string]string
 		teamID                int
 		ephemeral             bool
 		workerName            string
@@ -51,6 +52,7 @@ var _ = Describe("Worker", func() {
 		}
 		platform = "some-platform"
 		tags = atc.Tags{"some", "tags"}
+		labels = map[string]string{"arch": "arm64", "zone": "a"}
 		teamID = 17
 		ephemeral = true
 		workerName = "some-worker"
@@ -70,6 +72,7 @@ var _ = Describe("Worker", func() {
 		dbWorker.ResourceTypesReturns(resourceTypes)
 		dbWorker.PlatformReturns(platform)
 		dbWorker.TagsReturns(tags)
+		dbWorker.LabelsReturns(labels)
 		dbWorker.EphemeralReturns(ephemeral)
 		dbWorker.TeamIDReturns(teamID)
 		dbWorker.NameReturns(workerName)
@@ -320,6 +323,36 @@ var _ = Describe("Worker", func() {
 					Expect(satisfyingErr).To(Equal(ErrMismatchedTags))
 				})
 			})
+
+			Context("when the worker's labels match the worker selector", func() {
+				BeforeEach(func() {
+					spec.WorkerSelector = atc.WorkerSelector{
+						"arch":    "arm64",
+						"zone in": []interface{}{"a", "b"},
+					}
+				})
+
+				It("returns the worker", func() {
+					Expect(satisfyingWorker).To(Equal(gardenWorker))
+				})
+
+				It("returns no error", func() {
+					Expect(satisfyingErr).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when the worker's labels do not match the worker selector", func() {
+				BeforeEach(func() {
+					spec.WorkerSelector = atc.WorkerSelector{
+						"arch":    "arm64",
+						"zone in": []interface{}{"b", "c"},
+					}
+				})
+
+				It("returns ErrMismatchedLabels", func() {
+					Expect(satisfyingErr).To(Equal(ErrMismatchedLabels))
+				})
+			})
 		})
 
 		Context("when the platform is incompatible", func() {
@@ -0,0 +1,215 @@
+package atc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// WorkerSelector selects workers by their labels. Each key is the name of a
+// label, optionally followed by an operator:
+//
+//   arch: arm64              # the label is equal to the value
+//   arch !=: arm64           # the label is absent or not equal to the value
+//   zone in: [a, b]          # the label is one of the values
+//   zone notin: [a, b]       # the label is absent or not one of the values
+//   gpu exists: true         # the label is present (or absent, if false)
+//
+// All requirements must be met for a worker to be selected.
+type WorkerSelector map[string]interface{}
+
+type SelectorOperator string
+
+const (
+	SelectorEquals    SelectorOperator = "="
+	SelectorNotEquals SelectorOperator = "!="
+	SelectorIn        SelectorOperator = "in"
+	SelectorNotIn     SelectorOperator = "notin"
+	SelectorExists    SelectorOperator = "exists"
+)
+
+// LabelRequirement is a single requirement parsed from a WorkerSelector.
+type LabelRequirement struct {
+	Label    string
+	Operator SelectorOperator
+	Values   []string
+}
+
+// Requirements parses the selector, returning its requirements ordered by
+// label.
+func (selector WorkerSelector) Requirements() ([]LabelRequirement, error) {
+	requirements := []LabelRequirement{}
+
+	for key, value := range selector {
+		requirement, err := parseRequirement(key, value)
+		if err != nil {
+			return nil, err
+		}
+
+		requirements = append(requirements, requirement)
+	}
+
+	sort.Slice(requirements, func(i, j int) bool {
+		if requirements[i].Label == requirements[j].Label {
+			return requirements[i].Operator < requirements[j].Operator
+		}
+
+		return requirements[i].Label < requirements[j].Label
+	})
+
+	return requirements, nil
+}
+
+// Unmatched returns the requirements which are not met by the given labels.
+// An invalid selector matches nothing.
+func (selector WorkerSelector) Unmatched(labels map[string]string) ([]LabelRequirement, error) {
+	requirements, err := selector.Requirements()
+	if err != nil {
+		return nil, err
+	}
+
+	unmatched := []LabelRequirement{}
+	for _, requirement := range requirements {
+		if !requirement.Matches(labels) {
+			unmatched = append(unmatched, requirement)
+		}
+	}
+
+	return unmatched, nil
+}
+
+// Matches returns true if the given labels meet all requirements of the
+// selector. An invalid selector matches nothing.
+func (selector WorkerSelector) Matches(labels map[string]string) bool {
+	unmatched, err := selector.Unmatched(labels)
+	if err != nil {
+		return false
+	}
+
+	return len(unmatched) == 0
+}
+
+func (selector WorkerSelector) String() string {
+	requirements, err := selector.Requirements()
+	if err != nil {
+		return "<invalid>"
+	}
+
+	strs := []string{}
+	for _, requirement := range requirements {
+		strs = append(strs, requirement.String())
+	}
+
+	return strings.Join(strs, ", ")
+}
+
+func (requirement LabelRequirement) Matches(labels map[string]string) bool {
+	value, found := labels[requirement.Label]
+
+	switch requirement.Operator {
+	case SelectorEquals:
+		return found && value == requirement.Values[0]
+	case SelectorNotEquals:
+		return !found || value != requirement.Values[0]
+	case SelectorIn:
+		return found && containsString(requirement.Values, value)
+	case SelectorNotIn:
+		return !found || !containsString(requirement.Values, value)
+	case SelectorExists:
+		return found == (requirement.Values[0] == "true")
+	}
+
+	return false
+}
+
+func (requirement LabelRequirement) String() string {
+	switch requirement.Operator {
+	case SelectorIn, SelectorNotIn:
+		return fmt.Sprintf("%s %s (%s)", requirement.Label, requirement.Operator, strings.Join(requirement.Values, ", "))
+	case SelectorExists:
+		if requirement.Values[0] == "true" {
+			return requirement.Label + " exists"
+		}
+
+		return requirement.Label + " does not exist"
+	}
+
+	return fmt.Sprintf("%s%s%s", requirement.Label, requirement.Operator, requirement.Values[0])
+}
+
+func parseRequirement(key string, value interface{}) (LabelRequirement, error) {
+	fields := strings.Fields(key)
+
+	requirement := LabelRequirement{
+		Operator: SelectorEquals,
+	}
+
+	switch len(fields) {
+	case 1:
+	case 2:
+		requirement.Operator = SelectorOperator(fields[1])
+	default:
+		return LabelRequirement{}, fmt.Errorf("invalid selector key '%s'", key)
+	}
+
+	requirement.Label = fields[0]
+
+	switch requirement.Operator {
+	case SelectorEquals, SelectorNotEquals:
+		str, ok := selectorScalar(value)
+		if !ok {
+			return LabelRequirement{}, fmt.Errorf("selector '%s' must have a single value", key)
+		}
+
+		requirement.Values = []string{str}
+
+	case SelectorIn, SelectorNotIn:
+		list, ok := value.([]interface{})
+		if !ok || len(list) == 0 {
+			return LabelRequirement{}, fmt.Errorf("selector '%s' must have a list of values", key)
+		}
+
+		for _, v := range list {
+			str, ok := selectorScalar(v)
+			if !ok {
+				return LabelRequirement{}, fmt.Errorf("selector '%s' must have a list of values", key)
+			}
+
+			requirement.Values = append(requirement.Values, str)
+		}
+
+	case SelectorExists:
+		exists, ok := value.(bool)
+		if !ok {
+			return LabelRequirement{}, fmt.Errorf("selector '%s' must be true or false", key)
+		}
+
+		requirement.Values = []string{fmt.Sprintf("%t", exists)}
+
+	default:
+		return LabelRequirement{}, fmt.Errorf("unknown operator '%s' in selector '%s'", requirement.Operator, key)
+	}
+
+	return requirement, nil
+}
+
+func selectorScalar(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool, int, int64, float64:
+		return fmt.Sprintf("%v", v), true
+	default:
+		return "", false
+	}
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	yaml "gopkg.in/yaml.v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerSelector", func() {
+	var selector atc.WorkerSelector
+
+	parse := func(config string) atc.WorkerSelector {
+		var selector atc.WorkerSelector
+		err := yaml.Unmarshal([]byte(config), &selector)
+		Expect(err).NotTo(HaveOccurred())
+		return selector
+	}
+
+	Describe("Requirements", func() {
+		It("parses every operator, ordered by label", func() {
+			selector = parse(`
+zone in: [a, b]
+arch: arm64
+os !=: windows
+region notin: [eu]
+gpu exists: true
+cores: 8
+`)
+
+			requirements, err := selector.Requirements()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requirements).To(Equal([]atc.LabelRequirement{
+				{Label: "arch", Operator: atc.SelectorEquals, Values: []string{"arm64"}},
+				{Label: "cores", Operator: atc.SelectorEquals, Values: []string{"8"}},
+				{Label: "gpu", Operator: atc.SelectorExists, Values: []string{"true"}},
+				{Label: "os", Operator: atc.SelectorNotEquals, Values: []string{"windows"}},
+				{Label: "region", Operator: atc.SelectorNotIn, Values: []string{"eu"}},
+				{Label: "zone", Operator: atc.SelectorIn, Values: []string{"a", "b"}},
+			}))
+		})
+
+		It("rejects unknown operators", func() {
+			_, err := parse(`arch ~=: arm64`).Requirements()
+			Expect(err).To(MatchError("unknown operator '~=' in selector 'arch ~='"))
+		})
+
+		It("rejects keys with too many fields", func() {
+			_, err := parse(`arch in x: [arm64]`).Requirements()
+			Expect(err).To(MatchError("invalid selector key 'arch in x'"))
+		})
+
+		It("requires a list for set operators", func() {
+			_, err := parse(`zone in: a`).Requirements()
+			Expect(err).To(MatchError("selector 'zone in' must have a list of values"))
+
+			_, err = parse(`zone notin: []`).Requirements()
+			Expect(err).To(MatchError("selector 'zone notin' must have a list of values"))
+		})
+
+		It("requires a single value for equality operators", func() {
+			_, err := parse(`arch: [arm64]`).Requirements()
+			Expect(err).To(MatchError("selector 'arch' must have a single value"))
+		})
+
+		It("requires a boolean for exists", func() {
+			_, err := parse(`gpu exists: yes please`).Requirements()
+			Expect(err).To(MatchError("selector 'gpu exists' must be true or false"))
+		})
+	})
+
+	Describe("Matches", func() {
+		BeforeEach(func() {
+			selector = parse(`
+arch: arm64
+zone in: [a, b]
+gpu exists: false
+`)
+		})
+
+		It("matches labels meeting every requirement", func() {
+			Expect(selector.Matches(map[string]string{"arch": "arm64", "zone": "b"})).To(BeTrue())
+		})
+
+		It("does not match when any requirement is unmet", func() {
+			Expect(selector.Matches(map[string]string{"arch": "amd64", "zone": "b"})).To(BeFalse())
+			Expect(selector.Matches(map[string]string{"arch": "arm64", "zone": "c"})).To(BeFalse())
+			Expect(selector.Matches(map[string]string{"arch": "arm64", "zone": "a", "gpu": "nvidia"})).To(BeFalse())
+			Expect(selector.Matches(nil)).To(BeFalse())
+		})
+
+		It("treats absent labels as meeting negative requirements", func() {
+			Expect(parse(`os !=: windows`).Matches(nil)).To(BeTrue())
+			Expect(parse(`zone notin: [a]`).Matches(nil)).To(BeTrue())
+		})
+
+		It("matches everything when empty", func() {
+			Expect(atc.WorkerSelector{}.Matches(nil)).To(BeTrue())
+		})
+
+		It("matches nothing when invalid", func() {
+			Expect(parse(`arch ~=: arm64`).Matches(map[string]string{"arch": "arm64"})).To(BeFalse())
+		})
+	})
+
+	Describe("Unmatched", func() {
+		It("returns the unmet requirements", func() {
+			selector = parse(`
+arch: arm64
+zone in: [a, b]
+`)
+
+			unmatched, err := selector.Unmatched(map[string]string{"arch": "arm64", "zone": "c"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unmatched).To(Equal([]atc.LabelRequirement{
+				{Label: "zone", Operator: atc.SelectorIn, Values: []string{"a", "b"}},
+			}))
+		})
+	})
+
+	Describe("String", func() {
+		It("describes each requirement", func() {
+			selector = parse(`
+arch: arm64
+os !=: windows
+zone in: [a, b]
+gpu exists: false
+`)
+
+			Expect(selector.String()).To(Equal("arch=arm64, gpu does not exist, os!=windows, zone in (a, b)"))
+		})
+	})
+})
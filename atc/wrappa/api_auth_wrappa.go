@@ -62,13 +62,18 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// resource belongs to authorized team
 		case atc.AbortBuild,
 			atc.SendInputToBuildPlan,
-			atc.ReadOutputFromBuildPlan:
+			atc.ReadOutputFromBuildPlan,
+			atc.ReadArtifactFromBuildStep:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
 		case atc.PruneWorker,
 			atc.LandWorker,
 			atc.RetireWorker,
+			atc.CordonWorker,
+			atc.UncordonWorker,
+			atc.HeartbeatWorker,
+			atc.ListWorkerBuilds,
 			atc.ListDestroyingVolumes,
 			atc.ListDestroyingContainers,
 			atc.ReportWorkerContainers,
@@ -98,10 +103,12 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.CreateBuild,
 			atc.GetContainer,
 			atc.HijackContainer,
+			atc.StreamOutContainerFiles,
+			atc.StreamInContainerFiles,
 			atc.ListContainers,
 			atc.ListWorkers,
 			atc.RegisterWorker,
-			atc.HeartbeatWorker,
+			atc.ConnectWorker,
 			atc.DeleteWorker,
 			atc.SetTeam,
 			atc.ListTeamBuilds,
@@ -112,7 +119,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		case atc.GetLogLevel,
 			atc.SetLogLevel,
-			atc.GetInfoCreds:
+			atc.GetInfoCreds,
+			atc.GetEncryptionKeyRotation:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team)
@@ -162,16 +162,21 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
 
 				// resource belongs to authorized team
-				atc.AbortBuild:              checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
-				atc.SendInputToBuildPlan:    checkWritePermissionForBuild(inputHandlers[atc.SendInputToBuildPlan]),
-				atc.ReadOutputFromBuildPlan: checkWritePermissionForBuild(inputHandlers[atc.ReadOutputFromBuildPlan]),
+				atc.AbortBuild:                checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.SendInputToBuildPlan:      checkWritePermissionForBuild(inputHandlers[atc.SendInputToBuildPlan]),
+				atc.ReadOutputFromBuildPlan:   checkWritePermissionForBuild(inputHandlers[atc.ReadOutputFromBuildPlan]),
+				atc.ReadArtifactFromBuildStep: checkWritePermissionForBuild(inputHandlers[atc.ReadArtifactFromBuildStep]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
 				atc.LandWorker:               checkTeamAccessForWorker(inputHandlers[atc.LandWorker]),
+				atc.ListWorkerBuilds:         checkTeamAccessForWorker(inputHandlers[atc.ListWorkerBuilds]),
 				atc.ReportWorkerContainers:   checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerContainers]),
 				atc.ReportWorkerVolumes:      checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerVolumes]),
 				atc.RetireWorker:             checkTeamAccessForWorker(inputHandlers[atc.RetireWorker]),
+				atc.CordonWorker:             checkTeamAccessForWorker(inputHandlers[atc.CordonWorker]),
+				atc.UncordonWorker:           checkTeamAccessForWorker(inputHandlers[atc.UncordonWorker]),
+				atc.HeartbeatWorker:          checkTeamAccessForWorker(inputHandlers[atc.HeartbeatWorker]),
 				atc.ListDestroyingContainers: checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingContainers]),
 				atc.ListDestroyingVolumes:    checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingVolumes]),
 
@@ -194,24 +199,27 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetResourceVersion:            openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceVersion]),
 
 				// authenticated
-				atc.CreateBuild:     authenticated(inputHandlers[atc.CreateBuild]),
-				atc.GetContainer:    authenticated(inputHandlers[atc.GetContainer]),
-				atc.HijackContainer: authenticated(inputHandlers[atc.HijackContainer]),
-				atc.ListContainers:  authenticated(inputHandlers[atc.ListContainers]),
-				atc.ListVolumes:     authenticated(inputHandlers[atc.ListVolumes]),
-				atc.ListTeamBuilds:  authenticated(inputHandlers[atc.ListTeamBuilds]),
-				atc.ListWorkers:     authenticated(inputHandlers[atc.ListWorkers]),
-				atc.RegisterWorker:  authenticated(inputHandlers[atc.RegisterWorker]),
-				atc.HeartbeatWorker: authenticated(inputHandlers[atc.HeartbeatWorker]),
-				atc.DeleteWorker:    authenticated(inputHandlers[atc.DeleteWorker]),
-				atc.SetTeam:         authenticated(inputHandlers[atc.SetTeam]),
-				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
-				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
+				atc.CreateBuild:             authenticated(inputHandlers[atc.CreateBuild]),
+				atc.GetContainer:            authenticated(inputHandlers[atc.GetContainer]),
+				atc.HijackContainer:         authenticated(inputHandlers[atc.HijackContainer]),
+				atc.StreamOutContainerFiles: authenticated(inputHandlers[atc.StreamOutContainerFiles]),
+				atc.StreamInContainerFiles:  authenticated(inputHandlers[atc.StreamInContainerFiles]),
+				atc.ListContainers:          authenticated(inputHandlers[atc.ListContainers]),
+				atc.ListVolumes:             authenticated(inputHandlers[atc.ListVolumes]),
+				atc.ListTeamBuilds:          authenticated(inputHandlers[atc.ListTeamBuilds]),
+				atc.ListWorkers:             authenticated(inputHandlers[atc.ListWorkers]),
+				atc.RegisterWorker:          authenticated(inputHandlers[atc.RegisterWorker]),
+				atc.ConnectWorker:           authenticated(inputHandlers[atc.ConnectWorker]),
+				atc.DeleteWorker:            authenticated(inputHandlers[atc.DeleteWorker]),
+				atc.SetTeam:                 authenticated(inputHandlers[atc.SetTeam]),
+				atc.RenameTeam:              authenticated(inputHandlers[atc.RenameTeam]),
+				atc.DestroyTeam:             authenticated(inputHandlers[atc.DestroyTeam]),
 
 				// authenticated and is admin
-				atc.GetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
-				atc.SetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
-				atc.GetInfoCreds: authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
+				atc.GetLogLevel:              authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
+				atc.SetLogLevel:              authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
+				atc.GetInfoCreds:             authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
+				atc.GetEncryptionKeyRotation: authenticatedAndAdmin(inputHandlers[atc.GetEncryptionKeyRotation]),
 
 				// authorized (requested team matches resource team)
 				atc.CheckResource:          authorized(inputHandlers[atc.CheckResource]),
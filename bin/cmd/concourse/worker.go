@@ -26,6 +26,8 @@ type WorkerCommand struct {
 
 	TSA worker.TSAConfig `group:"TSA Configuration" namespace:"tsa"`
 
+	ATC worker.ATCConfig `group:"ATC Configuration (direct registration)" namespace:"atc"`
+
 	Certs Certs
 
 	WorkDir flag.Dir `long:"work-dir" required:"true" description:"Directory in which to place container data."`
@@ -43,6 +45,10 @@ type WorkerCommand struct {
 
 	DrainTimeout time.Duration `long:"drain-timeout" default:"1h" description:"Duration after which a worker should give up draining forwarded connections on shutdown."`
 
+	DrainWaitForBuilds bool `long:"drain-wait-for-builds" description:"On shutdown, land the worker and wait for its running builds to finish before exiting."`
+
+	Health worker.HealthConfig `group:"Health Check Configuration" namespace:"health"`
+
 	Garden GardenBackend `group:"Garden Configuration" namespace:"garden"`
 
 	Baggageclaim baggageclaimcmd.BaggageclaimCommand `group:"Baggageclaim Configuration" namespace:"baggageclaim"`
@@ -107,8 +113,52 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		},
 	}
 
+	var tsaClient worker.TSAClient
 	if cmd.TSA.WorkerPrivateKey != nil {
-		tsaClient := cmd.TSA.Client(atcWorker)
+		tsaClient = cmd.TSA.Client(atcWorker)
+	} else if len(cmd.ATC.URLs) > 0 && cmd.ATC.Token != "" {
+		tsaClient = cmd.ATC.Client(atcWorker)
+	}
+
+	if tsaClient != nil {
+		gardenClient := gclient.New(
+			gconn.NewWithLogger(
+				"tcp",
+				cmd.gardenAddr(),
+				logger.Session("garden-connection"),
+			),
+		)
+
+		baggageclaimClient := bclient.NewWithHTTPClient(
+			cmd.baggageclaimURL(),
+
+			// ensure we don't use baggageclaim's default retryhttp client; all
+			// traffic should be local, so any failures are unlikely to be transient.
+			// we don't want a retry loop to block up sweeping and prevent the worker
+			// from existing.
+			&http.Client{
+				Transport: &http.Transport{
+					// don't let a slow (possibly stuck) baggageclaim server slow down
+					// sweeping too much
+					ResponseHeaderTimeout: 1 * time.Minute,
+				},
+			},
+		)
+
+		healthMonitor := cmd.Health.Monitor(
+			logger.Session("health-monitor"),
+			cmd.WorkDir.Path(),
+			gardenClient,
+			baggageclaimClient,
+		)
+
+		members = append(members, grouper.Member{
+			Name: "health-monitor",
+			Runner: NewLoggingRunner(
+				logger.Session("health-monitor-runner"),
+				healthMonitor,
+			),
+		})
 
 		beacon := &worker.Beacon{
 			Logger: logger.Session("beacon"),
@@ -123,6 +173,9 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 
 			LocalBaggageclaimNetwork: "tcp",
 			LocalBaggageclaimAddr:    cmd.baggageclaimAddr(),
+
+			Health:   healthMonitor,
+			AutoLand: cmd.Health.AutoLand,
 		}
 
 		members = append(members, grouper.Member{
@@ -133,34 +186,11 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 					logger.Session("beacon-runner"),
 					beacon,
 					tsaClient,
+					cmd.DrainWaitForBuilds,
 				),
 			),
 		})
 
-		gardenClient := gclient.New(
-			gconn.NewWithLogger(
-				"tcp",
-				cmd.gardenAddr(),
-				logger.Session("garden-connection"),
-			),
-		)
-
-		baggageclaimClient := bclient.NewWithHTTPClient(
-			cmd.baggageclaimURL(),
-
-			// ensure we don't use baggageclaim's default retryhttp client; all
-			// traffic should be local, so any failures are unlikely to be transient.
-			// we don't want a retry loop to block up sweeping and prevent the worker
-			// from existing.
-			&http.Client{
-				Transport: &http.Transport{
-					// don't let a slow (possibly stuck) baggageclaim server slow down
-					// sweeping too much
-					ResponseHeaderTimeout: 1 * time.Minute,
-				},
-			},
-		)
-
 		members = append(members, grouper.Member{
 			Name: "sweeper",
 			Runner: NewLoggingRunner(
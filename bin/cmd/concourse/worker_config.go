@@ -1,15 +1,18 @@
 package main
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
 )
 
 type WorkerConfig struct {
-	Name     string   `long:"name"  description:"The name to set for the worker during registration. If not specified, the hostname will be used."`
-	Tags     []string `long:"tag"   description:"A tag to set during registration. Can be specified multiple times."`
-	TeamName string   `long:"team"  description:"The name of the team that this worker will be assigned to."`
+	Name     string       `long:"name"  description:"The name to set for the worker during registration. If not specified, the hostname will be used."`
+	Tags     []string     `long:"tag"   description:"A tag to set during registration. Can be specified multiple times."`
+	Labels   WorkerLabels `long:"label" description:"A label to set during registration, matched against worker_selector. Can be specified multiple times." value-name:"NAME=VALUE"`
+	TeamName string       `long:"team"  description:"The name of the team that this worker will be assigned to."`
 
 	HTTPProxy  string `long:"http-proxy"  env:"http_proxy"                  description:"HTTP proxy endpoint to use for containers."`
 	HTTPSProxy string `long:"https-proxy" env:"https_proxy"                 description:"HTTPS proxy endpoint to use for containers."`
@@ -25,6 +28,7 @@ type WorkerConfig struct {
 func (c WorkerConfig) Worker() atc.Worker {
 	return atc.Worker{
 		Tags:          c.Tags,
+		Labels:        c.Labels,
 		Team:          c.TeamName,
 		Name:          c.Name,
 		StartTime:     time.Now().Unix(),
@@ -35,3 +39,21 @@ func (c WorkerConfig) Worker() atc.Worker {
 		Ephemeral:     c.Ephemeral,
 	}
 }
+
+// WorkerLabels are the key/value labels of a worker, given as NAME=VALUE.
+type WorkerLabels map[string]string
+
+func (labels *WorkerLabels) UnmarshalFlag(value string) error {
+	segs := strings.SplitN(value, "=", 2)
+	if len(segs) != 2 || segs[0] == "" {
+		return fmt.Errorf("invalid label '%s' (must be NAME=VALUE)", value)
+	}
+
+	if *labels == nil {
+		*labels = WorkerLabels{}
+	}
+
+	(*labels)[segs[0]] = segs[1]
+
+	return nil
+}
@@ -10,8 +10,8 @@ import (
 )
 
 type AbortBuildCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to cancel"`
-	Build string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to cancel. If job not specified: build id"`
+	Job   flaghelpers.JobFlag   `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to cancel"`
+	Build flaghelpers.BuildFlag `short:"b" long:"build" required:"true" description:"If job is specified: build number to cancel. If job not specified: build id"`
 }
 
 func (command *AbortBuildCommand) Execute([]string) error {
@@ -28,9 +28,9 @@ func (command *AbortBuildCommand) Execute([]string) error {
 	var build atc.Build
 	var exists bool
 	if command.Job.PipelineName == "" && command.Job.JobName == "" {
-		build, exists, err = target.Client().Build(command.Build)
+		build, exists, err = target.Client().Build(string(command.Build))
 	} else {
-		build, exists, err = target.Team().JobBuild(command.Job.PipelineName, command.Job.JobName, command.Build)
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineName, command.Job.JobName, string(command.Build))
 	}
 	if err != nil {
 		return err
@@ -2,15 +2,25 @@ package commands
 
 import (
 	"fmt"
+	"os"
 	"sort"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
 )
 
 type ChecklistCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"The pipeline from which to generate the Checkfile"`
+
+	ui.OutputFlags
+}
+
+type checklistEntry struct {
+	Group   string `json:"group"`
+	Job     string `json:"job"`
+	Command string `json:"command"`
 }
 
 func (command *ChecklistCommand) Validate() error {
@@ -40,33 +50,60 @@ func (command *ChecklistCommand) Execute([]string) error {
 		return err
 	}
 
-	printCheckfile(target.Team().Name(), pipelineName, config, target.Client().URL())
+	entries := checklistEntries(target.Team().Name(), pipelineName, config, target.Client().URL())
+
+	if command.Structured() {
+		return command.Present(os.Stdout, entries)
+	}
+
+	printCheckfile(entries)
 
 	return nil
 }
 
-func printCheckfile(teamName, pipelineName string, config atc.Config, url string) {
+func checklistEntries(teamName, pipelineName string, config atc.Config, url string) []checklistEntry {
 	orphanHeaderName := "misc"
 	if len(config.Groups) == 0 {
 		orphanHeaderName = pipelineName
 	}
 
-	for _, group := range config.Groups {
-		printGroup(teamName, pipelineName, group, url)
-	}
+	groups := config.Groups
 
 	miscJobs := orphanedJobs(config)
 	if len(miscJobs) > 0 {
-		printGroup(teamName, pipelineName, atc.GroupConfig{Name: orphanHeaderName, Jobs: miscJobs}, url)
+		groups = append(groups, atc.GroupConfig{Name: orphanHeaderName, Jobs: miscJobs})
+	}
+
+	entries := []checklistEntry{}
+	for _, group := range groups {
+		for _, job := range group.Jobs {
+			entries = append(entries, checklistEntry{
+				Group:   group.Name,
+				Job:     job,
+				Command: fmt.Sprintf("concourse.check %s %s %s %s", url, teamName, pipelineName, job),
+			})
+		}
 	}
+
+	return entries
 }
 
-func printGroup(teamName, pipelineName string, group atc.GroupConfig, url string) {
-	fmt.Printf("#- %s\n", group.Name)
-	for _, job := range group.Jobs {
-		fmt.Printf("%s: concourse.check %s %s %s %s\n", job, url, teamName, pipelineName, job)
+func printCheckfile(entries []checklistEntry) {
+	for i, entry := range entries {
+		if i == 0 || entries[i-1].Group != entry.Group {
+			if i != 0 {
+				fmt.Println("")
+			}
+
+			fmt.Printf("#- %s\n", entry.Group)
+		}
+
+		fmt.Printf("%s: %s\n", entry.Job, entry.Command)
+	}
+
+	if len(entries) > 0 {
+		fmt.Println("")
 	}
-	fmt.Println("")
 }
 
 func orphanedJobs(config atc.Config) []string {
@@ -9,10 +9,10 @@ import (
 )
 
 type ClearTaskCacheCommand struct {
-	Job             flaghelpers.JobFlag `short:"j" long:"job"  required:"true"  description:"Job to clear cache from"`
-	StepName        string              `short:"s" long:"step"  required:"true" description:"Step name to clear cache from"`
-	CachePath       string              `short:"c" long:"cache-path"  default:"" description:"Cache directory to clear out"`
-	SkipInteractive bool                `short:"n"  long:"non-interactive"          description:"Destroy the task cache(s) without confirmation"`
+	Job             flaghelpers.JobFlag  `short:"j" long:"job"  required:"true"  description:"Job to clear cache from"`
+	StepName        flaghelpers.StepFlag `short:"s" long:"step"  required:"true" description:"Step name to clear cache from"`
+	CachePath       string               `short:"c" long:"cache-path"  default:"" description:"Cache directory to clear out"`
+	SkipInteractive bool                 `short:"n"  long:"non-interactive"          description:"Destroy the task cache(s) without confirmation"`
 }
 
 func (command *ClearTaskCacheCommand) Execute([]string) error {
@@ -43,7 +43,7 @@ func (command *ClearTaskCacheCommand) Execute([]string) error {
 		}
 	}
 
-	numRemoved, err := target.Team().ClearTaskCache(command.Job.PipelineName, command.Job.JobName, command.StepName, command.CachePath)
+	numRemoved, err := target.Team().ClearTaskCache(command.Job.PipelineName, command.Job.JobName, string(command.StepName), command.CachePath)
 
 	if err != nil {
 		fmt.Println(err.Error())
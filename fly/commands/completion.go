@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"fmt"
+)
+
+// go-flags prints completions for the command line it is given whenever
+// GO_FLAGS_COMPLETION is set, so each script just re-invokes fly that way.
+
+const bashCompletion = `_fly_compl() {
+	local args=("${COMP_WORDS[@]:1:$COMP_CWORD}")
+	local IFS=$'\n'
+	COMPREPLY=($(GO_FLAGS_COMPLETION=1 "${COMP_WORDS[0]}" "${args[@]}"))
+	if [[ ${#COMPREPLY[@]} -eq 1 && ${COMPREPLY[0]} == */ ]]; then
+		compopt -o nospace
+	fi
+	return 0
+}
+complete -o default -F _fly_compl fly
+`
+
+const zshCompletion = `#compdef fly
+_fly() {
+	local -a completions
+	completions=("${(@f)$(GO_FLAGS_COMPLETION=1 "${words[1]}" "${(@)words[2,CURRENT]}" 2>/dev/null)}")
+	compadd -Q -- "${completions[@]}"
+}
+compdef _fly fly
+`
+
+const fishCompletion = `function __fly_complete
+	set -l args (commandline -opc)
+	set -e args[1]
+	env GO_FLAGS_COMPLETION=1 fly $args (commandline -ct)
+end
+complete -c fly -f -a '(__fly_complete)'
+`
+
+type CompletionCommand struct {
+	Shell string `long:"shell" required:"true" choice:"bash" choice:"zsh" choice:"fish" description:"Shell to generate the completion script for"`
+}
+
+func (command *CompletionCommand) Execute([]string) error {
+	switch command.Shell {
+	case "bash":
+		fmt.Print(bashCompletion)
+	case "zsh":
+		fmt.Print(zshCompletion)
+	case "fish":
+		fmt.Print(fishCompletion)
+	}
+
+	return nil
+}
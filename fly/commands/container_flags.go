@@ -0,0 +1,320 @@
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/hijackhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/vito/go-interact/interact"
+)
+
+// ContainerFlags are shared by the commands that reach into a build step or
+// check container, i.e. hijack, cp and port-forward.
+type ContainerFlags struct {
+	Job      flaghelpers.JobFlag      `short:"j" long:"job"   value-name:"PIPELINE/JOB"   description:"Name of a job whose container to use"`
+	Check    flaghelpers.ResourceFlag `short:"c" long:"check" value-name:"PIPELINE/CHECK" description:"Name of a resource whose checking container to use"`
+	Url      string                   `short:"u" long:"url"                               description:"URL for the build, job, or check container to use"`
+	Build    flaghelpers.BuildFlag    `short:"b" long:"build"                             description:"Build number within the job, or global build ID"`
+	StepName flaghelpers.StepFlag     `short:"s" long:"step"                              description:"Name of the step whose container to use (e.g. build, unit, resource name)"`
+	StepType string                   `          long:"step-type"                         description:"Type of the step whose container to use (e.g. get, put, task)"`
+	Attempt  string                   `short:"a" long:"attempt" value-name:"N[,N,...]"    description:"Attempt number of the step whose container to use"`
+}
+
+// LoadTarget loads the target named by --target, or failing that the one
+// the --url belongs to.
+func (command *ContainerFlags) LoadTarget() (rc.Target, error) {
+	var (
+		target rc.Target
+		name   rc.TargetName
+		err    error
+	)
+	if Fly.Target == "" && command.Url != "" {
+		u, err := url.Parse(command.Url)
+		if err != nil {
+			return nil, err
+		}
+		urlMap := parseUrlPath(u.Path)
+		target, name, err = rc.LoadTargetFromURL(fmt.Sprintf("%s://%s", u.Scheme, u.Host), urlMap["teams"], Fly.Verbose)
+		if err != nil {
+			return nil, err
+		}
+		Fly.Target = name
+	} else {
+		target, err = rc.LoadTarget(Fly.Target, Fly.Verbose)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// SelectContainer finds the containers matching the flags, asking the user
+// to pick one if there is more than one. It returns io.EOF if the user gave
+// up choosing.
+func (command *ContainerFlags) SelectContainer(target rc.Target) (atc.Container, error) {
+	fingerprint, err := command.getContainerFingerprint(target)
+	if err != nil {
+		return atc.Container{}, err
+	}
+
+	containers, err := command.getContainerIDs(target, fingerprint)
+	if err != nil {
+		return atc.Container{}, err
+	}
+
+	hijackableContainers := make([]atc.Container, 0)
+
+	for _, container := range containers {
+		if container.State == atc.ContainerStateCreated || container.State == atc.ContainerStateFailed {
+			hijackableContainers = append(hijackableContainers, container)
+		}
+	}
+
+	var chosenContainer atc.Container
+	if len(hijackableContainers) == 0 {
+		displayhelpers.Failf("no containers matched your search parameters!\n\nthey may have expired if your build hasn't recently finished.")
+	} else if len(hijackableContainers) > 1 {
+		var choices []interact.Choice
+		for _, container := range hijackableContainers {
+			var infos []string
+
+			if container.BuildID != 0 {
+				if container.JobName != "" {
+					infos = append(infos, fmt.Sprintf("build #%s", container.BuildName))
+				} else {
+					infos = append(infos, fmt.Sprintf("build id: %d", container.BuildID))
+				}
+			}
+
+			if container.StepName != "" {
+				infos = append(infos, fmt.Sprintf("step: %s", container.StepName))
+			}
+
+			if container.ResourceName != "" {
+				infos = append(infos, fmt.Sprintf("resource: %s", container.ResourceName))
+			}
+
+			infos = append(infos, fmt.Sprintf("type: %s", container.Type))
+
+			if container.Type == "check" {
+				infos = append(infos, fmt.Sprintf("expires in: %s", container.ExpiresIn))
+			}
+
+			if container.Attempt != "" {
+				infos = append(infos, fmt.Sprintf("attempt: %s", container.Attempt))
+			}
+
+			choices = append(choices, interact.Choice{
+				Display: strings.Join(infos, ", "),
+				Value:   container,
+			})
+		}
+
+		err = interact.NewInteraction("choose a container", choices...).Resolve(&chosenContainer)
+		if err != nil {
+			return atc.Container{}, err
+		}
+	} else {
+		chosenContainer = hijackableContainers[0]
+	}
+
+	return chosenContainer, nil
+}
+
+func parseUrlPath(urlPath string) map[string]string {
+	pathWithoutFirstSlash := strings.Replace(urlPath, "/", "", 1)
+	urlComponents := strings.Split(pathWithoutFirstSlash, "/")
+	urlMap := make(map[string]string)
+
+	for i := 0; i < len(urlComponents)/2; i++ {
+		keyIndex := i * 2
+		valueIndex := keyIndex + 1
+		urlMap[urlComponents[keyIndex]] = urlComponents[valueIndex]
+	}
+
+	return urlMap
+}
+
+func (command *ContainerFlags) getContainerFingerprintFromUrl(target rc.Target, urlParam string) (*containerFingerprint, error) {
+	u, err := url.Parse(urlParam)
+	if err != nil {
+		return nil, err
+	}
+
+	urlMap := parseUrlPath(u.Path)
+
+	parsedTargetUrl := url.URL{
+		Scheme: u.Scheme,
+		Host:   u.Host,
+	}
+
+	host := parsedTargetUrl.String()
+	if host != target.URL() {
+		err = fmt.Errorf("URL doesn't match that of target")
+		return nil, err
+	}
+
+	team := urlMap["teams"]
+	if team != target.Team().Name() {
+		err = fmt.Errorf("Team in URL doesn't match the current team of the target")
+		return nil, err
+	}
+
+	fingerprint := &containerFingerprint{
+		pipelineName:  urlMap["pipelines"],
+		jobName:       urlMap["jobs"],
+		buildNameOrID: urlMap["builds"],
+		checkName:     urlMap["resources"],
+	}
+
+	return fingerprint, nil
+}
+
+func (command *ContainerFlags) getContainerFingerprint(target rc.Target) (*containerFingerprint, error) {
+	var err error
+	fingerprint := &containerFingerprint{}
+
+	if command.Url != "" {
+		fingerprint, err = command.getContainerFingerprintFromUrl(target, command.Url)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pipelineName := command.Check.PipelineName
+	if command.Job.PipelineName != "" {
+		pipelineName = command.Job.PipelineName
+	}
+
+	for _, field := range []struct {
+		fp  *string
+		cmd string
+	}{
+		{fp: &fingerprint.pipelineName, cmd: pipelineName},
+		{fp: &fingerprint.buildNameOrID, cmd: string(command.Build)},
+		{fp: &fingerprint.stepName, cmd: string(command.StepName)},
+		{fp: &fingerprint.stepType, cmd: command.StepType},
+		{fp: &fingerprint.jobName, cmd: command.Job.JobName},
+		{fp: &fingerprint.checkName, cmd: command.Check.ResourceName},
+		{fp: &fingerprint.attempt, cmd: command.Attempt},
+	} {
+		if field.cmd != "" {
+			*field.fp = field.cmd
+		}
+	}
+
+	return fingerprint, nil
+}
+
+func (command *ContainerFlags) getContainerIDs(target rc.Target, fingerprint *containerFingerprint) ([]atc.Container, error) {
+	reqValues, err := locateContainer(target.Client(), fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := target.Team().ListContainers(reqValues)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(hijackhelpers.ContainerSorter(containers))
+
+	return containers, nil
+}
+
+type containerLocator interface {
+	locate(*containerFingerprint) (map[string]string, error)
+}
+
+type stepContainerLocator struct {
+	client concourse.Client
+}
+
+func (locator stepContainerLocator) locate(fingerprint *containerFingerprint) (map[string]string, error) {
+	reqValues := map[string]string{}
+
+	if fingerprint.stepType != "" {
+		reqValues["type"] = fingerprint.stepType
+	}
+
+	if fingerprint.stepName != "" {
+		reqValues["step_name"] = fingerprint.stepName
+	}
+
+	if fingerprint.attempt != "" {
+		reqValues["attempt"] = fingerprint.attempt
+	}
+
+	if fingerprint.jobName != "" {
+		reqValues["pipeline_name"] = fingerprint.pipelineName
+		reqValues["job_name"] = fingerprint.jobName
+		if fingerprint.buildNameOrID != "" {
+			reqValues["build_name"] = fingerprint.buildNameOrID
+		}
+	} else if fingerprint.buildNameOrID != "" {
+		reqValues["build_id"] = fingerprint.buildNameOrID
+	} else {
+		build, err := GetBuild(locator.client, nil, "", "", "")
+		if err != nil {
+			return reqValues, err
+		}
+		reqValues["build_id"] = strconv.Itoa(build.ID)
+	}
+
+	return reqValues, nil
+}
+
+type checkContainerLocator struct{}
+
+func (locator checkContainerLocator) locate(fingerprint *containerFingerprint) (map[string]string, error) {
+	reqValues := map[string]string{}
+
+	reqValues["type"] = "check"
+	if fingerprint.checkName != "" {
+		reqValues["resource_name"] = fingerprint.checkName
+	}
+	if fingerprint.pipelineName != "" {
+		reqValues["pipeline_name"] = fingerprint.pipelineName
+	}
+
+	return reqValues, nil
+}
+
+type containerFingerprint struct {
+	pipelineName  string
+	jobName       string
+	buildNameOrID string
+
+	stepName string
+	stepType string
+
+	checkName string
+	attempt   string
+}
+
+func locateContainer(client concourse.Client, fingerprint *containerFingerprint) (map[string]string, error) {
+	var locator containerLocator
+
+	if fingerprint.checkName == "" {
+		locator = stepContainerLocator{
+			client: client,
+		}
+	} else {
+		locator = checkContainerLocator{}
+	}
+
+	return locator.locate(fingerprint)
+}
@@ -5,14 +5,13 @@ import (
 	"sort"
 	"strconv"
 
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type ContainersCommand struct {
-	Json bool `long:"json" description:"Print command result as JSON"`
+	ui.OutputFlags
 }
 
 func (command *ContainersCommand) Execute([]string) error {
@@ -31,12 +30,8 @@ func (command *ContainersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(containers)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Structured() {
+		return command.Present(os.Stdout, containers)
 	}
 
 	table := ui.Table{
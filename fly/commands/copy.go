@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/go-archive/tarfs"
+)
+
+type CopyCommand struct {
+	ContainerFlags
+
+	PositionalArgs struct {
+		Source      string `positional-arg-name:"SOURCE"      required:"true" description:"File or directory to copy; prefix with ':' for a path in the container"`
+		Destination string `positional-arg-name:"DESTINATION" required:"true" description:"Directory to copy into; prefix with ':' for a path in the container"`
+	} `positional-args:"yes"`
+}
+
+func (command *CopyCommand) Execute([]string) error {
+	src, srcInContainer := containerPath(command.PositionalArgs.Source)
+	dst, dstInContainer := containerPath(command.PositionalArgs.Destination)
+
+	if srcInContainer == dstInContainer {
+		return errors.New("exactly one of SOURCE and DESTINATION must be a container path, prefixed with ':'")
+	}
+
+	target, err := command.LoadTarget()
+	if err != nil {
+		return err
+	}
+
+	container, err := command.SelectContainer(target)
+	if err == io.EOF {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if srcInContainer {
+		return command.copyOut(target, container, resolveContainerPath(container, src), dst)
+	}
+
+	return command.copyIn(target, container, src, resolveContainerPath(container, dst))
+}
+
+func (command *CopyCommand) copyOut(target rc.Target, container atc.Container, src string, dst string) error {
+	out, found, err := target.Team().StreamOutContainerFiles(container.ID, src, container.User)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("container '%s' no longer exists", container.ID)
+	}
+
+	defer out.Close()
+
+	err = os.MkdirAll(dst, 0755)
+	if err != nil {
+		return err
+	}
+
+	err = tarfs.Extract(out, dst)
+	if err != nil {
+		return fmt.Errorf("failed to extract files: %s", err)
+	}
+
+	fmt.Printf("copied :%s to %s\n", src, dst)
+
+	return nil
+}
+
+func (command *CopyCommand) copyIn(target rc.Target, container atc.Container, src string, dst string) error {
+	_, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	archive, archiveWriter := io.Pipe()
+
+	go func() {
+		archiveWriter.CloseWithError(tarfs.Compress(archiveWriter, filepath.Dir(src), filepath.Base(src)))
+	}()
+
+	found, err := target.Team().StreamInContainerFiles(container.ID, dst, container.User, archive)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("container '%s' no longer exists", container.ID)
+	}
+
+	fmt.Printf("copied %s to :%s\n", src, dst)
+
+	return nil
+}
+
+func containerPath(arg string) (string, bool) {
+	if strings.HasPrefix(arg, ":") {
+		return strings.TrimPrefix(arg, ":"), true
+	}
+
+	return arg, false
+}
+
+// relative container paths are taken to be relative to the step's working
+// directory, which is where hijack would drop you
+func resolveContainerPath(container atc.Container, p string) string {
+	if path.IsAbs(p) || container.WorkingDirectory == "" {
+		return p
+	}
+
+	return path.Join(container.WorkingDirectory, p)
+}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type CordonWorkerCommand struct {
+	Worker flaghelpers.WorkerFlag `short:"w" long:"worker" required:"true" description:"Worker to cordon"`
+	Reason string                 `short:"r" long:"reason" description:"Reason for cordoning the worker, shown in 'fly workers'"`
+}
+
+func (command *CordonWorkerCommand) Execute(args []string) error {
+	workerName := string(command.Worker)
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = target.Client().CordonWorker(workerName, command.Reason)
+	if err == concourse.ErrWorkerNotRunning {
+		return fmt.Errorf("worker '%s' is not running and cannot be cordoned", workerName)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("cordoned '%s'\n", workerName)
+
+	return nil
+}
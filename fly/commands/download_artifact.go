@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/go-archive/tgzfs"
+)
+
+type DownloadArtifactCommand struct {
+	Job       flaghelpers.JobFlag   `short:"j" long:"job"        required:"true" value-name:"PIPELINE/JOB" description:"Job whose build produced the artifact"`
+	Build     flaghelpers.BuildFlag `short:"b" long:"build"                                                description:"Build of the job to download from (default: the latest finished build)"`
+	StepName  flaghelpers.StepFlag  `short:"s" long:"step"       required:"true"                           description:"Step that produced the artifact"`
+	Artifact  string                `short:"a" long:"artifact"                                             description:"Path of the task output to download, which is its name unless the output sets a path (default: the step name)"`
+	OutputDir string                `short:"o" long:"output-dir" required:"true"                           description:"Directory to extract the artifact into"`
+}
+
+func (command *DownloadArtifactCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	client := target.Client()
+
+	build, err := command.build(client, target.Team())
+	if err != nil {
+		return err
+	}
+
+	artifact := command.Artifact
+	if artifact == "" {
+		artifact = string(command.StepName)
+	}
+
+	out, found, err := client.ReadArtifactFromBuildStep(build.ID, string(command.StepName), artifact)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("artifact '%s' of step '%s' no longer exists on any worker", artifact, command.StepName)
+	}
+
+	defer out.Close()
+
+	err = tgzfs.Extract(out, command.OutputDir)
+	if err != nil {
+		return fmt.Errorf("failed to extract artifact: %s", err)
+	}
+
+	fmt.Printf("downloaded '%s' from %s/%s #%s to %s\n", artifact, command.Job.PipelineName, command.Job.JobName, build.Name, command.OutputDir)
+
+	return nil
+}
+
+func (command *DownloadArtifactCommand) build(client concourse.Client, team concourse.Team) (atc.Build, error) {
+	if command.Build != "" {
+		return GetBuild(client, team, command.Job.JobName, string(command.Build), command.Job.PipelineName)
+	}
+
+	job, found, err := team.Job(command.Job.PipelineName, command.Job.JobName)
+	if err != nil {
+		return atc.Build{}, err
+	}
+
+	if !found {
+		return atc.Build{}, errors.New("job not found")
+	}
+
+	if job.FinishedBuild == nil {
+		return atc.Build{}, errors.New("job has no finished builds")
+	}
+
+	return *job.FinishedBuild, nil
+}
@@ -1,11 +1,13 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -18,15 +20,19 @@ import (
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
+	"gopkg.in/yaml.v2"
 )
 
 type ExecuteCommand struct {
-	TaskConfig     atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute"`
+	TaskConfig     atc.PathFlag                       `short:"c" long:"config"                                description:"The task config to execute"`
+	Job            flaghelpers.JobFlag                `          long:"job"         value-name:"PIPELINE/JOB" description:"A job whose steps to execute instead of a task config, with the versions of its next build. Its get steps can be overridden with --input"`
+	SkipPuts       bool                               `          long:"skip-puts"                             description:"Skip the put steps of the job being executed"`
+	Steps          atc.PathFlag                       `          long:"steps"                                 description:"A file of task steps to execute in order instead of a task config, sharing artifacts by name as in a job's plan"`
 	Privileged     bool                               `short:"p" long:"privileged"                            description:"Run the task with full privileges"`
 	IncludeIgnored bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
 	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
 	InputMappings  []flaghelpers.VariablePairFlag     `short:"m" long:"input-mapping"       value-name:"[NAME=STRING]"    description:"Map a resource to a different name as task input"`
-	InputsFrom     flaghelpers.JobFlag                `short:"j" long:"inputs-from" value-name:"PIPELINE/JOB" description:"A job to base the inputs on"`
+	InputsFrom     flaghelpers.JobFlag                `short:"j" long:"inputs-from" value-name:"PIPELINE/JOB" description:"A job to base the inputs on. Without --config, executes the job as with --job"`
 	Outputs        []flaghelpers.OutputPairFlag       `short:"o" long:"output"      value-name:"NAME=PATH"    description:"An output to fetch from the task (can be specified multiple times)"`
 	Image          string                             `long:"image" description:"Image resource for the one-off build"`
 	Tags           []string                           `          long:"tag"         value-name:"TAG"          description:"A tag for a specific environment (can be specified multiple times)"`
@@ -48,61 +54,76 @@ func (command *ExecuteCommand) Execute(args []string) error {
 
 	includeIgnored := command.IncludeIgnored
 
-	taskTemplate := templatehelpers.NewYamlTemplateWithParams(command.TaskConfig, command.VarsFrom, command.Var, command.YAMLVar)
-	taskTemplateEvaluated, err := taskTemplate.Evaluate(false, false)
-	if err != nil {
-		return err
-	}
-
-	taskConfig, err := config.OverrideTaskParams(taskTemplateEvaluated, args)
-	if err != nil {
-		return err
+	jobFlag := "--job"
+	if command.TaskConfig == "" && command.Job.JobName == "" && command.InputsFrom.JobName != "" {
+		command.Job = command.InputsFrom
+		command.InputsFrom = flaghelpers.JobFlag{}
+		jobFlag = "--inputs-from"
 	}
 
 	client := target.Client()
 
 	fact := atc.NewPlanFactory(time.Now().Unix())
 
-	inputMappings := executehelpers.DetermineInputMappings(command.InputMappings)
-	inputs, imageResource, err := executehelpers.DetermineInputs(
-		fact,
-		target.Team(),
-		taskConfig.Inputs,
-		command.Inputs,
-		inputMappings,
-		command.Image,
-		command.InputsFrom,
-	)
-	if err != nil {
-		return err
+	var plan atc.Plan
+	var inputs []executehelpers.Input
+	var outputs []executehelpers.Output
+	var pipelineName string
+
+	modes := []string{}
+	if command.TaskConfig != "" {
+		modes = append(modes, "--config")
 	}
 
-	if imageResource != nil {
-		taskConfig.ImageResource = imageResource
+	if command.Job.JobName != "" {
+		modes = append(modes, jobFlag)
 	}
 
-	outputs, err := executehelpers.DetermineOutputs(
-		fact,
-		taskConfig.Outputs,
-		command.Outputs,
-	)
-	if err != nil {
-		return err
+	if command.Steps != "" {
+		modes = append(modes, "--steps")
 	}
 
-	plan, err := executehelpers.CreateBuildPlan(
-		fact,
-		target,
-		command.Privileged,
-		inputs,
-		inputMappings,
-		outputs,
-		taskConfig,
-		command.Tags,
-	)
+	if len(modes) == 0 {
+		return errors.New("one of --config, --job or --steps must be specified")
+	}
 
-	if err != nil {
-		return err
+	if len(modes) > 1 {
+		return fmt.Errorf("%s cannot be combined: only one of --config, --job or --steps may be specified", strings.Join(modes, " and "))
+	}
+
+	if command.InputsFrom.JobName != "" && command.TaskConfig == "" {
+		return errors.New("--inputs-from can only be combined with --config")
+	}
+
+	switch {
+	case command.Job.JobName != "":
+		plan, inputs, outputs, err = executehelpers.CreateJobBuildPlan(
+			fact,
+			target.Team(),
+			command.Job,
+			command.Inputs,
+			command.Outputs,
+			command.SkipPuts,
+		)
+		if err != nil {
+			return err
+		}
+
+		pipelineName = command.Job.PipelineName
+
+	case command.Steps != "":
+		plan, inputs, outputs, err = command.stepsBuildPlan(fact)
+		if err != nil {
+			return err
+		}
+
+	default:
+		plan, inputs, outputs, err = command.taskBuildPlan(fact, target, args)
+		if err != nil {
+			return err
+		}
+
+		pipelineName = command.InputsFrom.PipelineName
 	}
 
 	clientURL, err := url.Parse(client.URL())
@@ -113,8 +134,8 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	var build atc.Build
 	var buildURL *url.URL
 
-	if command.InputsFrom.PipelineName != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineName, plan)
+	if pipelineName != "" {
+		build, err = target.Team().CreatePipelineBuild(pipelineName, plan)
 		if err != nil {
 			return err
 		}
@@ -191,6 +212,84 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	return nil
 }
 
+func (command *ExecuteCommand) stepsBuildPlan(fact atc.PlanFactory) (atc.Plan, []executehelpers.Input, []executehelpers.Output, error) {
+	stepsTemplate := templatehelpers.NewYamlTemplateWithParams(command.Steps, command.VarsFrom, command.Var, command.YAMLVar)
+	stepsTemplateEvaluated, err := stepsTemplate.Evaluate(false, false)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	var steps atc.PlanSequence
+	err = yaml.Unmarshal(stepsTemplateEvaluated, &steps)
+	if err != nil {
+		return atc.Plan{}, nil, nil, fmt.Errorf("failed to parse steps: %s", err)
+	}
+
+	return executehelpers.CreateStepsBuildPlan(
+		fact,
+		steps,
+		command.Inputs,
+		command.Outputs,
+		command.Tags,
+	)
+}
+
+func (command *ExecuteCommand) taskBuildPlan(fact atc.PlanFactory, target rc.Target, args []string) (atc.Plan, []executehelpers.Input, []executehelpers.Output, error) {
+	taskTemplate := templatehelpers.NewYamlTemplateWithParams(command.TaskConfig, command.VarsFrom, command.Var, command.YAMLVar)
+	taskTemplateEvaluated, err := taskTemplate.Evaluate(false, false)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	taskConfig, err := config.OverrideTaskParams(taskTemplateEvaluated, args)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	inputMappings := executehelpers.DetermineInputMappings(command.InputMappings)
+	inputs, imageResource, err := executehelpers.DetermineInputs(
+		fact,
+		target.Team(),
+		taskConfig.Inputs,
+		command.Inputs,
+		inputMappings,
+		command.Image,
+		command.InputsFrom,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	if imageResource != nil {
+		taskConfig.ImageResource = imageResource
+	}
+
+	outputs, err := executehelpers.DetermineOutputs(
+		fact,
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	plan, err := executehelpers.CreateBuildPlan(
+		fact,
+		target,
+		command.Privileged,
+		inputs,
+		inputMappings,
+		outputs,
+		taskConfig,
+		command.Tags,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	return plan, inputs, outputs, nil
+}
+
 func abortOnSignal(
 	client concourse.Client,
 	terminate <-chan os.Signal,
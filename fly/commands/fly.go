@@ -21,6 +21,8 @@ type FlyCommand struct {
 
 	Userinfo UserinfoCommand `command:"userinfo" description:"User information"`
 
+	Completion CompletionCommand `command:"completion" description:"Print a shell completion script for fly"`
+
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	SetTeam     SetTeamCommand     `command:"set-team"  alias:"st" description:"Create or modify a team to have the given credentials"`
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
@@ -34,6 +36,9 @@ type FlyCommand struct {
 	Containers ContainersCommand `command:"containers" alias:"cs" description:"Print the active containers"`
 	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
 
+	Copy        CopyCommand        `command:"cp"                     description:"Copy files into or out of a container"`
+	PortForward PortForwardCommand `command:"port-forward" alias:"pf" description:"Forward a local port to a port in a container"`
+
 	Jobs       JobsCommand       `command:"jobs"      alias:"js" description:"List the jobs in the pipelines"`
 	PauseJob   PauseJobCommand   `command:"pause-job" alias:"pj" description:"Pause a job"`
 	UnpauseJob UnpauseJobCommand `command:"unpause-job" alias:"uj" description:"Unpause a job"`
@@ -48,6 +53,7 @@ type FlyCommand struct {
 	HidePipeline     HidePipelineCommand     `command:"hide-pipeline"       alias:"hp"   description:"Hide a pipeline from the public"`
 	RenamePipeline   RenamePipelineCommand   `command:"rename-pipeline"     alias:"rp"   description:"Rename a pipeline"`
 	ValidatePipeline ValidatePipelineCommand `command:"validate-pipeline"   alias:"vp"   description:"Validate a pipeline config"`
+	LintPipeline     LintPipelineCommand     `command:"lint-pipeline"       alias:"lp"   description:"Check a pipeline config for likely problems"`
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
 
@@ -59,16 +65,19 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
+	Builds           BuildsCommand           `command:"builds"            alias:"bs" description:"List builds data"`
+	AbortBuild       AbortBuildCommand       `command:"abort-build"       alias:"ab" description:"Abort a build"`
+	DownloadArtifact DownloadArtifactCommand `command:"download-artifact" alias:"da" description:"Download an artifact from a step of a finished build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
-	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
-	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
-	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
+	Workers        WorkersCommand        `command:"workers" alias:"ws" description:"List the registered workers"`
+	LandWorker     LandWorkerCommand     `command:"land-worker" alias:"lw" description:"Land a worker"`
+	PruneWorker    PruneWorkerCommand    `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
+	CordonWorker   CordonWorkerCommand   `command:"cordon-worker" alias:"cw" description:"Stop placing new containers on a running worker"`
+	UncordonWorker UncordonWorkerCommand `command:"uncordon-worker" alias:"ucw" description:"Resume placing new containers on a cordoned worker"`
 
 	Curl CurlCommand `command:"curl" alias:"c" description:"curl the api"`
 }
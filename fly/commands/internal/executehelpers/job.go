@@ -0,0 +1,203 @@
+package executehelpers
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// CreateJobBuildPlan constructs the plan the scheduler would run for the
+// job's next build, using the versions the job would run with. Each local
+// input replaces the job's get step of the same name with an upload, and each
+// output is downloaded once the job's steps have run.
+func CreateJobBuildPlan(
+	fact atc.PlanFactory,
+	team concourse.Team,
+	job flaghelpers.JobFlag,
+	localInputMappings []flaghelpers.InputPairFlag,
+	outputMappings []flaghelpers.OutputPairFlag,
+	skipPuts bool,
+) (atc.Plan, []Input, []Output, error) {
+	err := CheckForInputType(localInputMappings)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	config, _, _, found, err := team.PipelineConfig(job.PipelineName)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	if !found {
+		return atc.Plan{}, nil, nil, fmt.Errorf("pipeline %s not found", job.PipelineName)
+	}
+
+	jobConfig, found := config.Jobs.Lookup(job.JobName)
+	if !found {
+		return atc.Plan{}, nil, nil, fmt.Errorf("job %s/%s not found", job.PipelineName, job.JobName)
+	}
+
+	buildInputs, found, err := team.BuildInputsForJob(job.PipelineName, job.JobName)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	if !found {
+		return atc.Plan{}, nil, nil, fmt.Errorf("build inputs for %s/%s not found", job.PipelineName, job.JobName)
+	}
+
+	versionedResourceTypes, found, err := team.VersionedResourceTypes(job.PipelineName)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	if !found {
+		return atc.Plan{}, nil, nil, fmt.Errorf("versioned resource types of %s not found", job.PipelineName)
+	}
+
+	plan, err := factory.NewBuildFactory(0, fact).Create(jobConfig, config.Resources, versionedResourceTypes, buildInputs)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	localInputs, err := GenerateLocalInputs(fact, localInputMappings)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	rewriter := &jobPlanRewriter{
+		fact:        fact,
+		localInputs: localInputs,
+		skipPuts:    skipPuts,
+		overridden:  map[string]bool{},
+	}
+
+	plan = rewriter.rewrite(plan)
+
+	inputs := []Input{}
+	for _, mapping := range localInputMappings {
+		if !rewriter.overridden[mapping.Name] {
+			return atc.Plan{}, nil, nil, fmt.Errorf("unknown input `%s`", mapping.Name)
+		}
+
+		inputs = append(inputs, localInputs[mapping.Name])
+	}
+
+	outputs, err := determineArtifactOutputs(fact, outputMappings)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	return withOutputs(fact, plan, outputs), inputs, outputs, nil
+}
+
+// determineArtifactOutputs downloads artifacts by name, without checking them
+// against any one task's outputs.
+func determineArtifactOutputs(fact atc.PlanFactory, outputMappings []flaghelpers.OutputPairFlag) ([]Output, error) {
+	outputs := []Output{}
+	for _, mapping := range outputMappings {
+		absPath, err := filepath.Abs(mapping.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, Output{
+			Name: mapping.Name,
+			Path: absPath,
+			Plan: fact.NewPlan(atc.ArtifactOutputPlan{
+				Name: mapping.Name,
+			}),
+		})
+	}
+
+	return outputs, nil
+}
+
+func withOutputs(fact atc.PlanFactory, plan atc.Plan, outputs []Output) atc.Plan {
+	if len(outputs) == 0 {
+		return plan
+	}
+
+	buildOutputs := atc.AggregatePlan{}
+	for _, output := range outputs {
+		buildOutputs = append(buildOutputs, output.Plan)
+	}
+
+	return fact.NewPlan(atc.EnsurePlan{
+		Step: plan,
+		Next: fact.NewPlan(buildOutputs),
+	})
+}
+
+type jobPlanRewriter struct {
+	fact        atc.PlanFactory
+	localInputs map[string]Input
+	skipPuts    bool
+
+	overridden map[string]bool
+}
+
+func (rewriter *jobPlanRewriter) rewrite(plan atc.Plan) atc.Plan {
+	switch {
+	case plan.Get != nil:
+		input, found := rewriter.localInputs[plan.Get.Name]
+		if found && plan.Get.VersionFrom == nil {
+			rewriter.overridden[plan.Get.Name] = true
+			return input.Plan
+		}
+
+	case plan.OnSuccess != nil:
+		if rewriter.skipPuts && plan.OnSuccess.Step.Put != nil {
+			// a put is followed by a get of the version it created, which
+			// can't run without the put
+			return rewriter.fact.NewPlan(atc.AggregatePlan{})
+		}
+
+		plan.OnSuccess.Step = rewriter.rewrite(plan.OnSuccess.Step)
+		plan.OnSuccess.Next = rewriter.rewrite(plan.OnSuccess.Next)
+
+	case plan.Put != nil:
+		if rewriter.skipPuts {
+			return rewriter.fact.NewPlan(atc.AggregatePlan{})
+		}
+
+	case plan.Aggregate != nil:
+		for i, step := range *plan.Aggregate {
+			(*plan.Aggregate)[i] = rewriter.rewrite(step)
+		}
+
+	case plan.Do != nil:
+		for i, step := range *plan.Do {
+			(*plan.Do)[i] = rewriter.rewrite(step)
+		}
+
+	case plan.Retry != nil:
+		for i, step := range *plan.Retry {
+			(*plan.Retry)[i] = rewriter.rewrite(step)
+		}
+
+	case plan.OnAbort != nil:
+		plan.OnAbort.Step = rewriter.rewrite(plan.OnAbort.Step)
+		plan.OnAbort.Next = rewriter.rewrite(plan.OnAbort.Next)
+
+	case plan.OnFailure != nil:
+		plan.OnFailure.Step = rewriter.rewrite(plan.OnFailure.Step)
+		plan.OnFailure.Next = rewriter.rewrite(plan.OnFailure.Next)
+
+	case plan.Ensure != nil:
+		plan.Ensure.Step = rewriter.rewrite(plan.Ensure.Step)
+		plan.Ensure.Next = rewriter.rewrite(plan.Ensure.Next)
+
+	case plan.Try != nil:
+		plan.Try.Step = rewriter.rewrite(plan.Try.Step)
+
+	case plan.Timeout != nil:
+		plan.Timeout.Step = rewriter.rewrite(plan.Timeout.Step)
+	}
+
+	return plan
+}
@@ -0,0 +1,132 @@
+package executehelpers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+)
+
+// CreateStepsBuildPlan constructs a plan which uploads the local inputs and
+// then runs the steps as a job's plan would, with artifacts shared between the
+// steps by name. Each output is downloaded once the steps have run.
+func CreateStepsBuildPlan(
+	fact atc.PlanFactory,
+	steps atc.PlanSequence,
+	localInputMappings []flaghelpers.InputPairFlag,
+	outputMappings []flaghelpers.OutputPairFlag,
+	tags []string,
+) (atc.Plan, []Input, []Output, error) {
+	if len(steps) == 0 {
+		return atc.Plan{}, nil, nil, errors.New("no steps to execute")
+	}
+
+	for i := range steps {
+		err := walkSteps(&steps[i], validateStep)
+		if err != nil {
+			return atc.Plan{}, nil, nil, err
+		}
+	}
+
+	err := CheckForInputType(localInputMappings)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	if len(tags) != 0 {
+		for i := range steps {
+			walkSteps(&steps[i], func(step *atc.PlanConfig) error {
+				if step.Task != "" && len(step.Tags) == 0 {
+					step.Tags = tags
+				}
+
+				return nil
+			})
+		}
+	}
+
+	stepsPlan, err := factory.NewBuildFactory(0, fact).Create(atc.JobConfig{Plan: steps}, nil, nil, nil)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	localInputs, err := GenerateLocalInputs(fact, localInputMappings)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	inputs := []Input{}
+	buildInputs := atc.AggregatePlan{}
+	for _, mapping := range localInputMappings {
+		input := localInputs[mapping.Name]
+		inputs = append(inputs, input)
+		buildInputs = append(buildInputs, input.Plan)
+	}
+
+	outputs, err := determineArtifactOutputs(fact, outputMappings)
+	if err != nil {
+		return atc.Plan{}, nil, nil, err
+	}
+
+	plan := fact.NewPlan(atc.DoPlan{
+		fact.NewPlan(buildInputs),
+		stepsPlan,
+	})
+
+	return withOutputs(fact, plan, outputs), inputs, outputs, nil
+}
+
+// walkSteps calls visit with the step and then with each step nested within
+// it, stopping at the first error.
+func walkSteps(step *atc.PlanConfig, visit func(*atc.PlanConfig) error) error {
+	err := visit(step)
+	if err != nil {
+		return err
+	}
+
+	nested := []*atc.PlanConfig{step.Try, step.Abort, step.Failure, step.Ensure, step.Success}
+	for _, sequence := range []*atc.PlanSequence{step.Do, step.Aggregate} {
+		if sequence != nil {
+			for i := range *sequence {
+				nested = append(nested, &(*sequence)[i])
+			}
+		}
+	}
+
+	for _, nestedStep := range nested {
+		if nestedStep != nil {
+			err := walkSteps(nestedStep, visit)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateStep(step *atc.PlanConfig) error {
+	switch {
+	case step.Get != "":
+		return fmt.Errorf("step `%s` is a get step; only tasks can be executed", step.Get)
+
+	case step.Put != "":
+		return fmt.Errorf("step `%s` is a put step; only tasks can be executed", step.Put)
+
+	case step.Task != "":
+		if step.TaskConfig == nil && step.TaskConfigPath == "" {
+			return fmt.Errorf("task `%s` must specify either a config or a file", step.Task)
+		}
+
+		if step.TaskConfig != nil {
+			err := step.TaskConfig.Validate()
+			if err != nil {
+				return fmt.Errorf("task `%s`: %s", step.Task, err)
+			}
+		}
+	}
+
+	return nil
+}
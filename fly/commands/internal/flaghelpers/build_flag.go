@@ -0,0 +1,33 @@
+package flaghelpers
+
+import (
+	"github.com/jessevdk/go-flags"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// BuildFlag is a build name within the job given with -j/--job, or a global
+// build ID.
+type BuildFlag string
+
+func (flag *BuildFlag) Complete(match string) []flags.Completion {
+	job, ok := jobFromArgs()
+	if !ok {
+		return []flags.Completion{}
+	}
+
+	return completeNames(match, "", "builds/"+job.PipelineName+"/"+job.JobName, func(target rc.Target) ([]string, error) {
+		builds, _, _, err := target.Team().JobBuilds(job.PipelineName, job.JobName, concourse.Page{Limit: 50})
+		if err != nil {
+			return nil, err
+		}
+
+		names := []string{}
+		for _, build := range builds {
+			names = append(names, build.Name)
+		}
+
+		return names, nil
+	})
+}
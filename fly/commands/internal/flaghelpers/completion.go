@@ -1,13 +1,24 @@
 package flaghelpers
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 
 	"github.com/concourse/concourse/fly/rc"
 )
 
+// CompletionCacheTTL is how long names fetched from a target are reused when
+// completing, so that completing a single word does not hit the API once per
+// keypress.
+const CompletionCacheTTL = 30 * time.Second
+
 type flyCommand struct {
 	Target rc.TargetName `short:"t" long:"target" description:"Concourse target name"`
 }
@@ -29,3 +40,123 @@ func parseFlags() flyCommand {
 
 	return fly
 }
+
+// argValue returns the value given to a flag anywhere on the command line,
+// for completions which depend on another flag of the same command.
+func argValue(args []string, short string, long string) string {
+	for i, arg := range args {
+		switch {
+		case arg == "-"+short || arg == "--"+long:
+			if i+1 < len(args) {
+				return args[i+1]
+			}
+		case strings.HasPrefix(arg, "--"+long+"="):
+			return strings.TrimPrefix(arg, "--"+long+"=")
+		case short != "" && strings.HasPrefix(arg, "-"+short) && !strings.HasPrefix(arg, "--"):
+			return strings.TrimPrefix(arg, "-"+short)
+		}
+	}
+
+	return ""
+}
+
+// jobFromArgs returns the job given with -j/--job, if any.
+func jobFromArgs() (JobFlag, bool) {
+	var job JobFlag
+
+	err := job.UnmarshalFlag(argValue(os.Args[1:], "j", "job"))
+	if err != nil {
+		return JobFlag{}, false
+	}
+
+	return job, true
+}
+
+// completeNames completes match against names fetched from the current
+// target, prepending prefix to each name. Names are cached per target under
+// key.
+func completeNames(match string, prefix string, key string, fetch func(rc.Target) ([]string, error)) []flags.Completion {
+	comps := []flags.Completion{}
+
+	fly := parseFlags()
+	if fly.Target == "" {
+		return comps
+	}
+
+	names, err := cachedNames(fly.Target, key, func() ([]string, error) {
+		target, err := rc.LoadTarget(fly.Target, false)
+		if err != nil {
+			return nil, err
+		}
+
+		err = target.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		return fetch(target)
+	})
+	if err != nil {
+		return comps
+	}
+
+	for _, name := range names {
+		if strings.HasPrefix(prefix+name, match) {
+			comps = append(comps, flags.Completion{Item: prefix + name})
+		}
+	}
+
+	return comps
+}
+
+type completionCacheEntry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Names     []string  `json:"names"`
+}
+
+func cachedNames(targetName rc.TargetName, key string, fetch func() ([]string, error)) ([]string, error) {
+	path := filepath.Join(rc.CacheDir(), "completion", url.PathEscape(string(targetName)), url.PathEscape(key)+".json")
+
+	payload, err := ioutil.ReadFile(path)
+	if err == nil {
+		var entry completionCacheEntry
+		err = json.Unmarshal(payload, &entry)
+		if err == nil && time.Since(entry.FetchedAt) < CompletionCacheTTL {
+			return entry.Names, nil
+		}
+	}
+
+	names, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err = json.Marshal(completionCacheEntry{
+		FetchedAt: time.Now(),
+		Names:     names,
+	})
+	if err != nil {
+		return names, nil
+	}
+
+	// the cache is best-effort; completing still works without it
+	if os.MkdirAll(filepath.Dir(path), 0700) == nil {
+		ioutil.WriteFile(path, payload, 0600)
+	}
+
+	return names, nil
+}
+
+func pipelineNames(target rc.Target) ([]string, error) {
+	pipelines, err := target.Team().ListPipelines()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, pipeline := range pipelines {
+		names = append(names, pipeline.Name)
+	}
+
+	return names, nil
+}
@@ -2,7 +2,6 @@ package flaghelpers
 
 import (
 	"errors"
-	"fmt"
 	"strings"
 
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -38,45 +37,28 @@ func (job *JobFlag) UnmarshalFlag(value string) error {
 }
 
 func (flag *JobFlag) Complete(match string) []flags.Completion {
-	fly := parseFlags()
-
-	target, err := rc.LoadTarget(fly.Target, false)
-	if err != nil {
-		return []flags.Completion{}
-	}
-
-	err = target.Validate()
-	if err != nil {
-		return []flags.Completion{}
-	}
-
-	team := target.Team()
-	comps := []flags.Completion{}
 	vs := strings.SplitN(match, "/", 2)
 
 	if len(vs) == 1 {
-		pipelines, err := team.ListPipelines()
-		if err != nil {
-			return comps
+		comps := completeNames(match, "", "pipelines", pipelineNames)
+		for i := range comps {
+			comps[i].Item += "/"
 		}
 
-		for _, pipeline := range pipelines {
-			if strings.HasPrefix(pipeline.Name, vs[0]) {
-				comps = append(comps, flags.Completion{Item: pipeline.Name + "/"})
-			}
-		}
-	} else if len(vs) == 2 {
-		jobs, err := team.ListJobs(vs[0])
+		return comps
+	}
+
+	return completeNames(match, vs[0]+"/", "jobs/"+vs[0], func(target rc.Target) ([]string, error) {
+		jobs, err := target.Team().ListJobs(vs[0])
 		if err != nil {
-			return comps
+			return nil, err
 		}
 
+		names := []string{}
 		for _, job := range jobs {
-			if strings.HasPrefix(job.Name, vs[1]) {
-				comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", vs[0], job.Name)})
-			}
+			names = append(names, job.Name)
 		}
-	}
 
-	return comps
+		return names, nil
+	})
 }
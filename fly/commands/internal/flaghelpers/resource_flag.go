@@ -4,6 +4,9 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/jessevdk/go-flags"
+
+	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
@@ -32,3 +35,30 @@ func (resource *ResourceFlag) UnmarshalFlag(value string) error {
 
 	return nil
 }
+
+func (flag *ResourceFlag) Complete(match string) []flags.Completion {
+	vs := strings.SplitN(match, "/", 2)
+
+	if len(vs) == 1 {
+		comps := completeNames(match, "", "pipelines", pipelineNames)
+		for i := range comps {
+			comps[i].Item += "/"
+		}
+
+		return comps
+	}
+
+	return completeNames(match, vs[0]+"/", "resources/"+vs[0], func(target rc.Target) ([]string, error) {
+		resources, err := target.Team().ListResources(vs[0])
+		if err != nil {
+			return nil, err
+		}
+
+		names := []string{}
+		for _, resource := range resources {
+			names = append(names, resource.Name)
+		}
+
+		return names, nil
+	})
+}
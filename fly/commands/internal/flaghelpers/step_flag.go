@@ -0,0 +1,70 @@
+package flaghelpers
+
+import (
+	"sort"
+
+	"github.com/jessevdk/go-flags"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+// StepFlag is the name of a step in the job given with -j/--job.
+type StepFlag string
+
+func (flag *StepFlag) Complete(match string) []flags.Completion {
+	job, ok := jobFromArgs()
+	if !ok {
+		return []flags.Completion{}
+	}
+
+	return completeNames(match, "", "steps/"+job.PipelineName+"/"+job.JobName, func(target rc.Target) ([]string, error) {
+		config, _, _, found, err := target.Team().PipelineConfig(job.PipelineName)
+		if err != nil || !found {
+			return nil, err
+		}
+
+		jobConfig, found := config.Jobs.Lookup(job.JobName)
+		if !found {
+			return nil, nil
+		}
+
+		names := map[string]bool{}
+		collectStepNames(jobConfig.Plan, names)
+
+		sorted := []string{}
+		for name := range names {
+			sorted = append(sorted, name)
+		}
+
+		sort.Strings(sorted)
+
+		return sorted, nil
+	})
+}
+
+func collectStepNames(plan atc.PlanSequence, names map[string]bool) {
+	for _, step := range plan {
+		collectStepName(step, names)
+	}
+}
+
+func collectStepName(step atc.PlanConfig, names map[string]bool) {
+	if step.Get != "" || step.Put != "" || step.Task != "" {
+		names[step.Name()] = true
+	}
+
+	if step.Do != nil {
+		collectStepNames(*step.Do, names)
+	}
+
+	if step.Aggregate != nil {
+		collectStepNames(*step.Aggregate, names)
+	}
+
+	for _, hook := range []*atc.PlanConfig{step.Try, step.Abort, step.Failure, step.Ensure, step.Success} {
+		if hook != nil {
+			collectStepName(*hook, names)
+		}
+	}
+}
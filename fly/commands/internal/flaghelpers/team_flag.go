@@ -0,0 +1,25 @@
+package flaghelpers
+
+import (
+	"github.com/jessevdk/go-flags"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type TeamFlag string
+
+func (flag *TeamFlag) Complete(match string) []flags.Completion {
+	return completeNames(match, "", "teams", func(target rc.Target) ([]string, error) {
+		teams, err := target.Client().ListTeams()
+		if err != nil {
+			return nil, err
+		}
+
+		names := []string{}
+		for _, team := range teams {
+			names = append(names, team.Name)
+		}
+
+		return names, nil
+	})
+}
@@ -0,0 +1,25 @@
+package flaghelpers
+
+import (
+	"github.com/jessevdk/go-flags"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type WorkerFlag string
+
+func (flag *WorkerFlag) Complete(match string) []flags.Completion {
+	return completeNames(match, "", "workers", func(target rc.Target) ([]string, error) {
+		workers, err := target.Client().ListWorkers()
+		if err != nil {
+			return nil, err
+		}
+
+		names := []string{}
+		for _, worker := range workers {
+			names = append(names, worker.Name)
+		}
+
+		return names, nil
+	})
+}
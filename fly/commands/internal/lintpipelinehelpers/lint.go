@@ -0,0 +1,105 @@
+package lintpipelinehelpers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/lint"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	"gopkg.in/yaml.v2"
+)
+
+type Format int
+
+const (
+	FormatText Format = iota
+	FormatJSON
+	FormatSARIF
+)
+
+// Lint runs the default rules against the config. Vars are left
+// uninterpolated, so that credentials provided by them are not mistaken for
+// plain-text secrets. It exits non-zero if any finding is an error, or, when
+// strict, a warning.
+func Lint(configPath atc.PathFlag, format Format, strict bool) error {
+	configBytes, err := ioutil.ReadFile(string(configPath))
+	if err != nil {
+		return err
+	}
+
+	evaluatedTemplate, err := templatehelpers.NewYamlTemplateWithParams(configPath, nil, nil, nil).Evaluate(true, false)
+	if err != nil {
+		return err
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(evaluatedTemplate, &config)
+	if err != nil {
+		return err
+	}
+
+	findings := lint.LintConfig(config, lint.DefaultRules, lint.ParseIgnores(configBytes))
+
+	switch format {
+	case FormatJSON:
+		err = displayhelpers.JsonPrint(findings)
+	case FormatSARIF:
+		err = displayhelpers.JsonPrint(NewSARIFLog(string(configPath), lint.DefaultRules, findings))
+	default:
+		err = renderFindings(findings)
+	}
+	if err != nil {
+		return err
+	}
+
+	failSeverity := lint.SeverityError
+	if strict {
+		failSeverity = lint.SeverityWarning
+	}
+
+	for _, finding := range findings {
+		if finding.Severity.AtLeast(failSeverity) {
+			displayhelpers.Failf("configuration has problems")
+		}
+	}
+
+	return nil
+}
+
+var severityColors = map[lint.Severity]*color.Color{
+	lint.SeverityError:   ui.ErroredColor,
+	lint.SeverityWarning: ui.StartedColor,
+	lint.SeverityInfo:    ui.PendingColor,
+}
+
+func renderFindings(findings []lint.Finding) error {
+	if len(findings) == 0 {
+		fmt.Println("looks good")
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "severity", Color: color.New(color.Bold)},
+			{Contents: "rule", Color: color.New(color.Bold)},
+			{Contents: "location", Color: color.New(color.Bold)},
+			{Contents: "message", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, finding := range findings {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: string(finding.Severity), Color: severityColors[finding.Severity]},
+			{Contents: finding.Rule},
+			{Contents: finding.Location},
+			{Contents: finding.Message},
+		})
+	}
+
+	return table.Render(os.Stdout, true)
+}
@@ -0,0 +1,117 @@
+package lintpipelinehelpers
+
+import (
+	"github.com/concourse/concourse/atc/lint"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// SARIFLog is the subset of the Static Analysis Results Interchange Format
+// needed to report lint findings to code scanning tools.
+type SARIFLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+type SARIFRule struct {
+	ID               string       `json:"id"`
+	ShortDescription SARIFMessage `json:"shortDescription"`
+}
+
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations"`
+}
+
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []SARIFLogicalLocation `json:"logicalLocations"`
+}
+
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+}
+
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type SARIFLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+var sarifLevels = map[lint.Severity]string{
+	lint.SeverityError:   "error",
+	lint.SeverityWarning: "warning",
+	lint.SeverityInfo:    "note",
+}
+
+func NewSARIFLog(configPath string, rules []lint.Rule, findings []lint.Finding) SARIFLog {
+	driver := SARIFDriver{
+		Name:           "fly lint-pipeline",
+		InformationURI: "https://concourse-ci.org",
+		Rules:          []SARIFRule{},
+	}
+
+	for _, rule := range rules {
+		driver.Rules = append(driver.Rules, SARIFRule{
+			ID:               rule.Name,
+			ShortDescription: SARIFMessage{Text: rule.Description},
+		})
+	}
+
+	results := []SARIFResult{}
+	for _, finding := range findings {
+		results = append(results, SARIFResult{
+			RuleID:  finding.Rule,
+			Level:   sarifLevels[finding.Severity],
+			Message: SARIFMessage{Text: finding.Message},
+			Locations: []SARIFLocation{
+				{
+					PhysicalLocation: SARIFPhysicalLocation{
+						ArtifactLocation: SARIFArtifactLocation{URI: configPath},
+					},
+					LogicalLocations: []SARIFLogicalLocation{
+						{FullyQualifiedName: finding.Location},
+					},
+				},
+			},
+		})
+	}
+
+	return SARIFLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []SARIFRun{
+			{
+				Tool:    SARIFTool{Driver: driver},
+				Results: results,
+			},
+		},
+	}
+}
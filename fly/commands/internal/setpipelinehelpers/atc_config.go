@@ -22,6 +22,8 @@ type ATCConfig struct {
 	Target           string
 	SkipInteraction  bool
 	CheckCredentials bool
+	DryRun           bool
+	FailOnBreaking   bool
 }
 
 func (atcConfig ATCConfig) ApplyConfigInteraction() bool {
@@ -65,6 +67,10 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		displayhelpers.ShowErrors("Error loading existing config", errorMessages)
 	}
 
+	if atcConfig.DryRun {
+		return atcConfig.dryRun(existingConfigVersion, evaluatedTemplate)
+	}
+
 	if !diffExists {
 		fmt.Println("no changes to apply")
 		return nil
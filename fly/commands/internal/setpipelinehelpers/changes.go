@@ -0,0 +1,79 @@
+package setpipelinehelpers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/mgutz/ansi"
+)
+
+var ErrBreakingChanges = errors.New("configuration contains breaking changes")
+
+func (atcConfig ATCConfig) dryRun(configVersion string, evaluatedTemplate []byte) error {
+	changes, warnings, err := atcConfig.Team.DryRunPipelineConfig(
+		atcConfig.PipelineName,
+		configVersion,
+		evaluatedTemplate,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	renderChanges(os.Stdout, changes)
+
+	fmt.Println("")
+	fmt.Println("dry run: configuration not applied")
+
+	if atcConfig.FailOnBreaking && changes.Breaking() {
+		return ErrBreakingChanges
+	}
+
+	return nil
+}
+
+func renderChanges(out io.Writer, changes atc.ConfigChanges) {
+	breaking := ansi.Color("(breaking)", "red")
+
+	fmt.Fprintln(out, "consequences:")
+
+	if !changes.Breaking() && len(changes.AddedJobs) == 0 && len(changes.AddedResources) == 0 && len(changes.JobsWithChangedInputs) == 0 {
+		fmt.Fprintln(out, "  none")
+		return
+	}
+
+	for _, name := range changes.AddedJobs {
+		fmt.Fprintf(out, "  job %s will be added\n", name)
+	}
+
+	for _, name := range changes.RemovedJobs {
+		fmt.Fprintf(out, "  job %s will be removed %s\n", name, breaking)
+	}
+
+	for _, name := range changes.JobsWithChangedInputs {
+		fmt.Fprintf(out, "  job %s will have different inputs\n", name)
+	}
+
+	for _, name := range changes.AddedResources {
+		fmt.Fprintf(out, "  resource %s will be added\n", name)
+	}
+
+	for _, name := range changes.RemovedResources {
+		fmt.Fprintf(out, "  resource %s will be removed %s\n", name, breaking)
+	}
+
+	for _, name := range changes.ResourcesWithChangedSource {
+		fmt.Fprintf(out, "  resource %s will lose its version history, as its source has changed %s\n", name, breaking)
+	}
+
+	for _, message := range changes.UnresolvedCredentials {
+		fmt.Fprintf(out, "  credentials could not be resolved: %s %s\n", message, breaking)
+	}
+}
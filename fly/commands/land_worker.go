@@ -2,16 +2,24 @@ package commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type LandWorkerCommand struct {
-	Worker string `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+	Worker flaghelpers.WorkerFlag `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+
+	Wait         bool          `long:"wait"          description:"Wait for the worker's running builds to finish and for the worker to land"`
+	WaitInterval time.Duration `long:"wait-interval" default:"5s" description:"Interval on which to check on the worker while waiting"`
 }
 
 func (command *LandWorkerCommand) Execute(args []string) error {
-	workerName := command.Worker
+	workerName := string(command.Worker)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -28,7 +36,64 @@ func (command *LandWorkerCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Wait {
+		err = command.waitForLanded(target.Client(), workerName)
+		if err != nil {
+			return err
+		}
+	}
+
 	fmt.Printf("landed '%s'\n", workerName)
 
 	return nil
 }
+
+func (command *LandWorkerCommand) waitForLanded(client concourse.Client, workerName string) error {
+	var remaining string
+
+	for {
+		workers, err := client.ListWorkers()
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, worker := range workers {
+			if worker.Name != workerName {
+				continue
+			}
+
+			found = true
+
+			if worker.State == "landed" {
+				return nil
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("worker '%s' disappeared while landing", workerName)
+		}
+
+		buildIDs, err := client.ListWorkerBuilds(workerName)
+		if err != nil {
+			return err
+		}
+
+		builds := []string{}
+		for _, id := range buildIDs {
+			builds = append(builds, strconv.Itoa(id))
+		}
+
+		if len(builds) == 0 {
+			if remaining != "" {
+				fmt.Println("all builds finished; waiting for worker to land")
+				remaining = ""
+			}
+		} else if strings.Join(builds, ", ") != remaining {
+			remaining = strings.Join(builds, ", ")
+			fmt.Printf("waiting for builds: %s\n", remaining)
+		}
+
+		time.Sleep(command.WaitInterval)
+	}
+}
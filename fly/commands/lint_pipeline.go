@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/lintpipelinehelpers"
+)
+
+type LintPipelineCommand struct {
+	Config atc.PathFlag `short:"c" long:"config" required:"true" description:"Pipeline configuration file"`
+	Strict bool         `short:"s" long:"strict"                 description:"Fail on warnings as well as errors"`
+
+	Json  bool `long:"json"  description:"Print the findings as JSON"`
+	Sarif bool `long:"sarif" description:"Print the findings in the SARIF format, for code scanning tools"`
+}
+
+func (command *LintPipelineCommand) Execute(args []string) error {
+	format := lintpipelinehelpers.FormatText
+
+	switch {
+	case command.Json && command.Sarif:
+		return errors.New("only one of --json or --sarif may be specified")
+	case command.Json:
+		format = lintpipelinehelpers.FormatJSON
+	case command.Sarif:
+		format = lintpipelinehelpers.FormatSARIF
+	}
+
+	return lintpipelinehelpers.Lint(command.Config, format, command.Strict)
+}
@@ -30,6 +30,8 @@ type LoginCommand struct {
 	CACert      atc.PathFlag `long:"ca-cert" description:"Path to Concourse PEM-encoded CA certificate file."`
 	OpenBrowser bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
 
+	CredentialHelper string `long:"credential-helper" description:"Store the token using the named credential helper (fly-credential-<name>) instead of in the flyrc. Only tokens from --username/--password logins kept by a helper are refreshed automatically; browser logins must log in again when the token expires"`
+
 	BrowserOnly bool
 }
 
@@ -92,6 +94,12 @@ func (command *LoginCommand) Execute(args []string) error {
 
 	var tokenType string
 	var tokenValue string
+	var refreshToken string
+
+	credentialHelper, err := command.credentialHelper()
+	if err != nil {
+		return err
+	}
 
 	version, err := target.Version()
 	if err != nil {
@@ -118,7 +126,7 @@ func (command *LoginCommand) Execute(args []string) error {
 		tokenType, tokenValue, err = command.legacyAuth(target, command.BrowserOnly)
 	} else {
 		if command.Username != "" && command.Password != "" {
-			tokenType, tokenValue, err = command.passwordGrant(client, command.Username, command.Password)
+			tokenType, tokenValue, refreshToken, err = command.passwordGrant(client, command.Username, command.Password, credentialHelper != "")
 		} else {
 			tokenType, tokenValue, err = command.authCodeGrant(client.URL(), command.BrowserOnly)
 		}
@@ -135,33 +143,48 @@ func (command *LoginCommand) Execute(args []string) error {
 		return err
 	}
 
+	if refreshToken == "" || credentialHelper == "" {
+		// browser logins hand the token over through the callback, which only
+		// carries the access token, and refresh tokens are only kept by
+		// credential helpers
+		fmt.Println("note: this token will not be refreshed automatically; only --username/--password logins with a --credential-helper are")
+	}
+
 	return command.saveTarget(
 		client.URL(),
 		&rc.TargetToken{
-			Type:  tokenType,
-			Value: tokenValue,
+			Type:         tokenType,
+			Value:        tokenValue,
+			RefreshToken: refreshToken,
 		},
 		target.CACert(),
 	)
 }
 
-func (command *LoginCommand) passwordGrant(client concourse.Client, username, password string) (string, string, error) {
+func (command *LoginCommand) passwordGrant(client concourse.Client, username, password string, offline bool) (string, string, string, error) {
+
+	scopes := []string{"openid", "profile", "email", "federated:id", "groups"}
+	if offline {
+		// refresh tokens are long-lived, so only ask for one when a credential
+		// helper will keep it out of the flyrc
+		scopes = append(scopes, "offline_access")
+	}
 
 	oauth2Config := oauth2.Config{
 		ClientID:     "fly",
 		ClientSecret: "Zmx5",
 		Endpoint:     oauth2.Endpoint{TokenURL: client.URL() + "/sky/token"},
-		Scopes:       []string{"openid", "profile", "email", "federated:id", "groups"},
+		Scopes:       scopes,
 	}
 
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client.HTTPClient())
 
 	token, err := oauth2Config.PasswordCredentialsToken(ctx, username, password)
 	if err != nil {
-		return "", "", err
+		return "", "", "", err
 	}
 
-	return token.TokenType, token.AccessToken, nil
+	return token.TokenType, token.AccessToken, token.RefreshToken, nil
 }
 
 func (command *LoginCommand) authCodeGrant(targetUrl string, browserOnly bool) (string, string, error) {
@@ -309,15 +332,36 @@ func waitForTokenInput(tokenChannel chan string, errorChannel chan error) {
 	}
 }
 
+func (command *LoginCommand) credentialHelper() (string, error) {
+	if command.CredentialHelper != "" {
+		return command.CredentialHelper, nil
+	}
+
+	targets, err := rc.LoadTargets()
+	if err != nil {
+		return "", err
+	}
+
+	return targets.Targets[Fly.Target].CredentialHelper, nil
+}
+
 func (command *LoginCommand) saveTarget(url string, token *rc.TargetToken, caCert string) error {
+	if command.CredentialHelper != "" {
+		err := rc.UseCredentialHelper(Fly.Target, command.CredentialHelper)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := rc.SaveTarget(
 		Fly.Target,
 		url,
 		command.Insecure,
 		command.TeamName,
 		&rc.TargetToken{
-			Type:  token.Type,
-			Value: token.Value,
+			Type:         token.Type,
+			Value:        token.Value,
+			RefreshToken: token.RefreshToken,
 		},
 		caCert,
 	)
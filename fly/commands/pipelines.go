@@ -4,15 +4,14 @@ import (
 	"os"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type PipelinesCommand struct {
-	All  bool `short:"a"  long:"all" description:"Show all pipelines"`
-	Json bool `long:"json" description:"Print command result as JSON"`
+	All bool `short:"a"  long:"all" description:"Show all pipelines"`
+	ui.OutputFlags
 }
 
 func (command *PipelinesCommand) Execute([]string) error {
@@ -40,12 +39,8 @@ func (command *PipelinesCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(pipelines)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Structured() {
+		return command.Present(os.Stdout, pipelines)
 	}
 
 	table := ui.Table{Headers: ui.TableRow{}}
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/hijacker"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/tedsuo/rata"
+)
+
+// portForwardScript is run in the container for every forwarded connection,
+// piping its stdin and stdout to the container port given as $0 with
+// whichever tool the image happens to have.
+const portForwardScript = `
+if command -v nc >/dev/null 2>&1; then
+  exec nc 127.0.0.1 "$0"
+elif command -v socat >/dev/null 2>&1; then
+  exec socat - "TCP:127.0.0.1:$0"
+elif command -v bash >/dev/null 2>&1; then
+  exec bash -c 'exec 3<>"/dev/tcp/127.0.0.1/$0"; cat <&3 & cat >&3; wait' "$0"
+fi
+
+echo "port-forward needs one of nc, socat or bash in the container" >&2
+exit 1
+`
+
+type PortForwardCommand struct {
+	ContainerFlags
+
+	Address string `long:"address" default:"127.0.0.1" description:"Local address to listen on"`
+
+	PositionalArgs struct {
+		Ports string `positional-arg-name:"[LOCAL_PORT:]CONTAINER_PORT" required:"true" description:"Container port to forward to, and optionally the local port to forward from"`
+	} `positional-args:"yes"`
+}
+
+func (command *PortForwardCommand) Execute([]string) error {
+	localPort, containerPort, err := parsePortForward(command.PositionalArgs.Ports)
+	if err != nil {
+		return err
+	}
+
+	target, err := command.LoadTarget()
+	if err != nil {
+		return err
+	}
+
+	container, err := command.SelectContainer(target)
+	if err == io.EOF {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(command.Address, strconv.Itoa(localPort)))
+	if err != nil {
+		return err
+	}
+
+	defer listener.Close()
+
+	fmt.Printf("forwarding %s to port %d of the container\n", listener.Addr(), containerPort)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go command.forward(target, container, containerPort, conn)
+	}
+}
+
+func (command *PortForwardCommand) forward(target rc.Target, container atc.Container, port int, conn net.Conn) {
+	defer conn.Close()
+
+	spec := atc.HijackProcessSpec{
+		Path: "sh",
+		Args: []string{"-c", portForwardScript, strconv.Itoa(port)},
+		User: container.User,
+		Dir:  container.WorkingDirectory,
+	}
+
+	reqGenerator := rata.NewRequestGenerator(target.URL(), atc.Routes)
+
+	h := hijacker.New(target.TLSConfig(), reqGenerator, target.Token())
+
+	status, err := h.Hijack(target.Team().Name(), container.ID, spec, hijacker.ProcessIO{
+		In:  conn,
+		Out: conn,
+		Err: os.Stderr,
+	})
+	if err != nil {
+		fmt.Fprintf(ui.Stderr, "failed to forward connection from %s: %s\n", conn.RemoteAddr(), err)
+		return
+	}
+
+	if status != 0 {
+		fmt.Fprintf(ui.Stderr, "connection from %s to port %d exited with status %d\n", conn.RemoteAddr(), port, status)
+	}
+}
+
+func parsePortForward(spec string) (int, int, error) {
+	// like kubectl, a lone port is forwarded from the same local port; ask
+	// for local port 0 to have one picked
+	local, remote := spec, spec
+
+	if segments := strings.SplitN(spec, ":", 2); len(segments) == 2 {
+		local, remote = segments[0], segments[1]
+	}
+
+	localPort, err := strconv.Atoi(local)
+	if err != nil || localPort < 0 || localPort > 65535 {
+		return 0, 0, fmt.Errorf("invalid local port: '%s'", local)
+	}
+
+	containerPort, err := strconv.Atoi(remote)
+	if err != nil || containerPort <= 0 || containerPort > 65535 {
+		return 0, 0, fmt.Errorf("invalid container port: '%s'", remote)
+	}
+
+	return localPort, containerPort, nil
+}
@@ -3,15 +3,16 @@ package commands
 import (
 	"fmt"
 
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 )
 
 type PruneWorkerCommand struct {
-	Worker string `short:"w"  long:"worker" required:"true" description:"Worker to prune"`
+	Worker flaghelpers.WorkerFlag `short:"w"  long:"worker" required:"true" description:"Worker to prune"`
 }
 
 func (command *PruneWorkerCommand) Execute(args []string) error {
-	workerName := command.Worker
+	workerName := string(command.Worker)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
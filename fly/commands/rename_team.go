@@ -4,12 +4,13 @@ import (
 	"fmt"
 
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 )
 
 type RenameTeamCommand struct {
-	TeamName    string `short:"o" long:"old-name" required:"true" description:"Current team name"`
-	NewTeamName string `short:"n" long:"new-name" required:"true" description:"New team name"`
+	TeamName    flaghelpers.TeamFlag `short:"o" long:"old-name" required:"true" description:"Current team name"`
+	NewTeamName string               `short:"n" long:"new-name" required:"true" description:"New team name"`
 }
 
 func (command *RenameTeamCommand) Execute([]string) error {
@@ -23,7 +24,7 @@ func (command *RenameTeamCommand) Execute([]string) error {
 		return err
 	}
 
-	found, err := target.Team().RenameTeam(command.TeamName, command.NewTeamName)
+	found, err := target.Team().RenameTeam(string(command.TeamName), command.NewTeamName)
 	if err != nil {
 		return err
 	}
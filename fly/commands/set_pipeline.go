@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
@@ -15,6 +17,9 @@ type SetPipelineCommand struct {
 
 	CheckCredentials bool `long:"check-creds"  description:"Validate credential variables against credential manager"`
 
+	DryRun         bool `long:"dry-run"           description:"Show the consequences of the configuration, as determined by the ATC, without applying it"`
+	FailOnBreaking bool `long:"fail-on-breaking"  description:"With --dry-run, exit non-zero if the configuration removes jobs or resources, resets version history or has unresolved credentials"`
+
 	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to configure"`
 	Config   atc.PathFlag             `short:"c"  long:"config"    required:"true"  description:"Pipeline configuration file"`
 
@@ -25,6 +30,10 @@ type SetPipelineCommand struct {
 }
 
 func (command *SetPipelineCommand) Validate() error {
+	if command.FailOnBreaking && !command.DryRun {
+		return errors.New("--fail-on-breaking can only be used with --dry-run")
+	}
+
 	return command.Pipeline.Validate()
 }
 
@@ -55,6 +64,8 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
+		DryRun:           command.DryRun,
+		FailOnBreaking:   command.FailOnBreaking,
 	}
 
 	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar)
@@ -2,13 +2,23 @@ package commands
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
 	jwt "github.com/dgrijalva/jwt-go"
 )
 
-type StatusCommand struct{}
+type StatusCommand struct {
+	ui.OutputFlags
+}
+
+type targetStatus struct {
+	Target   string `json:"target"`
+	LoggedIn bool   `json:"logged_in"`
+	Error    string `json:"error,omitempty"`
+}
 
 func (c *StatusCommand) Execute([]string) error {
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
@@ -16,30 +26,60 @@ func (c *StatusCommand) Execute([]string) error {
 		return err
 	}
 
-	tToken := target.Token()
+	status := c.status(target)
+
+	if c.Structured() {
+		err = c.Present(os.Stdout, status)
+		if err != nil {
+			return err
+		}
+
+		if !status.LoggedIn {
+			os.Exit(1)
+		}
 
-	if tToken == nil || tToken.Value == "" {
-		displayhelpers.Failf("logged out")
 		return nil
 	}
 
-	if tToken != nil {
-		_, err := jwt.Parse(tToken.Value, func(token *jwt.Token) (interface{}, error) {
-			return nil, token.Claims.Valid()
-		})
-
-		if err != nil && err.Error() != jwt.ErrInvalidKeyType.Error() {
-			displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
-			return nil
-		}
+	if status.Error == "logged out" {
+		displayhelpers.Failf("logged out")
+		return nil
+	}
 
-		_, err = target.Client().UserInfo()
-		if err != nil {
-			displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
-			return nil
-		}
+	if !status.LoggedIn {
+		displayhelpers.Failf("please login again.\n\ntoken validation failed with error : %s", status.Error)
+		return nil
 	}
 
 	fmt.Println("logged in successfully")
 	return nil
 }
+
+func (c *StatusCommand) status(target rc.Target) targetStatus {
+	status := targetStatus{Target: string(Fly.Target)}
+
+	tToken := target.Token()
+
+	if tToken == nil || tToken.Value == "" {
+		status.Error = "logged out"
+		return status
+	}
+
+	_, err := jwt.Parse(tToken.Value, func(token *jwt.Token) (interface{}, error) {
+		return nil, token.Claims.Valid()
+	})
+
+	if err != nil && err.Error() != jwt.ErrInvalidKeyType.Error() {
+		status.Error = err.Error()
+		return status
+	}
+
+	_, err = target.Client().UserInfo()
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	status.LoggedIn = true
+	return status
+}
@@ -12,7 +12,16 @@ import (
 	"github.com/fatih/color"
 )
 
-type TargetsCommand struct{}
+type TargetsCommand struct {
+	ui.OutputFlags
+}
+
+type targetSummary struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Team   string `json:"team"`
+	Expiry string `json:"expiry"`
+}
 
 func (command *TargetsCommand) Execute([]string) error {
 	flyYAML, err := rc.LoadTargets()
@@ -20,6 +29,24 @@ func (command *TargetsCommand) Execute([]string) error {
 		return err
 	}
 
+	targets := []targetSummary{}
+	for targetName, targetValues := range flyYAML.Targets {
+		targets = append(targets, targetSummary{
+			Name:   string(targetName),
+			URL:    targetValues.API,
+			Team:   targetValues.TeamName,
+			Expiry: GetExpirationFromString(targetValues.Token),
+		})
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].Name < targets[j].Name
+	})
+
+	if command.Structured() {
+		return command.Present(os.Stdout, targets)
+	}
+
 	table := ui.Table{
 		Headers: ui.TableRow{
 			{Contents: "name", Color: color.New(color.Bold)},
@@ -29,21 +56,15 @@ func (command *TargetsCommand) Execute([]string) error {
 		},
 	}
 
-	for targetName, targetValues := range flyYAML.Targets {
-		expirationTime := GetExpirationFromString(targetValues.Token)
-
-		row := ui.TableRow{
-			{Contents: string(targetName)},
-			{Contents: targetValues.API},
-			{Contents: targetValues.TeamName},
-			{Contents: expirationTime},
-		}
-
-		table.Data = append(table.Data, row)
+	for _, target := range targets {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: target.Name},
+			{Contents: target.URL},
+			{Contents: target.Team},
+			{Contents: target.Expiry},
+		})
 	}
 
-	sort.Sort(table.Data)
-
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
 
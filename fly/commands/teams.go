@@ -7,14 +7,13 @@ import (
 
 	"strings"
 
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type TeamsCommand struct {
-	Json    bool `long:"json" description:"Print command result as JSON"`
+	ui.OutputFlags
 	Details bool `short:"d" long:"details" description:"Print authentication configuration"`
 }
 
@@ -34,12 +33,8 @@ func (command *TeamsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(teams)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Structured() {
+		return command.Present(os.Stdout, teams)
 	}
 
 	headers := ui.TableRow{
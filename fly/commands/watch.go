@@ -1,19 +1,30 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"sync"
+	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
+const watchPipelineInterval = 2 * time.Second
+
 type WatchCommand struct {
-	Job       flaghelpers.JobFlag `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Watches builds of the given job"`
-	Build     string              `short:"b" long:"build"                                  description:"Watches a specific build"`
-	Timestamp bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+	Job        flaghelpers.JobFlag      `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Watches builds of the given job"`
+	Build      flaghelpers.BuildFlag    `short:"b" long:"build"                                  description:"Watches a specific build"`
+	Pipeline   flaghelpers.PipelineFlag `short:"p" long:"pipeline"    value-name:"PIPELINE"      description:"Watches every new build of the given pipeline"`
+	Step       flaghelpers.StepFlag     `          long:"step"        value-name:"NAME"          description:"Only print the output of the given step"`
+	SinceEvent *int                     `          long:"since-event" value-name:"ID"            description:"Only print the build's events after the given event ID"`
+	Timestamp  bool                     `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
 }
 
 func (command *WatchCommand) Execute(args []string) error {
@@ -27,28 +38,52 @@ func (command *WatchCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Pipeline != "" {
+		if command.Job.JobName != "" || command.Build != "" {
+			return errors.New("--pipeline cannot be combined with --job or --build")
+		}
+
+		if command.SinceEvent != nil {
+			return errors.New("--since-event cannot be combined with --pipeline")
+		}
+
+		return command.watchPipeline(target.Client(), target.Team())
+	}
+
 	var buildId int
 	client := target.Client()
 	if command.Job.JobName != "" || command.Build == "" {
-		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineName)
+		build, err := GetBuild(client, target.Team(), command.Job.JobName, string(command.Build), command.Job.PipelineName)
 		if err != nil {
 			return err
 		}
 		buildId = build.ID
 	} else if command.Build != "" {
-		buildId, err = strconv.Atoi(command.Build)
+		buildId, err = strconv.Atoi(string(command.Build))
 
 		if err != nil {
 			return err
 		}
 	}
 
-	eventSource, err := client.BuildEvents(fmt.Sprintf("%d", buildId))
+	renderOptions, found, err := command.renderOptions(client, buildId)
 	if err != nil {
 		return err
 	}
 
-	renderOptions := eventstream.RenderOptions{ShowTimestamp: command.Timestamp}
+	if !found {
+		return fmt.Errorf("build has no step named '%s'", command.Step)
+	}
+
+	var eventSource concourse.Events
+	if command.SinceEvent != nil {
+		eventSource, err = client.BuildEventsSince(fmt.Sprintf("%d", buildId), *command.SinceEvent)
+	} else {
+		eventSource, err = client.BuildEvents(fmt.Sprintf("%d", buildId))
+	}
+	if err != nil {
+		return err
+	}
 
 	exitCode := eventstream.Render(os.Stdout, eventSource, renderOptions)
 
@@ -58,3 +93,92 @@ func (command *WatchCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (command *WatchCommand) renderOptions(client concourse.Client, buildID int) (eventstream.RenderOptions, bool, error) {
+	options := eventstream.RenderOptions{
+		ShowTimestamp:        command.Timestamp,
+		BuildStatusExitCodes: true,
+	}
+
+	if command.Step == "" {
+		return options, true, nil
+	}
+
+	plan, found, err := client.BuildPlan(buildID)
+	if err != nil {
+		return eventstream.RenderOptions{}, false, err
+	}
+
+	if !found {
+		return eventstream.RenderOptions{}, false, errors.New("build plan not found")
+	}
+
+	options.Origins, err = eventstream.StepOrigins(plan, string(command.Step))
+	if err != nil {
+		return eventstream.RenderOptions{}, false, err
+	}
+
+	return options, len(options.Origins) > 0, nil
+}
+
+func (command *WatchCommand) watchPipeline(client concourse.Client, team concourse.Team) error {
+	var lock sync.Mutex
+
+	seen := map[int]bool{}
+	first := true
+
+	for {
+		builds, _, found, err := team.PipelineBuilds(string(command.Pipeline), concourse.Page{Limit: 100})
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return errors.New("pipeline not found")
+		}
+
+		for i := len(builds) - 1; i >= 0; i-- {
+			build := builds[i]
+			if seen[build.ID] {
+				continue
+			}
+
+			seen[build.ID] = true
+
+			if first && !build.IsRunning() {
+				continue
+			}
+
+			go command.followBuild(client, build, os.Stdout, &lock)
+		}
+
+		first = false
+
+		time.Sleep(watchPipelineInterval)
+	}
+}
+
+func (command *WatchCommand) followBuild(client concourse.Client, build atc.Build, dst io.Writer, lock *sync.Mutex) {
+	out := eventstream.NewPrefixedWriter(dst, lock, fmt.Sprintf("%s/%s | ", build.JobName, build.Name))
+	defer out.Flush()
+
+	renderOptions, found, err := command.renderOptions(client, build.ID)
+	if err != nil {
+		fmt.Fprintf(out, "failed to get build plan: %s\n", err)
+		return
+	}
+
+	if !found {
+		return
+	}
+
+	eventSource, err := client.BuildEvents(fmt.Sprintf("%d", build.ID))
+	if err != nil {
+		fmt.Fprintf(out, "failed to stream events: %s\n", err)
+		return
+	}
+
+	defer eventSource.Close()
+
+	eventstream.Render(out, eventSource, renderOptions)
+}
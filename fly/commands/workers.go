@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
@@ -16,7 +15,7 @@ import (
 
 type WorkersCommand struct {
 	Details bool `short:"d" long:"details" description:"Print additional information for each worker"`
-	Json    bool `long:"json" description:"Print command result as JSON"`
+	ui.OutputFlags
 }
 
 func (command *WorkersCommand) Execute([]string) error {
@@ -35,12 +34,8 @@ func (command *WorkersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(workers)
-		if err != nil {
-			return err
-		}
-		return nil
+	if command.Structured() {
+		return command.Present(os.Stdout, workers)
 	}
 
 	sort.Sort(byWorkerName(workers))
@@ -48,9 +43,12 @@ func (command *WorkersCommand) Execute([]string) error {
 	var runningWorkers []worker
 	var stalledWorkers []worker
 	var outdatedWorkers []worker
+	var cordonedWorkers []worker
 	for _, w := range workers {
 		if w.State == "stalled" {
 			stalledWorkers = append(stalledWorkers, worker{w, false})
+		} else if w.State == "cordoned" {
+			cordonedWorkers = append(cordonedWorkers, worker{w, false})
 		} else {
 			workerVersionCompatible, err := target.IsWorkerVersionCompatible(w.Version)
 			if err != nil {
@@ -67,7 +65,7 @@ func (command *WorkersCommand) Execute([]string) error {
 
 	dst, isTTY := ui.ForTTY(os.Stdout)
 	if !isTTY {
-		return command.tableFor(append(append(runningWorkers, outdatedWorkers...), stalledWorkers...)).Render(os.Stdout, Fly.PrintTableHeaders)
+		return command.tableFor(append(append(append(runningWorkers, outdatedWorkers...), cordonedWorkers...), stalledWorkers...)).Render(os.Stdout, Fly.PrintTableHeaders)
 	}
 
 	err = command.tableFor(runningWorkers).Render(os.Stdout, Fly.PrintTableHeaders)
@@ -92,6 +90,24 @@ func (command *WorkersCommand) Execute([]string) error {
 		}
 	}
 
+	if len(cordonedWorkers) > 0 {
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "the following workers are cordoned and will not be given new containers:")
+		fmt.Fprintln(dst, "")
+
+		err = cordonTableFor(cordonedWorkers).Render(os.Stdout, Fly.PrintTableHeaders)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "these workers can be put back into service by running:")
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, "    "+ui.Embolden("fly -t %s uncordon-worker -w (name)", Fly.Target))
+		fmt.Fprintln(dst, "")
+	}
+
 	if len(stalledWorkers) > 0 {
 		fmt.Fprintln(dst, "")
 		fmt.Fprintln(dst, "")
@@ -129,6 +145,7 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			ui.TableCell{Contents: "garden address", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "baggageclaim url", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "resource types", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "labels", Color: color.New(color.Bold)},
 		)
 	}
 
@@ -154,6 +171,15 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			row = append(row, stringOrDefault(w.GardenAddr))
 			row = append(row, stringOrDefault(w.BaggageclaimURL))
 			row = append(row, stringOrDefault(strings.Join(resourceTypes, ", ")))
+
+			var labels []string
+			for name, value := range w.Labels {
+				labels = append(labels, name+"="+value)
+			}
+
+			sort.Strings(labels)
+
+			row = append(row, stringOrDefault(strings.Join(labels, ", ")))
 		}
 
 		table.Data = append(table.Data, row)
@@ -162,6 +188,28 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 	return table
 }
 
+func cordonTableFor(workers []worker) ui.Table {
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "containers", Color: color.New(color.Bold)},
+			{Contents: "cordoned by", Color: color.New(color.Bold)},
+			{Contents: "reason", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, w := range workers {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: w.Name},
+			{Contents: strconv.Itoa(w.ActiveContainers)},
+			stringOrDefault(w.CordonedBy),
+			stringOrDefault(w.CordonReason),
+		})
+	}
+
+	return table
+}
+
 type byWorkerName []atc.Worker
 
 func (ws byWorkerName) Len() int               { return len(ws) }
@@ -0,0 +1,67 @@
+package eventstream
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// PrefixedWriter prefixes every line written to it, writing only whole lines
+// to the destination so that several builds can share one terminal.
+type PrefixedWriter struct {
+	dst    io.Writer
+	lock   *sync.Mutex
+	prefix string
+	buffer []byte
+}
+
+// NewPrefixedWriter returns a writer which prefixes its lines. Writers sharing
+// a lock never interleave their lines.
+func NewPrefixedWriter(dst io.Writer, lock *sync.Mutex, prefix string) *PrefixedWriter {
+	return &PrefixedWriter{
+		dst:    dst,
+		lock:   lock,
+		prefix: prefix,
+	}
+}
+
+func (w *PrefixedWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+
+	idx := bytes.LastIndexByte(w.buffer, '\n')
+	if idx == -1 {
+		return len(p), nil
+	}
+
+	lines := w.buffer[:idx+1]
+
+	var out bytes.Buffer
+	for len(lines) > 0 {
+		end := bytes.IndexByte(lines, '\n') + 1
+		out.WriteString(w.prefix)
+		out.Write(lines[:end])
+		lines = lines[end:]
+	}
+
+	w.buffer = append([]byte{}, w.buffer[idx+1:]...)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err := w.dst.Write(out.Bytes())
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Flush writes out any trailing partial line.
+func (w *PrefixedWriter) Flush() error {
+	if len(w.buffer) == 0 {
+		return nil
+	}
+
+	_, err := w.Write([]byte("\n"))
+	return err
+}
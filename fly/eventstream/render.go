@@ -5,20 +5,54 @@ import (
 	"io"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 	"github.com/fatih/color"
 )
 
+// Exit codes returned by Render once the build has finished. Unless
+// BuildStatusExitCodes is set, a failed build instead returns the exit status
+// of its last task when that is non-zero.
+const (
+	ExitSucceeded   = 0
+	ExitFailed      = 1
+	ExitErrored     = 2
+	ExitAborted     = 3
+	ExitStreamError = 255
+)
+
 type RenderOptions struct {
 	ShowTimestamp bool
+
+	// Origins limits the rendered step output to the given plan IDs. Build
+	// statuses and errors without an origin are always rendered.
+	Origins []event.OriginID
+
+	// BuildStatusExitCodes makes the exit code depend only on the build's
+	// status, ignoring task exit statuses.
+	BuildStatusExitCodes bool
+}
+
+func (options RenderOptions) includes(origin event.Origin) bool {
+	if len(options.Origins) == 0 || origin.ID == "" {
+		return true
+	}
+
+	for _, id := range options.Origins {
+		if id == origin.ID {
+			return true
+		}
+	}
+
+	return false
 }
 
 func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) int {
 	dstImpl := NewTimestampedWriter(dst, options.ShowTimestamp)
 
-	exitStatus := 0
+	exitStatus := ExitSucceeded
 
 	for {
 		ev, err := src.NextEvent()
@@ -28,10 +62,14 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			} else {
 				dstImpl.SetTimestamp(0)
 				fmt.Fprintf(dstImpl, "failed to parse next event: %s\n", err)
-				return 255
+				return ExitStreamError
 			}
 		}
 
+		if !options.includes(eventOrigin(ev)) {
+			continue
+		}
+
 		switch e := ev.(type) {
 		case event.Log:
 			dstImpl.SetTimestamp(e.Time)
@@ -53,7 +91,9 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			fmt.Fprintf(dstImpl, "\x1b[1mrunning %s\x1b[0m\n", argv)
 
 		case event.FinishTask:
-			exitStatus = e.ExitStatus
+			if !options.BuildStatusExitCodes {
+				exitStatus = e.ExitStatus
+			}
 
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
@@ -72,24 +112,24 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			case "failed":
 				printColor = ui.FailedColor
 
-				if exitStatus == 0 {
-					exitStatus = 1
+				if exitStatus == ExitSucceeded {
+					exitStatus = ExitFailed
 				}
 			case "errored":
 				printColor = ui.ErroredColor
 
-				if exitStatus == 0 {
-					exitStatus = 2
+				if exitStatus == ExitSucceeded {
+					exitStatus = ExitErrored
 				}
 			case "aborted":
 				printColor = ui.AbortedColor
 
-				if exitStatus == 0 {
-					exitStatus = 3
+				if exitStatus == ExitSucceeded {
+					exitStatus = ExitAborted
 				}
 			default:
 				fmt.Fprintf(dstImpl, "unknown status: %s", e.Status)
-				return 255
+				return ExitStreamError
 			}
 
 			printColorFunc := printColor.SprintFunc()
@@ -99,3 +139,22 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		}
 	}
 }
+
+func eventOrigin(ev atc.Event) event.Origin {
+	switch e := ev.(type) {
+	case event.Log:
+		return e.Origin
+	case event.LogV50:
+		return e.Origin
+	case event.InitializeTask:
+		return e.Origin
+	case event.StartTask:
+		return e.Origin
+	case event.FinishTask:
+		return e.Origin
+	case event.Error:
+		return e.Origin
+	default:
+		return event.Origin{}
+	}
+}
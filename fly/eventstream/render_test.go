@@ -174,6 +174,16 @@ var _ = Describe("V1.0 Renderer", func() {
 				Expect(exitStatus).To(Equal(42))
 			})
 
+			Context("when exiting with build status exit codes", func() {
+				BeforeEach(func() {
+					options.BuildStatusExitCodes = true
+				})
+
+				It("exits with the build's exit code", func() {
+					Expect(exitStatus).To(Equal(eventstream.ExitSucceeded))
+				})
+			})
+
 			Context("and time configuration is enabled", func() {
 				BeforeEach(func() {
 					options.ShowTimestamp = true
@@ -186,6 +196,45 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when limited to some origins", func() {
+		BeforeEach(func() {
+			options.Origins = []event.OriginID{"some-step"}
+
+			receivedEvents <- event.Log{
+				Origin:  event.Origin{ID: "some-step"},
+				Payload: "included\n",
+			}
+			receivedEvents <- event.Log{
+				Origin:  event.Origin{ID: "other-step"},
+				Payload: "excluded\n",
+			}
+			receivedEvents <- event.FinishTask{
+				Origin:     event.Origin{ID: "other-step"},
+				ExitStatus: 42,
+			}
+			receivedEvents <- event.Error{
+				Message: "build-level error",
+			}
+			receivedEvents <- event.Status{
+				Status: atc.StatusSucceeded,
+			}
+		})
+
+		It("prints only the events from those origins", func() {
+			Expect(out.Contents()).To(ContainSubstring("included"))
+			Expect(out.Contents()).NotTo(ContainSubstring("excluded"))
+		})
+
+		It("still prints events without an origin", func() {
+			Expect(out.Contents()).To(ContainSubstring("build-level error"))
+			Expect(out.Contents()).To(ContainSubstring("succeeded"))
+		})
+
+		It("ignores the exit statuses of other origins", func() {
+			Expect(exitStatus).To(Equal(0))
+		})
+	})
+
 	Describe("receiving a Status event", func() {
 		Context("with status 'succeeded'", func() {
 			BeforeEach(func() {
@@ -0,0 +1,65 @@
+package eventstream
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// StepOrigins returns the IDs of every step in the build plan with the given
+// name, for use as RenderOptions.Origins. Get and put steps without an
+// explicit name are named after their resource.
+func StepOrigins(plan atc.PublicBuildPlan, step string) ([]event.OriginID, error) {
+	if plan.Plan == nil {
+		return nil, nil
+	}
+
+	var tree interface{}
+	err := json.Unmarshal(*plan.Plan, &tree)
+	if err != nil {
+		return nil, err
+	}
+
+	var origins []event.OriginID
+	collectStepOrigins(tree, step, &origins)
+
+	return origins, nil
+}
+
+func collectStepOrigins(node interface{}, step string, origins *[]event.OriginID) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if id, ok := n["id"].(string); ok && publicStepName(n) == step {
+			*origins = append(*origins, event.OriginID(id))
+		}
+
+		for _, child := range n {
+			collectStepOrigins(child, step, origins)
+		}
+
+	case []interface{}:
+		for _, child := range n {
+			collectStepOrigins(child, step, origins)
+		}
+	}
+}
+
+func publicStepName(node map[string]interface{}) string {
+	for _, key := range []string{"task", "get", "put", "dependent_get"} {
+		step, ok := node[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := step["name"].(string); ok && name != "" {
+			return name
+		}
+
+		if resource, ok := step["resource"].(string); ok {
+			return resource
+		}
+	}
+
+	return ""
+}
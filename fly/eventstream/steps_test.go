@@ -0,0 +1,48 @@
+package eventstream_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/fly/eventstream"
+)
+
+var _ = Describe("StepOrigins", func() {
+	var plan atc.PublicBuildPlan
+
+	BeforeEach(func() {
+		raw := json.RawMessage(`{
+			"id": "do",
+			"do": [
+				{"id": "1", "get": {"type": "git", "resource": "repo"}},
+				{"id": "2", "get": {"type": "git", "name": "other", "resource": "repo"}},
+				{
+					"id": "3",
+					"on_success": {
+						"step": {"id": "4", "task": {"name": "unit", "privileged": false}},
+						"on_success": {"id": "5", "put": {"type": "git", "name": "repo", "resource": "repo"}}
+					}
+				}
+			]
+		}`)
+
+		plan = atc.PublicBuildPlan{Schema: "exec.v2", Plan: &raw}
+	})
+
+	It("finds tasks by name", func() {
+		Expect(eventstream.StepOrigins(plan, "unit")).To(Equal([]event.OriginID{"4"}))
+	})
+
+	It("finds gets and puts by name, defaulting to their resource", func() {
+		Expect(eventstream.StepOrigins(plan, "repo")).To(ConsistOf(event.OriginID("1"), event.OriginID("5")))
+		Expect(eventstream.StepOrigins(plan, "other")).To(Equal([]event.OriginID{"2"}))
+	})
+
+	It("finds nothing for unknown steps", func() {
+		Expect(eventstream.StepOrigins(plan, "bogus")).To(BeEmpty())
+	})
+})
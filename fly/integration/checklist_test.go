@@ -112,6 +112,26 @@ some-orphaned-job: concourse.check %s main some-pipeline some-orphaned-job
 				})
 			})
 
+			Context("when --json is given", func() {
+				It("prints each job's check as json", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "checklist", "-p", "some-pipeline", "--json")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(fmt.Sprintf(`[
+						{"group": "some-group", "job": "job-1", "command": "concourse.check %[1]s main some-pipeline job-1"},
+						{"group": "some-group", "job": "job-2", "command": "concourse.check %[1]s main some-pipeline job-2"},
+						{"group": "some-other-group", "job": "job-3", "command": "concourse.check %[1]s main some-pipeline job-3"},
+						{"group": "some-other-group", "job": "job-4", "command": "concourse.check %[1]s main some-pipeline job-4"},
+						{"group": "misc", "job": "some-orphaned-job", "command": "concourse.check %[1]s main some-pipeline some-orphaned-job"}
+					]`, atcServer.URL())))
+				})
+			})
+
 			Context("when there are no groups", func() {
 				BeforeEach(func() {
 					config = atc.Config{
@@ -0,0 +1,192 @@
+package integration_test
+
+import (
+	"os"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("completion", func() {
+		for _, shell := range []string{"bash", "zsh", "fish"} {
+			shell := shell
+
+			It("prints a completion script for "+shell, func() {
+				sess, err := gexec.Start(exec.Command(flyPath, "completion", "--shell", shell), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("GO_FLAGS_COMPLETION=1"))
+			})
+		}
+
+		It("rejects unknown shells", func() {
+			sess, err := gexec.Start(exec.Command(flyPath, "completion", "--shell", "tcsh"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+	})
+
+	Describe("completing flags", func() {
+		complete := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName}, args...)...)
+			flyCmd.Env = append(os.Environ(), "GO_FLAGS_COMPLETION=1")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			return sess
+		}
+
+		Context("pipelines", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{Name: "some-pipeline"},
+							{Name: "other-pipeline"},
+						}),
+					),
+				)
+			})
+
+			It("caches the names fetched from the target", func() {
+				sess := complete("jobs", "-p", "some-")
+				Expect(sess.Out.Contents()).To(ContainSubstring("some-pipeline"))
+				Expect(sess.Out.Contents()).NotTo(ContainSubstring("other-pipeline"))
+
+				requests := len(atcServer.ReceivedRequests())
+
+				sess = complete("resources", "-p", "other-")
+				Expect(sess.Out.Contents()).To(ContainSubstring("other-pipeline"))
+
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(requests))
+			})
+		})
+
+		Context("resources", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Resource{
+							{Name: "some-resource"},
+							{Name: "other-resource"},
+						}),
+					),
+				)
+			})
+
+			It("returns the matching resources of the pipeline", func() {
+				sess := complete("check-resource", "-r", "some-pipeline/some-")
+				Expect(sess.Out.Contents()).To(ContainSubstring("some-pipeline/some-resource"))
+				Expect(sess.Out.Contents()).NotTo(ContainSubstring("other-resource"))
+			})
+		})
+
+		Context("builds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Build{
+							{ID: 12, Name: "12"},
+							{ID: 11, Name: "11"},
+							{ID: 2, Name: "2"},
+						}),
+					),
+				)
+			})
+
+			It("returns the matching builds of the job given with --job", func() {
+				sess := complete("hijack", "-j", "some-pipeline/some-job", "-b", "1")
+				Expect(string(sess.Out.Contents())).To(Equal("11\n12\n"))
+			})
+		})
+
+		Context("steps", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.RespondWithJSONEncoded(200, atc.ConfigResponse{
+							Config: &atc.Config{
+								Jobs: atc.JobConfigs{
+									{
+										Name: "some-job",
+										Plan: atc.PlanSequence{
+											{Get: "some-input"},
+											{Task: "unit", Ensure: &atc.PlanConfig{Task: "cleanup"}},
+											{Put: "some-output"},
+										},
+									},
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			It("returns the matching steps of the job given with --job", func() {
+				sess := complete("watch", "--job=some-pipeline/some-job", "--step", "")
+				Expect(sess.Out.Contents()).To(ContainSubstring("cleanup"))
+				Expect(sess.Out.Contents()).To(ContainSubstring("some-input"))
+				Expect(sess.Out.Contents()).To(ContainSubstring("some-output"))
+				Expect(sess.Out.Contents()).To(ContainSubstring("unit"))
+			})
+		})
+
+		Context("workers", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Worker{
+							{Name: "worker-a"},
+							{Name: "other-worker"},
+						}),
+					),
+				)
+			})
+
+			It("returns the matching workers", func() {
+				sess := complete("land-worker", "-w", "work")
+				Expect(sess.Out.Contents()).To(ContainSubstring("worker-a"))
+				Expect(sess.Out.Contents()).NotTo(ContainSubstring("other-worker"))
+			})
+		})
+
+		Context("teams", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Team{
+							{Name: "main"},
+							{Name: "other-team"},
+						}),
+					),
+				)
+			})
+
+			It("returns the matching teams", func() {
+				sess := complete("destroy-team", "-n", "oth")
+				Expect(sess.Out.Contents()).To(ContainSubstring("other-team"))
+				Expect(sess.Out.Contents()).NotTo(ContainSubstring("main"))
+			})
+		})
+	})
+})
@@ -0,0 +1,181 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("cp", func() {
+		var (
+			tmpDir string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "fly-cp")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		containersHandler := func() http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers", "build_name=3&job_name=some-job&pipeline_name=some-pipeline&step_name=some-step"),
+				ghttp.RespondWithJSONEncoded(200, []atc.Container{
+					{
+						ID:               "container-id-1",
+						State:            atc.ContainerStateCreated,
+						BuildID:          3,
+						Type:             "task",
+						StepName:         "some-step",
+						User:             "some-user",
+						WorkingDirectory: "/tmp/build/some-guid",
+					},
+				}),
+			)
+		}
+
+		Context("when neither path is in the container", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cp", "-j", "some-pipeline/some-job", "-b", "3", "-s", "some-step", "some-file", "some-dir")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("exactly one of SOURCE and DESTINATION must be a container path"))
+			})
+		})
+
+		Context("when copying out of the container", func() {
+			BeforeEach(func() {
+				tarball := new(bytes.Buffer)
+				tarWriter := tar.NewWriter(tarball)
+
+				err := tarWriter.WriteHeader(&tar.Header{
+					Name: "core",
+					Mode: 0644,
+					Size: int64(len("some-core-dump")),
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = tarWriter.Write([]byte("some-core-dump"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tarWriter.Close()).To(Succeed())
+
+				atcServer.AppendHandlers(
+					containersHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers/container-id-1/files", "path=%2Ftmp%2Fbuild%2Fsome-guid%2Fcore&user=some-user"),
+						ghttp.RespondWith(200, tarball.Bytes()),
+					),
+				)
+			})
+
+			It("extracts the files into the destination, resolving relative paths against the working directory", func() {
+				dst := filepath.Join(tmpDir, "out")
+
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cp", "-j", "some-pipeline/some-job", "-b", "3", "-s", "some-step", ":core", dst)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("copied :/tmp/build/some-guid/core to " + dst))
+
+				contents, err := ioutil.ReadFile(filepath.Join(dst, "core"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-core-dump"))
+			})
+		})
+
+		Context("when copying into the container", func() {
+			var streamedIn map[string]string
+
+			BeforeEach(func() {
+				streamedIn = map[string]string{}
+
+				err := ioutil.WriteFile(filepath.Join(tmpDir, "some-file"), []byte("some-contents"), 0644)
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					containersHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/containers/container-id-1/files", "path=%2Fopt%2Fdebug&user=some-user"),
+						ghttp.VerifyHeaderKV("Content-Type", "application/x-tar"),
+						func(w http.ResponseWriter, r *http.Request) {
+							tarReader := tar.NewReader(r.Body)
+							for {
+								header, err := tarReader.Next()
+								if err == io.EOF {
+									break
+								}
+								Expect(err).NotTo(HaveOccurred())
+
+								contents, err := ioutil.ReadAll(tarReader)
+								Expect(err).NotTo(HaveOccurred())
+
+								streamedIn[header.Name] = string(contents)
+							}
+						},
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("streams the files into the container", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cp", "-j", "some-pipeline/some-job", "-b", "3", "-s", "some-step", filepath.Join(tmpDir, "some-file"), ":/opt/debug")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("copied .* to :/opt/debug"))
+
+				Expect(streamedIn).To(HaveKeyWithValue(ContainSubstring("some-file"), "some-contents"))
+			})
+		})
+
+		Context("when the container is gone", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					containersHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers/container-id-1/files"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cp", "-j", "some-pipeline/some-job", "-b", "3", "-s", "some-step", ":core", tmpDir)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("container 'container-id-1' no longer exists"))
+			})
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("CordonWorker", func() {
+	It("cordons the worker with the given reason", func() {
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+				ghttp.VerifyJSONRepresenting(atc.CordonWorkerRequest{Reason: "disk is failing"}),
+				ghttp.RespondWith(http.StatusOK, nil),
+			),
+		)
+
+		flyCmd := exec.Command(flyPath, "-t", targetName, "cordon-worker", "-w", "some-worker", "-r", "disk is failing")
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(sess).Should(gexec.Exit(0))
+		Expect(sess.Out).To(gbytes.Say("cordoned 'some-worker'"))
+	})
+
+	Context("when the worker is not running", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+					ghttp.RespondWith(http.StatusConflict, nil),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "cordon-worker", "-w", "some-worker")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("worker 'some-worker' is not running and cannot be cordoned"))
+		})
+	})
+})
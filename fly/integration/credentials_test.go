@@ -0,0 +1,269 @@
+package integration_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var _ = Describe("Credentials", func() {
+	loadFlyrc := func() map[rc.TargetName]rc.TargetProps {
+		contents, err := ioutil.ReadFile(filepath.Join(homeDir, ".flyrc"))
+		Expect(err).NotTo(HaveOccurred())
+
+		var flyrc struct {
+			Targets map[rc.TargetName]rc.TargetProps
+		}
+		Expect(yaml.Unmarshal(contents, &flyrc)).To(Succeed())
+
+		return flyrc.Targets
+	}
+
+	// installCredentialHelper puts a fly-credential-fake helper on the PATH of
+	// the returned environment; it keeps the token in the returned file
+	installCredentialHelper := func() ([]string, string) {
+		if runtime.GOOS == "windows" {
+			Skip("the fake credential helper is a shell script")
+		}
+
+		binDir := filepath.Join(homeDir, "bin")
+		Expect(os.Mkdir(binDir, 0755)).To(Succeed())
+
+		storePath := filepath.Join(homeDir, "store.json")
+
+		helper := `#!/bin/sh
+case "$1" in
+get) cat ` + storePath + ` 2>/dev/null ;;
+store) sed -n 's/.*"token":\({[^}]*}\).*/\1/p' > ` + storePath + ` ;;
+erase) rm -f ` + storePath + ` ;;
+esac
+`
+		err := ioutil.WriteFile(filepath.Join(binDir, "fly-credential-fake"), []byte(helper), 0755)
+		Expect(err).NotTo(HaveOccurred())
+
+		env := append(os.Environ(), "PATH="+binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+		return env, storePath
+	}
+
+	pipelinesHandler := func(authorization string) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+			ghttp.VerifyHeaderKV("Authorization", authorization),
+			ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+				{Name: "pipeline-1"},
+			}),
+		)
+	}
+
+	Describe("targets described by the environment", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(pipelinesHandler("Bearer env-token"))
+		})
+
+		It("uses them when no target is given", func() {
+			flyCmd := exec.Command(flyPath, "pipelines")
+			flyCmd.Env = append(
+				os.Environ(),
+				"FLY_TARGET_URL="+atcServer.URL(),
+				"FLY_TOKEN=Bearer env-token",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("pipeline-1"))
+
+			Expect(loadFlyrc()).To(HaveLen(1))
+		})
+	})
+
+	Describe("refresh tokens", func() {
+		tokenHandler := func(scope string) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/sky/token"),
+				ghttp.VerifyFormKV("scope", scope),
+				ghttp.RespondWithJSONEncoded(200, map[string]string{
+					"token_type":    "Bearer",
+					"access_token":  "some-token",
+					"refresh_token": "some-refresh-token",
+				}),
+			)
+		}
+
+		Context("when logging in without a credential helper", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(tokenHandler("openid profile email federated:id groups"))
+			})
+
+			It("does not ask for or save a refresh token", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "login", "-u", "user", "-p", "pass")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say("this token will not be refreshed automatically"))
+
+				Expect(loadFlyrc()[targetName].Token).To(Equal(&rc.TargetToken{
+					Type:  "Bearer",
+					Value: "some-token",
+				}))
+			})
+		})
+
+		Context("when logging in with a credential helper", func() {
+			var (
+				env       []string
+				storePath string
+			)
+
+			BeforeEach(func() {
+				env, storePath = installCredentialHelper()
+
+				atcServer.AppendHandlers(tokenHandler("openid profile email federated:id groups offline_access"))
+			})
+
+			It("keeps the refresh token with the helper", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "login", "-u", "user", "-p", "pass", "--credential-helper", "fake")
+				flyCmd.Env = env
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).NotTo(gbytes.Say("will not be refreshed"))
+
+				Expect(loadFlyrc()[targetName].Token).To(BeNil())
+
+				stored, err := ioutil.ReadFile(storePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored).To(MatchJSON(`{"type":"Bearer","value":"some-token","refresh_token":"some-refresh-token"}`))
+			})
+		})
+
+		Context("when logging in through the browser with a credential helper", func() {
+			var (
+				env       []string
+				storePath string
+			)
+
+			BeforeEach(func() {
+				env, storePath = installCredentialHelper()
+			})
+
+			It("has no refresh token to keep and says so", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "login", "--credential-helper", "fake")
+				flyCmd.Env = env
+
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("or enter token manually"))
+
+				_, err = fmt.Fprintf(stdin, "Bearer some-token\n")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stdin.Close()).To(Succeed())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("this token will not be refreshed automatically"))
+
+				stored, err := ioutil.ReadFile(storePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored).To(MatchJSON(`{"type":"Bearer","value":"some-token"}`))
+			})
+		})
+	})
+
+	Describe("credential helpers", func() {
+		var (
+			env       []string
+			storePath string
+		)
+
+		BeforeEach(func() {
+			env, storePath = installCredentialHelper()
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/sky/token"),
+					ghttp.RespondWithJSONEncoded(200, map[string]string{
+						"token_type":   "Bearer",
+						"access_token": "helper-token",
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "login", "-u", "user", "-p", "pass", "--credential-helper", "fake")
+			flyCmd.Env = env
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+
+		It("stores the token with the helper instead of in the flyrc", func() {
+			target := loadFlyrc()[targetName]
+			Expect(target.CredentialHelper).To(Equal("fake"))
+			Expect(target.Token).To(BeNil())
+
+			stored, err := ioutil.ReadFile(storePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored).To(MatchJSON(`{"type":"Bearer","value":"helper-token"}`))
+		})
+
+		It("uses the token from the helper", func() {
+			atcServer.AppendHandlers(
+				infoHandler(),
+				pipelinesHandler("Bearer helper-token"),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "pipelines")
+			flyCmd.Env = env
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("pipeline-1"))
+		})
+
+		It("erases the token on logout", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "logout")
+			flyCmd.Env = env
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(storePath).NotTo(BeAnExistingFile())
+		})
+	})
+})
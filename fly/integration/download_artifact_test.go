@@ -0,0 +1,142 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("download-artifact", func() {
+		var (
+			outputDir string
+			args      []string
+		)
+
+		tarballHandler := func(w http.ResponseWriter, req *http.Request) {
+			gw := gzip.NewWriter(w)
+			tw := tar.NewWriter(gw)
+
+			contents := []byte("some-contents")
+
+			err := tw.WriteHeader(&tar.Header{
+				Name: "some-file",
+				Mode: 0644,
+				Size: int64(len(contents)),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = tw.Write(contents)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tw.Close()).To(Succeed())
+			Expect(gw.Close()).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			var err error
+			outputDir, err = ioutil.TempDir("", "fly-artifact")
+			Expect(err).NotTo(HaveOccurred())
+
+			args = []string{"-t", targetName, "download-artifact", "-j", "some-pipeline/some-job", "-s", "some-task", "-a", "some-output", "-o", outputDir}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(outputDir)
+		})
+
+		Context("with a specific build", func() {
+			BeforeEach(func() {
+				args = append(args, "-b", "3")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3"),
+						ghttp.RespondWithJSONEncoded(200, atc.Build{ID: 42, Name: "3", Status: "failed"}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/42/steps/some-task/artifacts/some-output"),
+						tarballHandler,
+					),
+				)
+			})
+
+			It("extracts the artifact into the output directory", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("downloaded 'some-output' from some-pipeline/some-job #3"))
+
+				Expect(ioutil.ReadFile(filepath.Join(outputDir, "some-file"))).To(Equal([]byte("some-contents")))
+			})
+		})
+
+		Context("without a build", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job"),
+						ghttp.RespondWithJSONEncoded(200, atc.Job{
+							NextBuild:     &atc.Build{ID: 43, Name: "4", Status: "started"},
+							FinishedBuild: &atc.Build{ID: 42, Name: "3", Status: "succeeded"},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/42/steps/some-task/artifacts/some-output"),
+						tarballHandler,
+					),
+				)
+			})
+
+			It("downloads from the latest finished build", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(ioutil.ReadFile(filepath.Join(outputDir, "some-file"))).To(Equal([]byte("some-contents")))
+			})
+		})
+
+		Context("when the artifact no longer exists", func() {
+			BeforeEach(func() {
+				args = append(args, "-b", "3")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3"),
+						ghttp.RespondWithJSONEncoded(200, atc.Build{ID: 42, Name: "3", Status: "failed"}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/42/steps/some-task/artifacts/some-output"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("artifact 'some-output' of step 'some-task' no longer exists on any worker"))
+			})
+		})
+	})
+})
@@ -0,0 +1,318 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --job", func() {
+		var (
+			buildDir string
+
+			taskConfig atc.TaskConfig
+			config     atc.Config
+
+			streaming chan struct{}
+			events    chan atc.Event
+			uploading chan struct{}
+
+			expectedPlan atc.Plan
+		)
+
+		BeforeEach(func() {
+			var err error
+			buildDir, err = ioutil.TempDir("", "fly-build-dir")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(buildDir, "some-file"), []byte("blob"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskConfig = atc.TaskConfig{
+				Platform: "some-platform",
+				ImageResource: &atc.ImageResource{
+					Type:   "registry-image",
+					Source: atc.Source{"repository": "ubuntu"},
+				},
+				Inputs: []atc.TaskInputConfig{
+					{Name: "some-input"},
+					{Name: "some-other-input"},
+				},
+				Outputs: []atc.TaskOutputConfig{
+					{Name: "some-output"},
+				},
+				Run: atc.TaskRunConfig{
+					Path: "find",
+					Args: []string{"."},
+				},
+			}
+
+			config = atc.Config{
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "some-resource",
+						Type:   "git",
+						Source: atc.Source{"uri": "https://internet.com"},
+					},
+					{
+						Name:   "some-other-resource",
+						Type:   "git",
+						Source: atc.Source{"uri": "https://example.com"},
+					},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						Plan: atc.PlanSequence{
+							{Get: "some-input", Resource: "some-resource"},
+							{Get: "some-other-input", Resource: "some-other-resource"},
+							{Task: "some-task", TaskConfig: &taskConfig},
+							{Put: "some-resource", Params: atc.Params{"repository": "some-output"}},
+						},
+					},
+				},
+			}
+
+			streaming = make(chan struct{})
+			events = make(chan atc.Event)
+			uploading = make(chan struct{})
+
+			planFactory := atc.NewPlanFactory(0)
+
+			expectedPlan = planFactory.NewPlan(atc.EnsurePlan{
+				Step: planFactory.NewPlan(atc.DoPlan{
+					planFactory.NewPlan(atc.UserArtifactPlan{
+						Name: "some-input",
+					}),
+					planFactory.NewPlan(atc.GetPlan{
+						Name:     "some-other-input",
+						Type:     "git",
+						Resource: "some-other-resource",
+						Source:   atc.Source{"uri": "https://example.com"},
+						Version:  &atc.Version{"some": "other-version"},
+					}),
+					planFactory.NewPlan(atc.TaskPlan{
+						Name:   "some-task",
+						Config: &taskConfig,
+					}),
+					planFactory.NewPlan(atc.AggregatePlan{}),
+				}),
+				Next: planFactory.NewPlan(atc.AggregatePlan{
+					planFactory.NewPlan(atc.ArtifactOutputPlan{
+						Name: "some-output",
+					}),
+				}),
+			})
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(buildDir)
+		})
+
+		JustBeforeEach(func() {
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/config",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: &config}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/inputs",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.BuildInput{
+					{
+						Name:     "some-input",
+						Type:     "git",
+						Resource: "some-resource",
+						Source:   atc.Source{"uri": "https://internet.com"},
+						Version:  atc.Version{"some": "version"},
+					},
+					{
+						Name:     "some-other-input",
+						Type:     "git",
+						Resource: "some-other-resource",
+						Source:   atc.Source{"uri": "https://example.com"},
+						Version:  atc.Version{"some": "other-version"},
+					},
+				}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/resource-types",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+			)
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds",
+				ghttp.CombineHandlers(
+					VerifyPlan(expectedPlan),
+					ghttp.RespondWith(201, `{"id":128}`),
+				),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/events",
+				func(w http.ResponseWriter, r *http.Request) {
+					flusher := w.(http.Flusher)
+
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+
+					flusher.Flush()
+
+					close(streaming)
+
+					id := 0
+
+					for e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						err = sse.Event{
+							ID:   fmt.Sprintf("%d", id),
+							Name: "event",
+							Data: payload,
+						}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+
+						flusher.Flush()
+
+						id++
+					}
+
+					err := sse.Event{
+						Name: "end",
+					}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+			atcServer.RouteToHandler("PUT", regexp.MustCompile(`/api/v1/builds/128/plan/.*/input`),
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, req *http.Request) {
+						close(uploading)
+					},
+					ghttp.RespondWith(200, ""),
+				),
+			)
+			atcServer.RouteToHandler("GET", regexp.MustCompile(`/api/v1/builds/128/plan/.*/output`),
+				ghttp.RespondWith(404, ""),
+			)
+		})
+
+		It("executes the job's steps with local inputs, skipping puts", func() {
+			flyCmd := exec.Command(
+				flyPath, "-t", targetName, "e",
+				"--job", "some-pipeline/some-job",
+				"--input", fmt.Sprintf("some-input=%s", buildDir),
+				"--output", fmt.Sprintf("some-output=%s", filepath.Join(buildDir, "out")),
+				"--skip-puts",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+			Eventually(uploading).Should(BeClosed())
+
+			events <- event.Log{Payload: "sup"}
+			close(events)
+
+			Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(0))
+		})
+
+		It("treats --inputs-from without --config as --job", func() {
+			flyCmd := exec.Command(
+				flyPath, "-t", targetName, "e",
+				"-j", "some-pipeline/some-job",
+				"-i", fmt.Sprintf("some-input=%s", buildDir),
+				"-o", fmt.Sprintf("some-output=%s", filepath.Join(buildDir, "out")),
+				"--skip-puts",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			close(events)
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(0))
+		})
+
+		Context("when an input is not one of the job's gets", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/some-job",
+					"--input", fmt.Sprintf("bogus-input=%s", buildDir),
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("unknown input `bogus-input`"))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/bogus-job",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("job some-pipeline/bogus-job not found"))
+			})
+		})
+
+		Context("when combined with --config", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/some-job",
+					"--config", filepath.Join(buildDir, "some-file"),
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--config and --job cannot be combined"))
+			})
+		})
+
+		Context("when given with -j and combined with --steps", func() {
+			It("errors naming both flags", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"-j", "some-pipeline/some-job",
+					"--steps", filepath.Join(buildDir, "some-file"),
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--inputs-from and --steps cannot be combined"))
+			})
+		})
+	})
+})
@@ -0,0 +1,273 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --steps", func() {
+		var (
+			buildDir  string
+			stepsPath string
+
+			streaming chan struct{}
+			events    chan atc.Event
+			uploading chan struct{}
+
+			expectedPlan atc.Plan
+		)
+
+		BeforeEach(func() {
+			var err error
+			buildDir, err = ioutil.TempDir("", "fly-build-dir")
+			Expect(err).NotTo(HaveOccurred())
+
+			stepsPath = filepath.Join(buildDir, "steps.yml")
+
+			err = ioutil.WriteFile(stepsPath, []byte(`---
+- task: build
+  tags: [some-tag]
+  config:
+    platform: some-platform
+    image_resource:
+      type: registry-image
+      source: {repository: ubuntu}
+    inputs:
+    - name: source
+    outputs:
+    - name: built
+    run:
+      path: make
+      args: [((target))]
+- task: test
+  config:
+    platform: some-platform
+    image_resource:
+      type: registry-image
+      source: {repository: ubuntu}
+    inputs:
+    - name: built
+    outputs:
+    - name: results
+    run:
+      path: make
+      args: [test]
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			streaming = make(chan struct{})
+			events = make(chan atc.Event)
+			uploading = make(chan struct{})
+
+			planFactory := atc.NewPlanFactory(0)
+
+			imageResource := &atc.ImageResource{
+				Type:   "registry-image",
+				Source: atc.Source{"repository": "ubuntu"},
+			}
+
+			expectedPlan = planFactory.NewPlan(atc.EnsurePlan{
+				Step: planFactory.NewPlan(atc.DoPlan{
+					planFactory.NewPlan(atc.AggregatePlan{
+						planFactory.NewPlan(atc.UserArtifactPlan{
+							Name: "source",
+						}),
+					}),
+					planFactory.NewPlan(atc.DoPlan{
+						planFactory.NewPlan(atc.TaskPlan{
+							Name: "build",
+							Tags: atc.Tags{"some-tag"},
+							Config: &atc.TaskConfig{
+								Platform:      "some-platform",
+								ImageResource: imageResource,
+								Inputs:        []atc.TaskInputConfig{{Name: "source"}},
+								Outputs:       []atc.TaskOutputConfig{{Name: "built"}},
+								Run:           atc.TaskRunConfig{Path: "make", Args: []string{"all"}},
+							},
+						}),
+						planFactory.NewPlan(atc.TaskPlan{
+							Name: "test",
+							Tags: atc.Tags{"other-tag"},
+							Config: &atc.TaskConfig{
+								Platform:      "some-platform",
+								ImageResource: imageResource,
+								Inputs:        []atc.TaskInputConfig{{Name: "built"}},
+								Outputs:       []atc.TaskOutputConfig{{Name: "results"}},
+								Run:           atc.TaskRunConfig{Path: "make", Args: []string{"test"}},
+							},
+						}),
+					}),
+				}),
+				Next: planFactory.NewPlan(atc.AggregatePlan{
+					planFactory.NewPlan(atc.ArtifactOutputPlan{
+						Name: "results",
+					}),
+				}),
+			})
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(buildDir)
+		})
+
+		JustBeforeEach(func() {
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/builds",
+				ghttp.CombineHandlers(
+					VerifyPlan(expectedPlan),
+					ghttp.RespondWith(201, `{"id":128}`),
+				),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/events",
+				func(w http.ResponseWriter, r *http.Request) {
+					flusher := w.(http.Flusher)
+
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+
+					flusher.Flush()
+
+					close(streaming)
+
+					id := 0
+
+					for e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						err = sse.Event{
+							ID:   fmt.Sprintf("%d", id),
+							Name: "event",
+							Data: payload,
+						}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+
+						flusher.Flush()
+
+						id++
+					}
+
+					err := sse.Event{
+						Name: "end",
+					}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+			atcServer.RouteToHandler("PUT", regexp.MustCompile(`/api/v1/builds/128/plan/.*/input`),
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, req *http.Request) {
+						close(uploading)
+					},
+					ghttp.RespondWith(200, ""),
+				),
+			)
+			atcServer.RouteToHandler("GET", regexp.MustCompile(`/api/v1/builds/128/plan/.*/output`),
+				ghttp.RespondWith(404, ""),
+			)
+		})
+
+		It("executes the steps in order as a one-off build, sharing artifacts between them", func() {
+			flyCmd := exec.Command(
+				flyPath, "-t", targetName, "e",
+				"--steps", stepsPath,
+				"--input", fmt.Sprintf("source=%s", buildDir),
+				"--output", fmt.Sprintf("results=%s", filepath.Join(buildDir, "out")),
+				"--tag", "other-tag",
+				"-v", "target=all",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+			Eventually(uploading).Should(BeClosed())
+
+			events <- event.Log{Payload: "sup"}
+			close(events)
+
+			Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(0))
+		})
+
+		Context("when the steps include a get", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(stepsPath, []byte(`---
+- get: some-resource
+`), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--steps", stepsPath,
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("step `some-resource` is a get step; only tasks can be executed"))
+			})
+		})
+
+		Context("when a task has neither a config nor a file", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(stepsPath, []byte(`---
+- task: build
+`), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--steps", stepsPath,
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("task `build` must specify either a config or a file"))
+			})
+		})
+
+		Context("when combined with --config", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--steps", stepsPath,
+					"--config", stepsPath,
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--config and --steps cannot be combined"))
+			})
+		})
+	})
+})
@@ -136,6 +136,49 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when --yaml is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--yaml")
+				})
+
+				It("prints response in yaml as stdout, with the json field names", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say(`- finished_build:`))
+					Expect(sess.Out).To(gbytes.Say(`name: job-1`))
+				})
+			})
+
+			Context("when --format is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--format", "{{.Name}} {{.Paused}}")
+				})
+
+				It("prints each job with the template", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(string(sess.Out.Contents())).To(Equal("job-1 false\njob-2 true\njob-3 false\n"))
+				})
+			})
+
+			Context("when both --json and --yaml are given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json", "--yaml")
+				})
+
+				It("errors", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("only one of --json, --yaml or --format may be specified"))
+				})
+			})
+
 			It("shows the pipeline's jobs", func() {
 				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,97 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("LandWorker", func() {
+	BeforeEach(func() {
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land"),
+				ghttp.RespondWith(http.StatusOK, nil),
+			),
+		)
+	})
+
+	It("lands the worker", func() {
+		flyCmd := exec.Command(flyPath, "-t", targetName, "land-worker", "-w", "some-worker")
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(sess).Should(gexec.Exit(0))
+		Expect(sess.Out).To(gbytes.Say("landed 'some-worker'"))
+	})
+
+	Context("when waiting", func() {
+		workersInState := func(state string) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/workers"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Worker{
+					{Name: "other-worker", State: "landed"},
+					{Name: "some-worker", State: state},
+				}),
+			)
+		}
+
+		runningBuilds := func(ids ...int) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/builds"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, ids),
+			)
+		}
+
+		It("prints the remaining builds until the worker lands", func() {
+			atcServer.AppendHandlers(
+				workersInState("landing"),
+				runningBuilds(1, 2),
+				workersInState("landing"),
+				runningBuilds(2),
+				workersInState("landing"),
+				runningBuilds(),
+				workersInState("landed"),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "land-worker", "-w", "some-worker", "--wait", "--wait-interval", "10ms")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("waiting for builds: 1, 2"))
+			Expect(sess.Out).To(gbytes.Say("waiting for builds: 2"))
+			Expect(sess.Out).To(gbytes.Say("all builds finished; waiting for worker to land"))
+			Expect(sess.Out).To(gbytes.Say("landed 'some-worker'"))
+		})
+
+		Context("when the worker disappears", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Worker{}),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "land-worker", "-w", "some-worker", "--wait")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("worker 'some-worker' disappeared while landing"))
+			})
+		})
+	})
+})
@@ -0,0 +1,126 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("lint-pipeline", func() {
+		It("reports errors and exits 1", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"lint-pipeline",
+				"-c", "fixtures/testConfigLint.yml",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gbytes.Say("error"))
+			Eventually(sess).Should(gbytes.Say("plaintext-secret"))
+			Eventually(sess).Should(gbytes.Say(`resources\.some-bucket\.source\.secret_access_key`))
+			Eventually(sess.Err).Should(gbytes.Say("configuration has problems"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Out.Contents()).ToNot(ContainSubstring("private_key"))
+			Expect(sess.Out.Contents()).ToNot(ContainSubstring("unused-resource"))
+		})
+
+		It("reports warnings without failing", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"lint-pipeline",
+				"-c", "fixtures/testConfigLintWarning.yml",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gbytes.Say("unpinned-image"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+
+		It("fails on warnings with --strict", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"lint-pipeline",
+				"-c", "fixtures/testConfigLintWarning.yml",
+				"--strict",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+
+		It("prints the findings as JSON with --json", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"lint-pipeline",
+				"-c", "fixtures/testConfigLintWarning.yml",
+				"--json",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(sess.Out.Contents()).To(MatchJSON(`[
+				{
+					"rule": "unpinned-image",
+					"severity": "warning",
+					"location": "jobs.some-job.plan[1].task.some-task",
+					"message": "image_resource does not pin a version, and will use whatever is latest"
+				}
+			]`))
+		})
+
+		It("prints a SARIF log with --sarif", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"lint-pipeline",
+				"-c", "fixtures/testConfigLintWarning.yml",
+				"--sarif",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			var log struct {
+				Version string `json:"version"`
+				Runs    []struct {
+					Results []struct {
+						RuleID string `json:"ruleId"`
+						Level  string `json:"level"`
+					} `json:"results"`
+				} `json:"runs"`
+			}
+
+			err = json.Unmarshal(sess.Out.Contents(), &log)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(log.Version).To(Equal("2.1.0"))
+			Expect(log.Runs).To(HaveLen(1))
+			Expect(log.Runs[0].Results).To(HaveLen(1))
+			Expect(log.Runs[0].Results[0].RuleID).To(Equal("unpinned-image"))
+			Expect(log.Runs[0].Results[0].Level).To(Equal("warning"))
+		})
+	})
+})
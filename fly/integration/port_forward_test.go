@@ -0,0 +1,121 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("port-forward", func() {
+		upgrader := websocket.Upgrader{}
+
+		Context("when the ports are invalid", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "port-forward", "-s", "some-step", "some-port")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("invalid local port: 'some-port'"))
+			})
+		})
+
+		Context("when a connection is made to the local port", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Build{
+							{ID: 3, Name: "3", Status: "started"},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers", "build_id=3&step_name=some-step"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Container{
+							{ID: "container-id-1", State: atc.ContainerStateCreated, BuildID: 3, Type: "task", StepName: "some-step", User: "some-user", WorkingDirectory: "/tmp/build/some-guid"},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers/container-id-1/hijack"),
+						func(w http.ResponseWriter, r *http.Request) {
+							defer GinkgoRecover()
+
+							conn, err := upgrader.Upgrade(w, r, nil)
+							Expect(err).NotTo(HaveOccurred())
+
+							defer conn.Close()
+
+							var processSpec atc.HijackProcessSpec
+							err = conn.ReadJSON(&processSpec)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(processSpec.Path).To(Equal("sh"))
+							Expect(processSpec.Args).To(HaveLen(3))
+							Expect(processSpec.Args[2]).To(Equal("8080"))
+							Expect(processSpec.User).To(Equal("some-user"))
+							Expect(processSpec.Dir).To(Equal("/tmp/build/some-guid"))
+
+							var input atc.HijackInput
+							err = conn.ReadJSON(&input)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(input.Stdin).To(Equal([]byte("ping")))
+
+							err = conn.WriteJSON(atc.HijackOutput{
+								Stdout: []byte("pong"),
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							exitStatus := 0
+							err = conn.WriteJSON(atc.HijackOutput{
+								ExitStatus: &exitStatus,
+							})
+							Expect(err).NotTo(HaveOccurred())
+						},
+					),
+				)
+			})
+
+			It("tunnels it to the container port through the hijack endpoint", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "port-forward", "-s", "some-step", "0:8080")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				defer sess.Kill()
+
+				Eventually(sess.Out).Should(gbytes.Say(`forwarding 127\.0\.0\.1:\d+ to port 8080 of the container`))
+
+				address := regexp.MustCompile(`127\.0\.0\.1:\d+`).Find(sess.Out.Contents())
+
+				conn, err := net.Dial("tcp", string(address))
+				Expect(err).NotTo(HaveOccurred())
+
+				defer conn.Close()
+
+				_, err = conn.Write([]byte("ping"))
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err := ioutil.ReadAll(conn)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(response)).To(Equal("pong"))
+
+				sess.Signal(os.Interrupt)
+				<-sess.Exited
+			})
+		})
+	})
+})
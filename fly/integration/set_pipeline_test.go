@@ -991,6 +991,74 @@ this is super secure
 				})
 			})
 
+			Context("when --dry-run is passed", func() {
+				BeforeEach(func() {
+					changedConfig.Resources = changedConfig.Resources[:1]
+
+					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path,
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", path, "dry_run="),
+							ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]interface{}{
+								"changes": atc.ConfigChanges{
+									AddedJobs:        []string{"some-new-job"},
+									RemovedResources: []string{"some-other-resource"},
+								},
+							}),
+						),
+					)
+				})
+
+				It("prints the consequences without applying the config", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "--dry-run")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say("resource some-other-resource has been removed"))
+					Eventually(sess).Should(gbytes.Say("consequences:"))
+					Eventually(sess).Should(gbytes.Say("job some-new-job will be added"))
+					Eventually(sess).Should(gbytes.Say("resource some-other-resource will be removed"))
+					Eventually(sess).Should(gbytes.Say("dry run: configuration not applied"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+					Expect(sess.Out.Contents()).ToNot(ContainSubstring("apply configuration?"))
+				})
+
+				Context("when --fail-on-breaking is passed", func() {
+					It("exits 1 for breaking changes", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "--dry-run", "--fail-on-breaking")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say("dry run: configuration not applied"))
+						Eventually(sess.Err).Should(gbytes.Say("configuration contains breaking changes"))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when --fail-on-breaking is passed without --dry-run", func() {
+				It("fails and says it needs --dry-run", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "--fail-on-breaking")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("--fail-on-breaking can only be used with --dry-run"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+
 			Context("when the server says this is the first time it's creating the pipeline", func() {
 				Context("when the user doesn't mention paused", func() {
 					BeforeEach(func() {
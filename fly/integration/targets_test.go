@@ -70,6 +70,43 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints the targets as json, ordered by name", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{"name": "another-test", "url": "https://example.com/another-test", "team": "test", "expiry": "Sat, 19 Mar 2016 01:54:30 UTC"},
+					{"name": "no-token", "url": "https://example.com/no-token", "team": "main", "expiry": "n/a"},
+					{"name": "omt", "url": "https://example.com/omt", "team": "main", "expiry": "Mon, 21 Mar 2016 01:54:30 UTC"},
+					{"name": "test", "url": "https://example.com/test", "team": "test", "expiry": "Fri, 25 Mar 2016 23:29:57 UTC"}
+				]`))
+			})
+		})
+
+		Context("when --format is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--format", "{{.Name}}={{.URL}}")
+			})
+
+			It("prints each target with the template", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(string(sess.Out.Contents())).To(Equal(`another-test=https://example.com/another-test
+no-token=https://example.com/no-token
+omt=https://example.com/omt
+test=https://example.com/test
+`))
+			})
+		})
+
 		Context("when no targets are available", func() {
 			BeforeEach(func() {
 				os.RemoveAll(flyrc)
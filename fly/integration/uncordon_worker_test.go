@@ -0,0 +1,33 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("UncordonWorker", func() {
+	BeforeEach(func() {
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/uncordon"),
+				ghttp.RespondWith(http.StatusOK, nil),
+			),
+		)
+	})
+
+	It("uncordons the worker", func() {
+		flyCmd := exec.Command(flyPath, "-t", targetName, "uncordon-worker", "-w", "some-worker")
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(sess).Should(gexec.Exit(0))
+		Expect(sess.Out).To(gbytes.Say("uncordoned 'some-worker'"))
+	})
+})
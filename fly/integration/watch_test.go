@@ -223,4 +223,158 @@ var _ = Describe("Watching", func() {
 			})
 		})
 	})
+
+	Describe("exit codes", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				eventsHandler(),
+			)
+		})
+
+		It("exits with the code for the build's status rather than the task's", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--build", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			events <- event.FinishTask{ExitStatus: 42}
+			events <- event.Status{Status: atc.StatusFailed}
+			close(events)
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+	})
+
+	Context("with --since-event", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("Last-Event-ID", "0"),
+					eventsHandler(),
+				),
+			)
+		})
+
+		It("asks the server for the events after the given event", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--build", "3", "--since-event", "0")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			events <- event.Log{Payload: "resumed\n"}
+			close(events)
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out.Contents()).To(ContainSubstring("resumed"))
+		})
+	})
+
+	Context("with --step", func() {
+		planHandler := func() http.HandlerFunc {
+			plan := json.RawMessage(`{"id":"do","do":[{"id":"1","task":{"name":"unit"}},{"id":"2","task":{"name":"integration"}}]}`)
+
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/builds/3/plan"),
+				ghttp.RespondWithJSONEncoded(200, atc.PublicBuildPlan{
+					Schema: "exec.v2",
+					Plan:   &plan,
+				}),
+			)
+		}
+
+		Context("when the build has the step", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					planHandler(),
+					eventsHandler(),
+				)
+			})
+
+			It("only prints the step's output", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--build", "3", "--step", "unit")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(streaming).Should(BeClosed())
+
+				events <- event.Log{Origin: event.Origin{ID: "1"}, Payload: "unit output\n"}
+				events <- event.Log{Origin: event.Origin{ID: "2"}, Payload: "integration output\n"}
+				events <- event.Status{Status: atc.StatusSucceeded}
+				close(events)
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out.Contents()).To(ContainSubstring("unit output"))
+				Expect(sess.Out.Contents()).NotTo(ContainSubstring("integration output"))
+			})
+		})
+
+		Context("when the build does not have the step", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					planHandler(),
+				)
+			})
+
+			It("returns an error and exits", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--build", "3", "--step", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("build has no step named 'bogus'"))
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("with a pipeline", func() {
+		BeforeEach(func() {
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/builds",
+				ghttp.RespondWithJSONEncoded(200, []atc.Build{
+					{ID: 3, Name: "7", Status: "started", JobName: "some-job"},
+					{ID: 2, Name: "6", Status: "succeeded", JobName: "some-job"},
+				}),
+			)
+
+			atcServer.RouteToHandler("GET", "/api/v1/builds/3/events", eventsHandler())
+		})
+
+		It("follows the pipeline's running builds with prefixed output", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--pipeline", "some-pipeline")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			events <- event.Log{Payload: "sup\n"}
+
+			Eventually(sess.Out).Should(gbytes.Say(`some-job/7 \| sup`))
+
+			close(events)
+
+			sess.Interrupt()
+			<-sess.Exited
+		})
+
+		It("cannot be combined with --job", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--pipeline", "some-pipeline", "--job", "some-pipeline/some-job")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("--pipeline cannot be combined with --job or --build"))
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+	})
 })
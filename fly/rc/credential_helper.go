@@ -0,0 +1,103 @@
+package rc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// CredentialHelperPrefix is prepended to a target's credential_helper to find
+// the executable on the PATH, e.g. 'osxkeychain' runs
+// 'fly-credential-osxkeychain'.
+//
+// The helper is invoked with a single argument, one of 'get', 'store' or
+// 'erase', and is given a JSON credentialRequest on stdin. For 'get' it should
+// print the token as JSON (e.g. {"type":"Bearer","value":"..."}) on stdout, or
+// nothing at all if it has no token for the target.
+const CredentialHelperPrefix = "fly-credential-"
+
+type credentialRequest struct {
+	Target TargetName   `json:"target"`
+	API    string       `json:"api"`
+	Team   string       `json:"team"`
+	Token  *TargetToken `json:"token,omitempty"`
+}
+
+func getCredential(targetName TargetName, props TargetProps) (*TargetToken, error) {
+	output, err := runCredentialHelper(props.CredentialHelper, "get", credentialRequest{
+		Target: targetName,
+		API:    props.API,
+		Team:   props.TeamName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bytes.TrimSpace(output)) == 0 {
+		return nil, nil
+	}
+
+	var token TargetToken
+	err = json.Unmarshal(output, &token)
+	if err != nil {
+		return nil, fmt.Errorf("credential helper '%s' returned an invalid token: %s", props.CredentialHelper, err)
+	}
+
+	if token.Value == "" {
+		return nil, nil
+	}
+
+	return &token, nil
+}
+
+func storeCredential(targetName TargetName, props TargetProps, token *TargetToken) error {
+	if token == nil || token.Value == "" {
+		return eraseCredential(targetName, props)
+	}
+
+	_, err := runCredentialHelper(props.CredentialHelper, "store", credentialRequest{
+		Target: targetName,
+		API:    props.API,
+		Team:   props.TeamName,
+		Token:  token,
+	})
+	return err
+}
+
+func eraseCredential(targetName TargetName, props TargetProps) error {
+	_, err := runCredentialHelper(props.CredentialHelper, "erase", credentialRequest{
+		Target: targetName,
+		API:    props.API,
+		Team:   props.TeamName,
+	})
+	return err
+}
+
+func runCredentialHelper(helper string, action string, request credentialRequest) ([]byte, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	cmd := exec.Command(CredentialHelperPrefix+helper, action)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+
+		return nil, fmt.Errorf("credential helper '%s' failed to %s token: %s", helper, action, message)
+	}
+
+	return stdout.Bytes(), nil
+}
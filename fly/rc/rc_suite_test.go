@@ -1,7 +1,9 @@
 package rc_test
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -18,6 +20,33 @@ func userHomeDir() string {
 	return os.Getenv("HOME")
 }
 
+// installCredentialHelper puts a 'fake' credential helper on the PATH which
+// keeps the token in store.json in the given directory, and appends each
+// action it is asked to perform to calls. It returns a function which
+// restores the PATH.
+func installCredentialHelper(dir string) func() {
+	binDir := filepath.Join(dir, "bin")
+	Expect(os.Mkdir(binDir, 0755)).To(Succeed())
+
+	helper := `#!/bin/sh
+echo "$1" >> ` + filepath.Join(dir, "calls") + `
+case "$1" in
+get) cat ` + filepath.Join(dir, "store.json") + ` 2>/dev/null ;;
+store) sed -n 's/.*"token":\({[^}]*}\).*/\1/p' > ` + filepath.Join(dir, "store.json") + ` ;;
+erase) rm -f ` + filepath.Join(dir, "store.json") + ` ;;
+esac
+`
+	err := ioutil.WriteFile(filepath.Join(binDir, "fly-credential-fake"), []byte(helper), 0755)
+	Expect(err).ToNot(HaveOccurred())
+
+	oldPath := os.Getenv("PATH")
+	os.Setenv("PATH", binDir+string(os.PathListSeparator)+oldPath)
+
+	return func() {
+		os.Setenv("PATH", oldPath)
+	}
+}
+
 var rsaCertPEM = `-----BEGIN CERTIFICATE-----
 MIIB0zCCAX2gAwIBAgIJAI/M7BYjwB+uMA0GCSqGSIb3DQEBBQUAMEUxCzAJBgNV
 BAYTAkFVMRMwEQYDVQQIDApTb21lLVN0YXRlMSEwHwYDVQQKDBhJbnRlcm5ldCBX
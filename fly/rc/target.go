@@ -1,6 +1,7 @@
 package rc
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
@@ -16,9 +17,14 @@ import (
 	"github.com/concourse/concourse/fly/version"
 	"github.com/concourse/concourse/go-concourse/concourse"
 	semisemanticversion "github.com/cppforlife/go-semi-semantic/version"
+	"github.com/dgrijalva/jwt-go"
 	"golang.org/x/oauth2"
 )
 
+// TokenRefreshThreshold is how close to expiring a token has to be before fly
+// trades in its refresh token for a new one.
+const TokenRefreshThreshold = 10 * time.Minute
+
 type ErrVersionMismatch struct {
 	flyVersion string
 	atcVersion string
@@ -111,11 +117,39 @@ func LoadTarget(selectedTarget TargetName, tracing bool) (Target, error) {
 		return nil, err
 	}
 
+	if targetProps.CredentialHelper != "" {
+		targetProps.Token, err = getCredential(selectedTarget, targetProps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	caCertPool, err := loadCACertPool(targetProps.CACert)
 	if err != nil {
 		return nil, err
 	}
 
+	// a failed refresh is not fatal; the current token is still good for a
+	// little while, and if it isn't the user will be asked to log in again
+	refreshed, err := refreshToken(targetProps.API, targetProps.Token, targetProps.Insecure, caCertPool)
+	if err == nil && refreshed != nil {
+		targetProps.Token = refreshed
+
+		if selectedTarget != "" {
+			err = SaveTarget(
+				selectedTarget,
+				targetProps.API,
+				targetProps.Insecure,
+				targetProps.TeamName,
+				targetProps.Token,
+				targetProps.CACert,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	httpClient := defaultHttpClient(targetProps.Token, targetProps.Insecure, caCertPool)
 	client := concourse.NewClient(targetProps.API, httpClient, tracing)
 
@@ -386,6 +420,56 @@ func defaultHttpClient(token *TargetToken, insecure bool, caCertPool *x509.CertP
 	return &http.Client{Transport: transport}
 }
 
+func refreshToken(api string, token *TargetToken, insecure bool, caCertPool *x509.CertPool) (*TargetToken, error) {
+	if token == nil || token.RefreshToken == "" {
+		return nil, nil
+	}
+
+	expiry, ok := tokenExpiry(token.Value)
+	if !ok || time.Until(expiry) > TokenRefreshThreshold {
+		return nil, nil
+	}
+
+	oauth2Config := oauth2.Config{
+		ClientID:     "fly",
+		ClientSecret: "Zmx5",
+		Endpoint:     oauth2.Endpoint{TokenURL: api + "/sky/token"},
+	}
+
+	httpClient := &http.Client{Transport: transport(insecure, caCertPool)}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+
+	newToken, err := oauth2Config.TokenSource(ctx, &oauth2.Token{RefreshToken: token.RefreshToken}).Token()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TargetToken{
+		Type:         newToken.TokenType,
+		Value:        newToken.AccessToken,
+		RefreshToken: newToken.RefreshToken,
+	}, nil
+}
+
+func tokenExpiry(value string) (time.Time, bool) {
+	parsedToken, _, err := new(jwt.Parser).ParseUnverified(value, jwt.MapClaims{})
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	claims, ok := parsedToken.Claims.(jwt.MapClaims)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(exp), 0), true
+}
+
 func loadCACertPool(caCert string) (cert *x509.CertPool, err error) {
 	if caCert == "" {
 		return nil, nil
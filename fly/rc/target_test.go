@@ -8,8 +8,11 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/onsi/gomega/ghttp"
 	"golang.org/x/oauth2"
 	yaml "gopkg.in/yaml.v2"
 
@@ -156,5 +159,129 @@ AA9WjQKZ7aKQRUzkuxCkPfAyAw7xzvjoyVGM5mKf5p/AfbdynMk2OmufTqj/ZA1k
 				}))
 			})
 		})
+
+		Context("when the token has a refresh token", func() {
+			var (
+				atcServer   *ghttp.Server
+				expiry      time.Time
+				restorePath func()
+			)
+
+			BeforeEach(func() {
+				if runtime.GOOS == "windows" {
+					Skip("the fake credential helper is a shell script")
+				}
+
+				// refresh tokens are only kept by credential helpers
+				restorePath = installCredentialHelper(tmpDir)
+
+				atcServer = ghttp.NewServer()
+				expiry = time.Now().Add(time.Hour)
+			})
+
+			JustBeforeEach(func() {
+				accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+					"exp": expiry.Unix(),
+				}).SignedString([]byte("some-key"))
+				Expect(err).NotTo(HaveOccurred())
+
+				err = rc.UseCredentialHelper("some-target", "fake")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = rc.SaveTarget(
+					"some-target",
+					atcServer.URL(),
+					false,
+					"main",
+					&rc.TargetToken{
+						Type:         "Bearer",
+						Value:        accessToken,
+						RefreshToken: "some-refresh-token",
+					},
+					"",
+				)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				atcServer.Close()
+				restorePath()
+			})
+
+			Context("when the token is not close to expiring", func() {
+				It("does not refresh it", func() {
+					_, err := rc.LoadTarget("some-target", false)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(atcServer.ReceivedRequests()).To(BeEmpty())
+				})
+			})
+
+			Context("when the token is about to expire", func() {
+				BeforeEach(func() {
+					expiry = time.Now().Add(time.Minute)
+				})
+
+				Context("when refreshing succeeds", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("POST", "/sky/token"),
+								ghttp.VerifyBasicAuth("fly", "Zmx5"),
+								ghttp.VerifyFormKV("grant_type", "refresh_token"),
+								ghttp.VerifyFormKV("refresh_token", "some-refresh-token"),
+								ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{
+									"token_type":    "Bearer",
+									"access_token":  "some-new-token",
+									"refresh_token": "some-new-refresh-token",
+								}),
+							),
+						)
+					})
+
+					It("uses the new token", func() {
+						target, err := rc.LoadTarget("some-target", false)
+						Expect(err).NotTo(HaveOccurred())
+
+						authorization, ok := target.TokenAuthorization()
+						Expect(ok).To(BeTrue())
+						Expect(authorization).To(Equal("Bearer some-new-token"))
+					})
+
+					It("saves the new token", func() {
+						_, err := rc.LoadTarget("some-target", false)
+						Expect(err).NotTo(HaveOccurred())
+
+						stored, err := ioutil.ReadFile(filepath.Join(tmpDir, "store.json"))
+						Expect(err).NotTo(HaveOccurred())
+						Expect(stored).To(MatchJSON(`{
+							"type": "Bearer",
+							"value": "some-new-token",
+							"refresh_token": "some-new-refresh-token"
+						}`))
+					})
+				})
+
+				Context("when refreshing fails", func() {
+					BeforeEach(func() {
+						atcServer.AppendHandlers(
+							ghttp.CombineHandlers(
+								ghttp.VerifyRequest("POST", "/sky/token"),
+								ghttp.RespondWith(http.StatusBadRequest, nil),
+							),
+						)
+					})
+
+					It("keeps using the current token", func() {
+						target, err := rc.LoadTarget("some-target", false)
+						Expect(err).NotTo(HaveOccurred())
+
+						authorization, ok := target.TokenAuthorization()
+						Expect(ok).To(BeTrue())
+						Expect(authorization).NotTo(Equal("Bearer some-new-token"))
+						Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+					})
+				})
+			})
+		})
 	})
 })
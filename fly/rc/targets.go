@@ -7,8 +7,11 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
 
 	"gopkg.in/yaml.v2"
 )
@@ -18,6 +21,16 @@ var (
 	ErrNoTargetFromURL   = errors.New("no target matching url")
 )
 
+// Environment variables describing an ephemeral target, used when no target
+// is specified. Nothing about such a target is ever written to the flyrc,
+// which makes them a good fit for CI.
+const (
+	TargetURLEnvVar = "FLY_TARGET_URL"
+	TokenEnvVar     = "FLY_TOKEN"
+	TeamEnvVar      = "FLY_TEAM"
+	InsecureEnvVar  = "FLY_INSECURE"
+)
+
 type UnknownTargetError struct {
 	TargetName TargetName
 }
@@ -27,16 +40,18 @@ func (err UnknownTargetError) Error() string {
 }
 
 type TargetProps struct {
-	API      string       `yaml:"api"`
-	TeamName string       `yaml:"team"`
-	Insecure bool         `yaml:"insecure,omitempty"`
-	Token    *TargetToken `yaml:"token,omitempty"`
-	CACert   string       `yaml:"ca_cert,omitempty"`
+	API              string       `yaml:"api"`
+	TeamName         string       `yaml:"team"`
+	Insecure         bool         `yaml:"insecure,omitempty"`
+	Token            *TargetToken `yaml:"token,omitempty"`
+	CACert           string       `yaml:"ca_cert,omitempty"`
+	CredentialHelper string       `yaml:"credential_helper,omitempty"`
 }
 
 type TargetToken struct {
-	Type  string `yaml:"type"`
-	Value string `yaml:"value"`
+	Type         string `yaml:"type" json:"type"`
+	Value        string `yaml:"value" json:"value"`
+	RefreshToken string `yaml:"refresh_token,omitempty" json:"refresh_token,omitempty"`
 }
 
 type targetDetailsYAML struct {
@@ -47,6 +62,12 @@ func flyrcPath() string {
 	return filepath.Join(userHomeDir(), ".flyrc")
 }
 
+// CacheDir is where fly keeps data it can safely throw away, such as
+// completion results.
+func CacheDir() string {
+	return filepath.Join(userHomeDir(), ".fly")
+}
+
 func DeleteTarget(targetName TargetName) error {
 	flyTargets, err := LoadTargets()
 	if err != nil {
@@ -54,6 +75,15 @@ func DeleteTarget(targetName TargetName) error {
 	}
 
 	if target, ok := flyTargets.Targets[targetName]; ok {
+		// a broken or uninstalled helper must not keep the target from being
+		// logged out of, so its token is left behind with a warning
+		if target.CredentialHelper != "" {
+			err := eraseCredential(targetName, target)
+			if err != nil {
+				fmt.Fprintf(ui.Stderr, "warning: %s\n", err)
+			}
+		}
+
 		if target.Token != nil {
 			*target.Token = TargetToken{}
 		}
@@ -83,12 +113,48 @@ func SaveTarget(
 	newInfo.TeamName = teamName
 	newInfo.CACert = caCert
 
+	// refresh tokens do not expire along with the token, so they are only
+	// kept by credential helpers and never written to the flyrc
+	if token != nil && token.RefreshToken != "" {
+		newInfo.Token = &TargetToken{Type: token.Type, Value: token.Value}
+	}
+
+	if newInfo.CredentialHelper != "" {
+		err := storeCredential(targetName, newInfo, token)
+		if err != nil {
+			return err
+		}
+
+		newInfo.Token = nil
+	}
+
 	flyTargets.Targets[targetName] = newInfo
 	return writeTargets(flyrc, flyTargets)
 }
 
+// UseCredentialHelper configures the target to keep its token in the given
+// credential helper rather than the flyrc. Any token already in the flyrc is
+// dropped.
+func UseCredentialHelper(targetName TargetName, helper string) error {
+	flyTargets, err := LoadTargets()
+	if err != nil {
+		return err
+	}
+
+	info := flyTargets.Targets[targetName]
+	info.CredentialHelper = helper
+	info.Token = nil
+
+	flyTargets.Targets[targetName] = info
+	return writeTargets(flyrcPath(), flyTargets)
+}
+
 func selectTarget(selectedTarget TargetName) (TargetProps, error) {
 	if selectedTarget == "" {
+		if target, ok := envTarget(); ok {
+			return target, nil
+		}
+
 		return TargetProps{}, ErrNoTargetSpecified
 	}
 	flyTargets, err := LoadTargets()
@@ -103,6 +169,35 @@ func selectTarget(selectedTarget TargetName) (TargetProps, error) {
 	return target, nil
 }
 
+func envTarget() (TargetProps, bool) {
+	api := os.Getenv(TargetURLEnvVar)
+	if api == "" {
+		return TargetProps{}, false
+	}
+
+	target := TargetProps{
+		API:      strings.TrimRight(api, "/"),
+		TeamName: os.Getenv(TeamEnvVar),
+	}
+
+	if target.TeamName == "" {
+		target.TeamName = atc.DefaultTeamName
+	}
+
+	target.Insecure, _ = strconv.ParseBool(os.Getenv(InsecureEnvVar))
+
+	if token := os.Getenv(TokenEnvVar); token != "" {
+		segments := strings.SplitN(token, " ", 2)
+		if len(segments) == 2 {
+			target.Token = &TargetToken{Type: segments[0], Value: segments[1]}
+		} else {
+			target.Token = &TargetToken{Type: "Bearer", Value: token}
+		}
+	}
+
+	return target, true
+}
+
 func userHomeDir() string {
 	home := os.Getenv("HOME")
 	if home != "" {
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
@@ -154,6 +155,33 @@ var _ = Describe("Targets", func() {
 			})
 		})
 
+		Context("when the token has a refresh token", func() {
+			BeforeEach(func() {
+				err := rc.SaveTarget(
+					"some-target",
+					"http://concourse.com",
+					false,
+					"main",
+					&rc.TargetToken{Type: "Bearer", Value: "some-token", RefreshToken: "some-refresh-token"},
+					"",
+				)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not write the refresh token to the flyrc", func() {
+				targets, err := rc.LoadTargets()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(targets.Targets["some-target"].Token).To(Equal(&rc.TargetToken{
+					Type:  "Bearer",
+					Value: "some-token",
+				}))
+
+				contents, err := ioutil.ReadFile(flyrc)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).ToNot(ContainSubstring("some-refresh-token"))
+			})
+		})
+
 		Context("when selecting a target that does not exist", func() {
 			It("returns UnknownTargetError", func() {
 				_, err := rc.LoadTarget("bogus", false)
@@ -166,6 +194,166 @@ var _ = Describe("Targets", func() {
 				_, err := rc.LoadTarget("", false)
 				Expect(err).To(Equal(rc.ErrNoTargetSpecified))
 			})
+
+			Context("when the target is described by the environment", func() {
+				BeforeEach(func() {
+					os.Setenv("FLY_TARGET_URL", "http://concourse.com/")
+					os.Setenv("FLY_TOKEN", "Bearer some-token")
+				})
+
+				AfterEach(func() {
+					os.Unsetenv("FLY_TARGET_URL")
+					os.Unsetenv("FLY_TOKEN")
+					os.Unsetenv("FLY_TEAM")
+				})
+
+				It("loads the target from the environment", func() {
+					target, err := rc.LoadTarget("", false)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(target.URL()).To(Equal("http://concourse.com"))
+					Expect(target.Team().Name()).To(Equal(atc.DefaultTeamName))
+
+					authorization, ok := target.TokenAuthorization()
+					Expect(ok).To(BeTrue())
+					Expect(authorization).To(Equal("Bearer some-token"))
+				})
+
+				It("does not save the target", func() {
+					_, err := rc.LoadTarget("", false)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(flyrc).ToNot(BeAnExistingFile())
+				})
+
+				Context("when the token has no type", func() {
+					BeforeEach(func() {
+						os.Setenv("FLY_TOKEN", "some-token")
+					})
+
+					It("assumes a bearer token", func() {
+						target, err := rc.LoadTarget("", false)
+						Expect(err).ToNot(HaveOccurred())
+
+						authorization, ok := target.TokenAuthorization()
+						Expect(ok).To(BeTrue())
+						Expect(authorization).To(Equal("Bearer some-token"))
+					})
+				})
+
+				Context("when a team is given", func() {
+					BeforeEach(func() {
+						os.Setenv("FLY_TEAM", "some-team")
+					})
+
+					It("targets that team", func() {
+						target, err := rc.LoadTarget("", false)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(target.Team().Name()).To(Equal("some-team"))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("credential helpers", func() {
+		var (
+			restorePath func()
+			storePath   string
+			callsPath   string
+		)
+
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("the fake credential helper is a shell script")
+			}
+
+			restorePath = installCredentialHelper(tmpDir)
+
+			storePath = filepath.Join(tmpDir, "store.json")
+			callsPath = filepath.Join(tmpDir, "calls")
+
+			err := rc.UseCredentialHelper("some-target", "fake")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = rc.SaveTarget(
+				"some-target",
+				"http://concourse.com",
+				false,
+				"main",
+				&rc.TargetToken{Type: "Bearer", Value: "some-token"},
+				"",
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			restorePath()
+		})
+
+		It("keeps the token out of the flyrc", func() {
+			targets, err := rc.LoadTargets()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets.Targets["some-target"].Token).To(BeNil())
+			Expect(targets.Targets["some-target"].CredentialHelper).To(Equal("fake"))
+
+			stored, err := ioutil.ReadFile(storePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored).To(MatchJSON(`{"type":"Bearer","value":"some-token"}`))
+		})
+
+		It("keeps the refresh token with the helper", func() {
+			err := rc.SaveTarget(
+				"some-target",
+				"http://concourse.com",
+				false,
+				"main",
+				&rc.TargetToken{Type: "Bearer", Value: "some-token", RefreshToken: "some-refresh-token"},
+				"",
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			stored, err := ioutil.ReadFile(storePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored).To(MatchJSON(`{"type":"Bearer","value":"some-token","refresh_token":"some-refresh-token"}`))
+		})
+
+		It("loads the token from the helper", func() {
+			target, err := rc.LoadTarget("some-target", false)
+			Expect(err).ToNot(HaveOccurred())
+
+			authorization, ok := target.TokenAuthorization()
+			Expect(ok).To(BeTrue())
+			Expect(authorization).To(Equal("Bearer some-token"))
+		})
+
+		It("erases the token when the target is deleted", func() {
+			err := rc.DeleteTarget("some-target")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(storePath).ToNot(BeAnExistingFile())
+
+			calls, err := ioutil.ReadFile(callsPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(calls)).To(Equal("store\nerase\n"))
+		})
+
+		Context("when the helper does not exist", func() {
+			BeforeEach(func() {
+				err := rc.UseCredentialHelper("some-target", "bogus")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				_, err := rc.LoadTarget("some-target", false)
+				Expect(err).To(MatchError(ContainSubstring("credential helper 'bogus' failed to get token")))
+			})
+
+			It("still deletes the target", func() {
+				err := rc.DeleteTarget("some-target")
+				Expect(err).ToNot(HaveOccurred())
+
+				targets, err := rc.LoadTargets()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(targets.Targets["some-target"].Token).To(BeNil())
+			})
 		})
 	})
 })
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+// OutputFlags are embedded in the commands which list things, so that their
+// result can be printed as JSON, as YAML or through a template instead of as a
+// table.
+type OutputFlags struct {
+	Json   bool   `long:"json"   description:"Print command result as JSON"`
+	Yaml   bool   `long:"yaml"   description:"Print command result as YAML"`
+	Format string `long:"format" value-name:"TEMPLATE" description:"Print each item of the command result using a Go template, e.g. '{{.Name}}'"`
+}
+
+// Structured is true if any of the flags were given, in which case the
+// command should present its result rather than render a table.
+func (flags OutputFlags) Structured() bool {
+	return flags.Json || flags.Yaml || flags.Format != ""
+}
+
+// Present writes the value in the requested format. YAML is converted from
+// the JSON representation, so the field names match. A template is executed
+// once for each element of a slice, or once for any other value, with each
+// execution followed by a newline.
+func (flags OutputFlags) Present(dst io.Writer, value interface{}) error {
+	requested := 0
+	for _, set := range []bool{flags.Json, flags.Yaml, flags.Format != ""} {
+		if set {
+			requested++
+		}
+	}
+
+	if requested > 1 {
+		return errors.New("only one of --json, --yaml or --format may be specified")
+	}
+
+	switch {
+	case flags.Json:
+		return presentJSON(dst, value)
+	case flags.Yaml:
+		return presentYAML(dst, value)
+	case flags.Format != "":
+		return presentTemplate(dst, flags.Format, value)
+	default:
+		return errors.New("no output format specified")
+	}
+}
+
+func presentJSON(dst io.Writer, value interface{}) error {
+	payload, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(dst, string(payload))
+	return err
+}
+
+func presentYAML(dst io.Writer, value interface{}) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	// decode numbers as json.Number, which YAML encodes as written, rather
+	// than as float64, which would turn IDs and timestamps into exponents
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var generic interface{}
+	err = decoder.Decode(&generic)
+	if err != nil {
+		return err
+	}
+
+	payload, err = yaml.Marshal(generic)
+	if err != nil {
+		return err
+	}
+
+	_, err = dst.Write(payload)
+	return err
+}
+
+func presentTemplate(dst io.Writer, format string, value interface{}) error {
+	tmpl, err := template.New("format").Parse(format)
+	if err != nil {
+		return fmt.Errorf("invalid --format template: %s", err)
+	}
+
+	items := []interface{}{value}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		items = make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items[i] = v.Index(i).Interface()
+		}
+	}
+
+	for _, item := range items {
+		err = tmpl.Execute(dst, item)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(dst)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package ui_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("OutputFlags", func() {
+	type item struct {
+		Name     string `json:"name"`
+		TeamName string `json:"team_name"`
+	}
+
+	var (
+		flags OutputFlags
+		value interface{}
+		buf   *gbytes.Buffer
+		err   error
+	)
+
+	BeforeEach(func() {
+		flags = OutputFlags{}
+		value = []item{
+			{Name: "some-name", TeamName: "some-team"},
+			{Name: "some-other-name", TeamName: "some-other-team"},
+		}
+		buf = gbytes.NewBuffer()
+	})
+
+	JustBeforeEach(func() {
+		err = flags.Present(buf, value)
+	})
+
+	Describe("Structured", func() {
+		It("is false without any flags", func() {
+			Expect(OutputFlags{}.Structured()).To(BeFalse())
+		})
+
+		It("is true with any of the flags", func() {
+			Expect(OutputFlags{Json: true}.Structured()).To(BeTrue())
+			Expect(OutputFlags{Yaml: true}.Structured()).To(BeTrue())
+			Expect(OutputFlags{Format: "{{.Name}}"}.Structured()).To(BeTrue())
+		})
+	})
+
+	Context("with --json", func() {
+		BeforeEach(func() {
+			flags.Json = true
+		})
+
+		It("presents the value as indented JSON", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buf.Contents()).To(MatchJSON(`[
+				{"name": "some-name", "team_name": "some-team"},
+				{"name": "some-other-name", "team_name": "some-other-team"}
+			]`))
+		})
+	})
+
+	Context("with --yaml", func() {
+		BeforeEach(func() {
+			flags.Yaml = true
+		})
+
+		It("presents the value as YAML, with the JSON field names", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buf.Contents()).To(MatchYAML(`
+- name: some-name
+  team_name: some-team
+- name: some-other-name
+  team_name: some-other-team
+`))
+		})
+
+		Context("when the value has integer IDs and timestamps", func() {
+			BeforeEach(func() {
+				value = atc.Build{
+					ID:        1234567,
+					Name:      "42",
+					StartTime: 1556000000,
+				}
+			})
+
+			It("presents them unchanged", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buf.Contents()).To(ContainSubstring("id: 1234567\n"))
+				Expect(buf.Contents()).To(ContainSubstring("start_time: 1556000000\n"))
+				Expect(buf.Contents()).To(ContainSubstring("name: \"42\"\n"))
+			})
+		})
+	})
+
+	Context("with --format", func() {
+		BeforeEach(func() {
+			flags.Format = "{{.Name}} {{.TeamName}}"
+		})
+
+		It("executes the template for each item", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(buf.Contents())).To(Equal("some-name some-team\nsome-other-name some-other-team\n"))
+		})
+
+		Context("when the value is not a slice", func() {
+			BeforeEach(func() {
+				value = item{Name: "some-name"}
+			})
+
+			It("executes the template once", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(buf.Contents())).To(Equal("some-name \n"))
+			})
+		})
+
+		Context("when the template is invalid", func() {
+			BeforeEach(func() {
+				flags.Format = "{{.Name"
+			})
+
+			It("errors", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid --format template"))
+			})
+		})
+	})
+
+	Context("with more than one flag", func() {
+		BeforeEach(func() {
+			flags.Json = true
+			flags.Yaml = true
+		})
+
+		It("errors", func() {
+			Expect(err).To(MatchError("only one of --json, --yaml or --format may be specified"))
+		})
+	})
+})
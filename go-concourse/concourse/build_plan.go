@@ -79,3 +79,27 @@ func (client *client) ReadOutputFromBuildPlan(buildID int, planID atc.PlanID) (i
 		return nil, false, err
 	}
 }
+
+func (client *client) ReadArtifactFromBuildStep(buildID int, stepName string, artifactName string) (io.ReadCloser, bool, error) {
+	params := rata.Params{
+		"build_id":      strconv.Itoa(buildID),
+		"step_name":     stepName,
+		"artifact_name": artifactName,
+	}
+
+	response := internal.Response{}
+	err := client.connection.Send(internal.Request{
+		RequestName:        atc.ReadArtifactFromBuildStep,
+		Params:             params,
+		ReturnResponseBody: true,
+	}, &response)
+
+	switch err.(type) {
+	case nil:
+		return response.Result.(io.ReadCloser), true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -138,4 +138,42 @@ var _ = Describe("ATC Handler Build Plans", func() {
 			})
 		})
 	})
+	Describe("ReadArtifactFromBuildStep", func() {
+		expectedURL := "/api/v1/builds/1234/steps/some-step/artifacts/some-artifact"
+
+		Context("when the artifact exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusOK, "some-tarball"),
+					),
+				)
+			})
+
+			It("returns its contents", func() {
+				out, found, err := client.ReadArtifactFromBuildStep(1234, "some-step", "some-artifact")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(ioutil.ReadAll(out)).To(Equal([]byte("some-tarball")))
+			})
+		})
+
+		Context("when the artifact no longer exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.ReadArtifactFromBuildStep(1234, "some-step", "some-artifact")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
@@ -17,15 +17,20 @@ type Client interface {
 	Builds(Page) ([]atc.Build, Pagination, error)
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
+	BuildEventsSince(buildID string, eventID int) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	AbortBuild(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SendInputToBuildPlan(buildID int, planID atc.PlanID, src io.Reader) (bool, error)
 	ReadOutputFromBuildPlan(buildID int, planID atc.PlanID) (io.ReadCloser, bool, error)
+	ReadArtifactFromBuildStep(buildID int, stepName string, artifactName string) (io.ReadCloser, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	CordonWorker(workerName string, reason string) error
+	UncordonWorker(workerName string) error
+	ListWorkerBuilds(workerName string) ([]int, error)
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
@@ -51,6 +51,20 @@ type FakeClient struct {
 		result1 concourse.Events
 		result2 error
 	}
+	BuildEventsSinceStub        func(string, int) (concourse.Events, error)
+	buildEventsSinceMutex       sync.RWMutex
+	buildEventsSinceArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	buildEventsSinceReturns struct {
+		result1 concourse.Events
+		result2 error
+	}
+	buildEventsSinceReturnsOnCall map[int]struct {
+		result1 concourse.Events
+		result2 error
+	}
 	BuildPlanStub        func(int) (atc.PublicBuildPlan, bool, error)
 	buildPlanMutex       sync.RWMutex
 	buildPlanArgsForCall []struct {
@@ -96,6 +110,18 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	CordonWorkerStub        func(string, string) error
+	cordonWorkerMutex       sync.RWMutex
+	cordonWorkerArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	cordonWorkerReturns struct {
+		result1 error
+	}
+	cordonWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetCLIReaderStub        func(string, string) (io.ReadCloser, http.Header, error)
 	getCLIReaderMutex       sync.RWMutex
 	getCLIReaderArgsForCall []struct {
@@ -169,6 +195,19 @@ type FakeClient struct {
 		result1 []atc.Team
 		result2 error
 	}
+	ListWorkerBuildsStub        func(string) ([]int, error)
+	listWorkerBuildsMutex       sync.RWMutex
+	listWorkerBuildsArgsForCall []struct {
+		arg1 string
+	}
+	listWorkerBuildsReturns struct {
+		result1 []int
+		result2 error
+	}
+	listWorkerBuildsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	ListWorkersStub        func() ([]atc.Worker, error)
 	listWorkersMutex       sync.RWMutex
 	listWorkersArgsForCall []struct {
@@ -192,6 +231,23 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReadArtifactFromBuildStepStub        func(int, string, string) (io.ReadCloser, bool, error)
+	readArtifactFromBuildStepMutex       sync.RWMutex
+	readArtifactFromBuildStepArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}
+	readArtifactFromBuildStepReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	readArtifactFromBuildStepReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
 	ReadOutputFromBuildPlanStub        func(int, atc.PlanID) (io.ReadCloser, bool, error)
 	readOutputFromBuildPlanMutex       sync.RWMutex
 	readOutputFromBuildPlanArgsForCall []struct {
@@ -258,6 +314,17 @@ type FakeClient struct {
 	uRLReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UncordonWorkerStub        func(string) error
+	uncordonWorkerMutex       sync.RWMutex
+	uncordonWorkerArgsForCall []struct {
+		arg1 string
+	}
+	uncordonWorkerReturns struct {
+		result1 error
+	}
+	uncordonWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UserInfoStub        func() (map[string]interface{}, error)
 	userInfoMutex       sync.RWMutex
 	userInfoArgsForCall []struct {
@@ -463,6 +530,70 @@ func (fake *FakeClient) BuildEventsReturnsOnCall(i int, result1 concourse.Events
 	}{result1, result2}
 }
 
+func (fake *FakeClient) BuildEventsSince(arg1 string, arg2 int) (concourse.Events, error) {
+	fake.buildEventsSinceMutex.Lock()
+	ret, specificReturn := fake.buildEventsSinceReturnsOnCall[len(fake.buildEventsSinceArgsForCall)]
+	fake.buildEventsSinceArgsForCall = append(fake.buildEventsSinceArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("BuildEventsSince", []interface{}{arg1, arg2})
+	fake.buildEventsSinceMutex.Unlock()
+	if fake.BuildEventsSinceStub != nil {
+		return fake.BuildEventsSinceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.buildEventsSinceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) BuildEventsSinceCallCount() int {
+	fake.buildEventsSinceMutex.RLock()
+	defer fake.buildEventsSinceMutex.RUnlock()
+	return len(fake.buildEventsSinceArgsForCall)
+}
+
+func (fake *FakeClient) BuildEventsSinceCalls(stub func(string, int) (concourse.Events, error)) {
+	fake.buildEventsSinceMutex.Lock()
+	defer fake.buildEventsSinceMutex.Unlock()
+	fake.BuildEventsSinceStub = stub
+}
+
+func (fake *FakeClient) BuildEventsSinceArgsForCall(i int) (string, int) {
+	fake.buildEventsSinceMutex.RLock()
+	defer fake.buildEventsSinceMutex.RUnlock()
+	argsForCall := fake.buildEventsSinceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) BuildEventsSinceReturns(result1 concourse.Events, result2 error) {
+	fake.buildEventsSinceMutex.Lock()
+	defer fake.buildEventsSinceMutex.Unlock()
+	fake.BuildEventsSinceStub = nil
+	fake.buildEventsSinceReturns = struct {
+		result1 concourse.Events
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) BuildEventsSinceReturnsOnCall(i int, result1 concourse.Events, result2 error) {
+	fake.buildEventsSinceMutex.Lock()
+	defer fake.buildEventsSinceMutex.Unlock()
+	fake.BuildEventsSinceStub = nil
+	if fake.buildEventsSinceReturnsOnCall == nil {
+		fake.buildEventsSinceReturnsOnCall = make(map[int]struct {
+			result1 concourse.Events
+			result2 error
+		})
+	}
+	fake.buildEventsSinceReturnsOnCall[i] = struct {
+		result1 concourse.Events
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) BuildPlan(arg1 int) (atc.PublicBuildPlan, bool, error) {
 	fake.buildPlanMutex.Lock()
 	ret, specificReturn := fake.buildPlanReturnsOnCall[len(fake.buildPlanArgsForCall)]
@@ -661,6 +792,67 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) CordonWorker(arg1 string, arg2 string) error {
+	fake.cordonWorkerMutex.Lock()
+	ret, specificReturn := fake.cordonWorkerReturnsOnCall[len(fake.cordonWorkerArgsForCall)]
+	fake.cordonWorkerArgsForCall = append(fake.cordonWorkerArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CordonWorker", []interface{}{arg1, arg2})
+	fake.cordonWorkerMutex.Unlock()
+	if fake.CordonWorkerStub != nil {
+		return fake.CordonWorkerStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonWorkerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CordonWorkerCallCount() int {
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
+	return len(fake.cordonWorkerArgsForCall)
+}
+
+func (fake *FakeClient) CordonWorkerCalls(stub func(string, string) error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = stub
+}
+
+func (fake *FakeClient) CordonWorkerArgsForCall(i int) (string, string) {
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
+	argsForCall := fake.cordonWorkerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) CordonWorkerReturns(result1 error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = nil
+	fake.cordonWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CordonWorkerReturnsOnCall(i int, result1 error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = nil
+	if fake.cordonWorkerReturnsOnCall == nil {
+		fake.cordonWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
 	fake.getCLIReaderMutex.Lock()
 	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
@@ -1005,6 +1197,69 @@ func (fake *FakeClient) ListTeamsReturnsOnCall(i int, result1 []atc.Team, result
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListWorkerBuilds(arg1 string) ([]int, error) {
+	fake.listWorkerBuildsMutex.Lock()
+	ret, specificReturn := fake.listWorkerBuildsReturnsOnCall[len(fake.listWorkerBuildsArgsForCall)]
+	fake.listWorkerBuildsArgsForCall = append(fake.listWorkerBuildsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ListWorkerBuilds", []interface{}{arg1})
+	fake.listWorkerBuildsMutex.Unlock()
+	if fake.ListWorkerBuildsStub != nil {
+		return fake.ListWorkerBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listWorkerBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListWorkerBuildsCallCount() int {
+	fake.listWorkerBuildsMutex.RLock()
+	defer fake.listWorkerBuildsMutex.RUnlock()
+	return len(fake.listWorkerBuildsArgsForCall)
+}
+
+func (fake *FakeClient) ListWorkerBuildsCalls(stub func(string) ([]int, error)) {
+	fake.listWorkerBuildsMutex.Lock()
+	defer fake.listWorkerBuildsMutex.Unlock()
+	fake.ListWorkerBuildsStub = stub
+}
+
+func (fake *FakeClient) ListWorkerBuildsArgsForCall(i int) string {
+	fake.listWorkerBuildsMutex.RLock()
+	defer fake.listWorkerBuildsMutex.RUnlock()
+	argsForCall := fake.listWorkerBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListWorkerBuildsReturns(result1 []int, result2 error) {
+	fake.listWorkerBuildsMutex.Lock()
+	defer fake.listWorkerBuildsMutex.Unlock()
+	fake.ListWorkerBuildsStub = nil
+	fake.listWorkerBuildsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListWorkerBuildsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.listWorkerBuildsMutex.Lock()
+	defer fake.listWorkerBuildsMutex.Unlock()
+	fake.ListWorkerBuildsStub = nil
+	if fake.listWorkerBuildsReturnsOnCall == nil {
+		fake.listWorkerBuildsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.listWorkerBuildsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListWorkers() ([]atc.Worker, error) {
 	fake.listWorkersMutex.Lock()
 	ret, specificReturn := fake.listWorkersReturnsOnCall[len(fake.listWorkersArgsForCall)]
@@ -1120,6 +1375,74 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ReadArtifactFromBuildStep(arg1 int, arg2 string, arg3 string) (io.ReadCloser, bool, error) {
+	fake.readArtifactFromBuildStepMutex.Lock()
+	ret, specificReturn := fake.readArtifactFromBuildStepReturnsOnCall[len(fake.readArtifactFromBuildStepArgsForCall)]
+	fake.readArtifactFromBuildStepArgsForCall = append(fake.readArtifactFromBuildStepArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ReadArtifactFromBuildStep", []interface{}{arg1, arg2, arg3})
+	fake.readArtifactFromBuildStepMutex.Unlock()
+	if fake.ReadArtifactFromBuildStepStub != nil {
+		return fake.ReadArtifactFromBuildStepStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.readArtifactFromBuildStepReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) ReadArtifactFromBuildStepCallCount() int {
+	fake.readArtifactFromBuildStepMutex.RLock()
+	defer fake.readArtifactFromBuildStepMutex.RUnlock()
+	return len(fake.readArtifactFromBuildStepArgsForCall)
+}
+
+func (fake *FakeClient) ReadArtifactFromBuildStepCalls(stub func(int, string, string) (io.ReadCloser, bool, error)) {
+	fake.readArtifactFromBuildStepMutex.Lock()
+	defer fake.readArtifactFromBuildStepMutex.Unlock()
+	fake.ReadArtifactFromBuildStepStub = stub
+}
+
+func (fake *FakeClient) ReadArtifactFromBuildStepArgsForCall(i int) (int, string, string) {
+	fake.readArtifactFromBuildStepMutex.RLock()
+	defer fake.readArtifactFromBuildStepMutex.RUnlock()
+	argsForCall := fake.readArtifactFromBuildStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ReadArtifactFromBuildStepReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.readArtifactFromBuildStepMutex.Lock()
+	defer fake.readArtifactFromBuildStepMutex.Unlock()
+	fake.ReadArtifactFromBuildStepStub = nil
+	fake.readArtifactFromBuildStepReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ReadArtifactFromBuildStepReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.readArtifactFromBuildStepMutex.Lock()
+	defer fake.readArtifactFromBuildStepMutex.Unlock()
+	fake.ReadArtifactFromBuildStepStub = nil
+	if fake.readArtifactFromBuildStepReturnsOnCall == nil {
+		fake.readArtifactFromBuildStepReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.readArtifactFromBuildStepReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) ReadOutputFromBuildPlan(arg1 int, arg2 atc.PlanID) (io.ReadCloser, bool, error) {
 	fake.readOutputFromBuildPlanMutex.Lock()
 	ret, specificReturn := fake.readOutputFromBuildPlanReturnsOnCall[len(fake.readOutputFromBuildPlanArgsForCall)]
@@ -1428,6 +1751,66 @@ func (fake *FakeClient) URLReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeClient) UncordonWorker(arg1 string) error {
+	fake.uncordonWorkerMutex.Lock()
+	ret, specificReturn := fake.uncordonWorkerReturnsOnCall[len(fake.uncordonWorkerArgsForCall)]
+	fake.uncordonWorkerArgsForCall = append(fake.uncordonWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UncordonWorker", []interface{}{arg1})
+	fake.uncordonWorkerMutex.Unlock()
+	if fake.UncordonWorkerStub != nil {
+		return fake.UncordonWorkerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uncordonWorkerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UncordonWorkerCallCount() int {
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
+	return len(fake.uncordonWorkerArgsForCall)
+}
+
+func (fake *FakeClient) UncordonWorkerCalls(stub func(string) error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = stub
+}
+
+func (fake *FakeClient) UncordonWorkerArgsForCall(i int) string {
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
+	argsForCall := fake.uncordonWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UncordonWorkerReturns(result1 error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = nil
+	fake.uncordonWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UncordonWorkerReturnsOnCall(i int, result1 error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = nil
+	if fake.uncordonWorkerReturnsOnCall == nil {
+		fake.uncordonWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) UserInfo() (map[string]interface{}, error) {
 	fake.userInfoMutex.Lock()
 	ret, specificReturn := fake.userInfoReturnsOnCall[len(fake.userInfoArgsForCall)]
@@ -1492,12 +1875,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
 	defer fake.buildEventsMutex.RUnlock()
+	fake.buildEventsSinceMutex.RLock()
+	defer fake.buildEventsSinceMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
@@ -1510,10 +1897,14 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listPipelinesMutex.RUnlock()
 	fake.listTeamsMutex.RLock()
 	defer fake.listTeamsMutex.RUnlock()
+	fake.listWorkerBuildsMutex.RLock()
+	defer fake.listWorkerBuildsMutex.RUnlock()
 	fake.listWorkersMutex.RLock()
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.readArtifactFromBuildStepMutex.RLock()
+	defer fake.readArtifactFromBuildStepMutex.RUnlock()
 	fake.readOutputFromBuildPlanMutex.RLock()
 	defer fake.readOutputFromBuildPlanMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
@@ -1524,6 +1915,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
 	defer fake.uRLMutex.RUnlock()
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
 	fake.userInfoMutex.RLock()
 	defer fake.userInfoMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -2,6 +2,7 @@
 package concoursefakes
 
 import (
+	io "io"
 	sync "sync"
 
 	atc "github.com/concourse/concourse/atc"
@@ -237,6 +238,23 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	DryRunPipelineConfigStub        func(string, string, []byte) (atc.ConfigChanges, []concourse.ConfigWarning, error)
+	dryRunPipelineConfigMutex       sync.RWMutex
+	dryRunPipelineConfigArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}
+	dryRunPipelineConfigReturns struct {
+		result1 atc.ConfigChanges
+		result2 []concourse.ConfigWarning
+		result3 error
+	}
+	dryRunPipelineConfigReturnsOnCall map[int]struct {
+		result1 atc.ConfigChanges
+		result2 []concourse.ConfigWarning
+		result3 error
+	}
 	EnableResourceVersionStub        func(string, string, int) (bool, error)
 	enableResourceVersionMutex       sync.RWMutex
 	enableResourceVersionArgsForCall []struct {
@@ -556,6 +574,39 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	StreamInContainerFilesStub        func(string, string, string, io.Reader) (bool, error)
+	streamInContainerFilesMutex       sync.RWMutex
+	streamInContainerFilesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 io.Reader
+	}
+	streamInContainerFilesReturns struct {
+		result1 bool
+		result2 error
+	}
+	streamInContainerFilesReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	StreamOutContainerFilesStub        func(string, string, string) (io.ReadCloser, bool, error)
+	streamOutContainerFilesMutex       sync.RWMutex
+	streamOutContainerFilesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	streamOutContainerFilesReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	streamOutContainerFilesReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
 	UnpauseJobStub        func(string, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -1592,6 +1643,79 @@ func (fake *FakeTeam) DisableResourceVersionReturnsOnCall(i int, result1 bool, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DryRunPipelineConfig(arg1 string, arg2 string, arg3 []byte) (atc.ConfigChanges, []concourse.ConfigWarning, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.dryRunPipelineConfigMutex.Lock()
+	ret, specificReturn := fake.dryRunPipelineConfigReturnsOnCall[len(fake.dryRunPipelineConfigArgsForCall)]
+	fake.dryRunPipelineConfigArgsForCall = append(fake.dryRunPipelineConfigArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("DryRunPipelineConfig", []interface{}{arg1, arg2, arg3Copy})
+	fake.dryRunPipelineConfigMutex.Unlock()
+	if fake.DryRunPipelineConfigStub != nil {
+		return fake.DryRunPipelineConfigStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.dryRunPipelineConfigReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigCallCount() int {
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
+	return len(fake.dryRunPipelineConfigArgsForCall)
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigCalls(stub func(string, string, []byte) (atc.ConfigChanges, []concourse.ConfigWarning, error)) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = stub
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigArgsForCall(i int) (string, string, []byte) {
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
+	argsForCall := fake.dryRunPipelineConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigReturns(result1 atc.ConfigChanges, result2 []concourse.ConfigWarning, result3 error) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = nil
+	fake.dryRunPipelineConfigReturns = struct {
+		result1 atc.ConfigChanges
+		result2 []concourse.ConfigWarning
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigReturnsOnCall(i int, result1 atc.ConfigChanges, result2 []concourse.ConfigWarning, result3 error) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = nil
+	if fake.dryRunPipelineConfigReturnsOnCall == nil {
+		fake.dryRunPipelineConfigReturnsOnCall = make(map[int]struct {
+			result1 atc.ConfigChanges
+			result2 []concourse.ConfigWarning
+			result3 error
+		})
+	}
+	fake.dryRunPipelineConfigReturnsOnCall[i] = struct {
+		result1 atc.ConfigChanges
+		result2 []concourse.ConfigWarning
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) EnableResourceVersion(arg1 string, arg2 string, arg3 int) (bool, error) {
 	fake.enableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.enableResourceVersionReturnsOnCall[len(fake.enableResourceVersionArgsForCall)]
@@ -3006,6 +3130,140 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) StreamInContainerFiles(arg1 string, arg2 string, arg3 string, arg4 io.Reader) (bool, error) {
+	fake.streamInContainerFilesMutex.Lock()
+	ret, specificReturn := fake.streamInContainerFilesReturnsOnCall[len(fake.streamInContainerFilesArgsForCall)]
+	fake.streamInContainerFilesArgsForCall = append(fake.streamInContainerFilesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 io.Reader
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("StreamInContainerFiles", []interface{}{arg1, arg2, arg3, arg4})
+	fake.streamInContainerFilesMutex.Unlock()
+	if fake.StreamInContainerFilesStub != nil {
+		return fake.StreamInContainerFilesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.streamInContainerFilesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) StreamInContainerFilesCallCount() int {
+	fake.streamInContainerFilesMutex.RLock()
+	defer fake.streamInContainerFilesMutex.RUnlock()
+	return len(fake.streamInContainerFilesArgsForCall)
+}
+
+func (fake *FakeTeam) StreamInContainerFilesCalls(stub func(string, string, string, io.Reader) (bool, error)) {
+	fake.streamInContainerFilesMutex.Lock()
+	defer fake.streamInContainerFilesMutex.Unlock()
+	fake.StreamInContainerFilesStub = stub
+}
+
+func (fake *FakeTeam) StreamInContainerFilesArgsForCall(i int) (string, string, string, io.Reader) {
+	fake.streamInContainerFilesMutex.RLock()
+	defer fake.streamInContainerFilesMutex.RUnlock()
+	argsForCall := fake.streamInContainerFilesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) StreamInContainerFilesReturns(result1 bool, result2 error) {
+	fake.streamInContainerFilesMutex.Lock()
+	defer fake.streamInContainerFilesMutex.Unlock()
+	fake.StreamInContainerFilesStub = nil
+	fake.streamInContainerFilesReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) StreamInContainerFilesReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.streamInContainerFilesMutex.Lock()
+	defer fake.streamInContainerFilesMutex.Unlock()
+	fake.StreamInContainerFilesStub = nil
+	if fake.streamInContainerFilesReturnsOnCall == nil {
+		fake.streamInContainerFilesReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.streamInContainerFilesReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) StreamOutContainerFiles(arg1 string, arg2 string, arg3 string) (io.ReadCloser, bool, error) {
+	fake.streamOutContainerFilesMutex.Lock()
+	ret, specificReturn := fake.streamOutContainerFilesReturnsOnCall[len(fake.streamOutContainerFilesArgsForCall)]
+	fake.streamOutContainerFilesArgsForCall = append(fake.streamOutContainerFilesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("StreamOutContainerFiles", []interface{}{arg1, arg2, arg3})
+	fake.streamOutContainerFilesMutex.Unlock()
+	if fake.StreamOutContainerFilesStub != nil {
+		return fake.StreamOutContainerFilesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.streamOutContainerFilesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) StreamOutContainerFilesCallCount() int {
+	fake.streamOutContainerFilesMutex.RLock()
+	defer fake.streamOutContainerFilesMutex.RUnlock()
+	return len(fake.streamOutContainerFilesArgsForCall)
+}
+
+func (fake *FakeTeam) StreamOutContainerFilesCalls(stub func(string, string, string) (io.ReadCloser, bool, error)) {
+	fake.streamOutContainerFilesMutex.Lock()
+	defer fake.streamOutContainerFilesMutex.Unlock()
+	fake.StreamOutContainerFilesStub = stub
+}
+
+func (fake *FakeTeam) StreamOutContainerFilesArgsForCall(i int) (string, string, string) {
+	fake.streamOutContainerFilesMutex.RLock()
+	defer fake.streamOutContainerFilesMutex.RUnlock()
+	argsForCall := fake.streamOutContainerFilesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) StreamOutContainerFilesReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.streamOutContainerFilesMutex.Lock()
+	defer fake.streamOutContainerFilesMutex.Unlock()
+	fake.StreamOutContainerFilesStub = nil
+	fake.streamOutContainerFilesReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) StreamOutContainerFilesReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.streamOutContainerFilesMutex.Lock()
+	defer fake.streamOutContainerFilesMutex.Unlock()
+	fake.StreamOutContainerFilesStub = nil
+	if fake.streamOutContainerFilesReturnsOnCall == nil {
+		fake.streamOutContainerFilesReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.streamOutContainerFilesReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 string, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -3232,6 +3490,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.destroyTeamMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
 	defer fake.enableResourceVersionMutex.RUnlock()
 	fake.exposePipelineMutex.RLock()
@@ -3276,6 +3536,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.streamInContainerFilesMutex.RLock()
+	defer fake.streamInContainerFilesMutex.RUnlock()
+	fake.streamOutContainerFilesMutex.RLock()
+	defer fake.streamOutContainerFilesMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
@@ -64,21 +64,47 @@ type ConfigWarning struct {
 }
 
 type setConfigResponse struct {
-	Errors   []string        `json:"errors"`
-	Warnings []ConfigWarning `json:"warnings"`
+	Errors   []string           `json:"errors"`
+	Warnings []ConfigWarning    `json:"warnings"`
+	Changes  *atc.ConfigChanges `json:"changes"`
 }
 
 func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error) {
-	params := rata.Params{
-		"pipeline_name": pipelineName,
-		"team_name":     team.name,
-	}
-
 	queryParams := url.Values{}
 	if checkCredentials {
 		queryParams.Add(atc.SaveConfigCheckCreds, "")
 	}
 
+	created, configResponse, err := team.saveConfig(pipelineName, configVersion, passedConfig, queryParams)
+	if err != nil {
+		return false, false, []ConfigWarning{}, err
+	}
+
+	return created, !created, configResponse.Warnings, nil
+}
+
+func (team *team) DryRunPipelineConfig(pipelineName string, configVersion string, passedConfig []byte) (atc.ConfigChanges, []ConfigWarning, error) {
+	queryParams := url.Values{}
+	queryParams.Add(atc.SaveConfigDryRun, "")
+
+	_, configResponse, err := team.saveConfig(pipelineName, configVersion, passedConfig, queryParams)
+	if err != nil {
+		return atc.ConfigChanges{}, []ConfigWarning{}, err
+	}
+
+	if configResponse.Changes == nil {
+		return atc.ConfigChanges{}, []ConfigWarning{}, errors.New("server does not support dry runs; the config may have been saved")
+	}
+
+	return *configResponse.Changes, configResponse.Warnings, nil
+}
+
+func (team *team) saveConfig(pipelineName string, configVersion string, passedConfig []byte, queryParams url.Values) (bool, setConfigResponse, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
 	response := internal.Response{}
 
 	err := team.connection.Send(internal.Request{
@@ -102,32 +128,32 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 
 				err = json.Unmarshal([]byte(unexpectedResponseError.Body), &validationErr)
 				if err != nil {
-					return false, false, []ConfigWarning{}, err
+					return false, setConfigResponse{}, err
 				}
 
-				return false, false, []ConfigWarning{}, validationErr
+				return false, setConfigResponse{}, validationErr
 			}
 		}
 
-		return false, false, []ConfigWarning{}, err
+		return false, setConfigResponse{}, err
 	}
 
 	configResponse := setConfigResponse{}
 	readCloser, ok := response.Result.(io.ReadCloser)
 	if !ok {
-		return false, false, []ConfigWarning{}, errors.New("Failed to assert type of response result")
+		return false, setConfigResponse{}, errors.New("Failed to assert type of response result")
 	}
 	defer readCloser.Close()
 
 	contents, err := ioutil.ReadAll(readCloser)
 	if err != nil {
-		return false, false, []ConfigWarning{}, err
+		return false, setConfigResponse{}, err
 	}
 
 	err = json.Unmarshal(contents, &configResponse)
 	if err != nil {
-		return false, false, []ConfigWarning{}, err
+		return false, setConfigResponse{}, err
 	}
 
-	return response.Created, !response.Created, configResponse.Warnings, nil
+	return response.Created, configResponse, nil
 }
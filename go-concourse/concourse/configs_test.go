@@ -353,4 +353,71 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 		})
 	})
+
+	Describe("DryRunPipelineConfig", func() {
+		var (
+			returnHeader int
+			returnBody   []byte
+		)
+
+		BeforeEach(func() {
+			atcServer.RouteToHandler("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/config",
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/config", "dry_run="),
+					ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+					func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(returnHeader)
+						w.Write(returnBody)
+					},
+				),
+			)
+		})
+
+		Context("when the server returns the changes", func() {
+			BeforeEach(func() {
+				returnHeader = http.StatusOK
+				returnBody = []byte(`{
+					"warnings": [{"type": "some-type", "message": "some-warning"}],
+					"changes": {"added_jobs": ["some-job"], "resources_with_changed_source": ["some-resource"]}
+				}`)
+			})
+
+			It("returns the changes and warnings", func() {
+				changes, warnings, err := team.DryRunPipelineConfig("mypipeline", "42", []byte(""))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(changes).To(Equal(atc.ConfigChanges{
+					AddedJobs:                  []string{"some-job"},
+					ResourcesWithChangedSource: []string{"some-resource"},
+				}))
+				Expect(warnings).To(Equal([]concourse.ConfigWarning{
+					{Type: "some-type", Message: "some-warning"},
+				}))
+			})
+		})
+
+		Context("when the config is invalid", func() {
+			BeforeEach(func() {
+				returnHeader = http.StatusBadRequest
+				returnBody = []byte(`{"errors":["some-error"]}`)
+			})
+
+			It("returns the validation errors", func() {
+				_, _, err := team.DryRunPipelineConfig("mypipeline", "42", []byte(""))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("some-error"))
+			})
+		})
+
+		Context("when the server does not support dry runs", func() {
+			BeforeEach(func() {
+				returnHeader = http.StatusOK
+				returnBody = []byte(`{}`)
+			})
+
+			It("returns an error", func() {
+				_, _, err := team.DryRunPipelineConfig("mypipeline", "42", []byte(""))
+				Expect(err).To(MatchError("server does not support dry runs; the config may have been saved"))
+			})
+		})
+	})
 })
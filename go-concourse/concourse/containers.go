@@ -1,6 +1,8 @@
 package concourse
 
 import (
+	"io"
+	"net/http"
 	"net/url"
 
 	"github.com/concourse/concourse/atc"
@@ -27,3 +29,51 @@ func (team *team) ListContainers(queryList map[string]string) ([]atc.Container,
 	})
 	return containers, err
 }
+
+func (team *team) StreamOutContainerFiles(handle string, path string, user string) (io.ReadCloser, bool, error) {
+	params := rata.Params{
+		"team_name": team.name,
+		"id":        handle,
+	}
+
+	response := internal.Response{}
+	err := team.connection.Send(internal.Request{
+		RequestName:        atc.StreamOutContainerFiles,
+		Params:             params,
+		Query:              url.Values{"path": {path}, "user": {user}},
+		ReturnResponseBody: true,
+	}, &response)
+
+	switch err.(type) {
+	case nil:
+		return response.Result.(io.ReadCloser), true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) StreamInContainerFiles(handle string, path string, user string, tarStream io.Reader) (bool, error) {
+	params := rata.Params{
+		"team_name": team.name,
+		"id":        handle,
+	}
+
+	err := team.connection.Send(internal.Request{
+		Header:      http.Header{"Content-Type": {"application/x-tar"}},
+		RequestName: atc.StreamInContainerFiles,
+		Params:      params,
+		Query:       url.Values{"path": {path}, "user": {user}},
+		Body:        tarStream,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -1,6 +1,8 @@
 package concourse_test
 
 import (
+	"bytes"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/concourse/concourse/atc"
@@ -81,4 +83,83 @@ var _ = Describe("ATC Handler Containers", func() {
 			})
 		})
 	})
+
+	Describe("StreamOutContainerFiles", func() {
+		expectedURL := "/api/v1/teams/some-team/containers/some-handle/files"
+
+		Context("when the container exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "path=%2Ftmp%2Fcore&user=some-user"),
+						ghttp.RespondWith(http.StatusOK, "some-tar-stream"),
+					),
+				)
+			})
+
+			It("returns the tar stream", func() {
+				out, found, err := team.StreamOutContainerFiles("some-handle", "/tmp/core", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(ioutil.ReadAll(out)).To(Equal([]byte("some-tar-stream")))
+			})
+		})
+
+		Context("when the container does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.StreamOutContainerFiles("some-handle", "/tmp/core", "some-user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("StreamInContainerFiles", func() {
+		expectedURL := "/api/v1/teams/some-team/containers/some-handle/files"
+
+		Context("when the container exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, "path=%2Ftmp&user=some-user"),
+						ghttp.VerifyHeaderKV("Content-Type", "application/x-tar"),
+						ghttp.VerifyBody([]byte("some-tar-stream")),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("streams in the tar stream", func() {
+				found, err := team.StreamInContainerFiles("some-handle", "/tmp", "some-user", bytes.NewBufferString("some-tar-stream"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the container does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				found, err := team.StreamInContainerFiles("some-handle", "/tmp", "some-user", bytes.NewBufferString("some-tar-stream"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
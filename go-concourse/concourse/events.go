@@ -1,10 +1,14 @@
 package concourse
 
 import (
+	"net/http"
+	"strconv"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
+	"github.com/vito/go-sse/sse"
 )
 
 type Events interface {
@@ -13,15 +17,32 @@ type Events interface {
 }
 
 func (client *client) BuildEvents(buildID string) (Events, error) {
-	sseEvents, err := client.connection.ConnectToEventStream(internal.Request{
-		RequestName: atc.BuildEvents,
-		Params: rata.Params{
-			"build_id": buildID,
-		},
-	})
+	sseEvents, err := client.connectToBuildEvents(buildID, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	return eventstream.NewSSEEventStream(sseEvents), nil
 }
+
+func (client *client) BuildEventsSince(buildID string, eventID int) (Events, error) {
+	header := http.Header{}
+	header.Set("Last-Event-ID", strconv.Itoa(eventID))
+
+	sseEvents, err := client.connectToBuildEvents(buildID, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventstream.NewSSEEventStream(sseEvents), nil
+}
+
+func (client *client) connectToBuildEvents(buildID string, header http.Header) (*sse.EventSource, error) {
+	return client.connection.ConnectToEventStream(internal.Request{
+		RequestName: atc.BuildEvents,
+		Params: rata.Params{
+			"build_id": buildID,
+		},
+		Header: header,
+	})
+}
@@ -49,7 +49,19 @@ var _ = Describe("ATC Handler Events", func() {
 
 					id := 0
 
+					// like the ATC, resume after the last event the client saw
+					since := -1
+					if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+						_, err := fmt.Sscanf(lastEventID, "%d", &since)
+						Expect(err).NotTo(HaveOccurred())
+					}
+
 					for e := range eventsChan {
+						if id <= since {
+							id++
+							continue
+						}
+
 						payload, err := json.Marshal(event.Message{Event: e})
 						Expect(err).NotTo(HaveOccurred())
 
@@ -127,5 +139,33 @@ var _ = Describe("ATC Handler Events", func() {
 				Expect(err).To(Equal(concourse.ErrForbidden))
 			})
 		})
+
+		Context("when resuming from an event", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyHeaderKV("Last-Event-ID", "0"),
+						eventsHandler(),
+					),
+				)
+			})
+
+			It("asks the server for the events after the given event", func() {
+				stream, err := client.BuildEventsSince(buildID, 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				next, err := stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(event.Status{
+					Status: atc.StatusSucceeded,
+				}))
+
+				_, err = stream.NextEvent()
+				Expect(err).To(Equal(io.EOF))
+
+				err = stream.Close()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
 	})
 })
@@ -120,7 +120,22 @@ func (connection *connection) send(req *http.Request, returnResponseBody bool, p
 }
 
 func (connection *connection) ConnectToEventStream(passedRequest Request) (*sse.EventSource, error) {
-	source, err := sse.Connect(connection.httpClient, time.Second, func() *http.Request {
+	httpClient := connection.httpClient
+
+	// the event source sets Last-Event-ID on every request, clearing it when
+	// no event has been read yet, so resume from the requested event by
+	// filling it in as the request goes out
+	if lastEventID := passedRequest.Header.Get("Last-Event-ID"); lastEventID != "" {
+		client := *httpClient
+		client.Transport = lastEventIDTransport{
+			RoundTripper: httpClient.Transport,
+			lastEventID:  lastEventID,
+		}
+
+		httpClient = &client
+	}
+
+	source, err := sse.Connect(httpClient, time.Second, func() *http.Request {
 		request, reqErr := connection.createHTTPRequest(passedRequest)
 		if reqErr != nil {
 			panic("unexpected error creating request: " + reqErr.Error())
@@ -144,6 +159,26 @@ func (connection *connection) ConnectToEventStream(passedRequest Request) (*sse.
 	return source, nil
 }
 
+type lastEventIDTransport struct {
+	http.RoundTripper
+
+	lastEventID string
+}
+
+func (transport lastEventIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	roundTripper := transport.RoundTripper
+	if roundTripper == nil {
+		roundTripper = http.DefaultTransport
+	}
+
+	if req.Header.Get("Last-Event-ID") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Last-Event-ID", transport.lastEventID)
+	}
+
+	return roundTripper.RoundTrip(req)
+}
+
 func (connection *connection) createHTTPRequest(passedRequest Request) (*http.Request, error) {
 	body := connection.getBody(passedRequest)
 
@@ -584,6 +584,28 @@ var _ = Describe("ATC Connection", func() {
 			_, err = events.NextEvent()
 			Expect(err).To(MatchError(io.EOF))
 		})
+
+		It("resumes from the Last-Event-ID of the request", func() {
+			header := http.Header{}
+			header.Set("Last-Event-ID", "4")
+
+			eventSource, err := connection.ConnectToEventStream(
+				Request{
+					RequestName: atc.BuildEvents,
+					Params:      rata.Params{"build_id": buildID},
+					Header:      header,
+				})
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			close(eventsChan)
+
+			Expect(eventSource.Close()).To(Succeed())
+
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			Expect(atcServer.ReceivedRequests()[0].Header.Get("Last-Event-ID")).To(Equal("4"))
+		})
 	})
 })
 
@@ -3,6 +3,7 @@ package concourse
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,6 +13,10 @@ import (
 	"github.com/tedsuo/rata"
 )
 
+// ErrWorkerNotRunning is returned when cordoning a worker which is not
+// running, e.g. because it is landing or has stalled.
+var ErrWorkerNotRunning = errors.New("worker is not running")
+
 type PruneWorkerError struct {
 	atc.PruneWorkerResponseBody
 }
@@ -92,3 +97,49 @@ func (client *client) LandWorker(workerName string) error {
 
 	return err
 }
+
+func (client *client) CordonWorker(workerName string, reason string) error {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(atc.CordonWorkerRequest{Reason: reason})
+	if err != nil {
+		return fmt.Errorf("Unable to marshal cordon request: %s", err)
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.CordonWorker,
+		Params:      rata.Params{"worker_name": workerName},
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusConflict {
+			return ErrWorkerNotRunning
+		}
+	}
+
+	return err
+}
+
+func (client *client) UncordonWorker(workerName string) error {
+	return client.connection.Send(internal.Request{
+		RequestName: atc.UncordonWorker,
+		Params:      rata.Params{"worker_name": workerName},
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (client *client) ListWorkerBuilds(workerName string) ([]int, error) {
+	var buildIDs []int
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListWorkerBuilds,
+		Params:      rata.Params{"worker_name": workerName},
+	}, &internal.Response{
+		Result: &buildIDs,
+	})
+	return buildIDs, err
+}
@@ -160,4 +160,124 @@ var _ = Describe("ATC Handler Workers", func() {
 			})
 		})
 	})
+
+	Describe("CordonWorker", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.VerifyJSONRepresenting(atc.CordonWorkerRequest{Reason: "some-reason"}),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("cordons the worker", func() {
+				err := client.CordonWorker("some-worker", "some-reason")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the worker is not running", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.RespondWith(http.StatusConflict, nil),
+					),
+				)
+			})
+
+			It("returns ErrWorkerNotRunning", func() {
+				err := client.CordonWorker("some-worker", "some-reason")
+				Expect(err).To(Equal(concourse.ErrWorkerNotRunning))
+			})
+		})
+
+		Context("failing to cordon worker", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.CordonWorker("some-worker", "some-reason")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("UncordonWorker", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/uncordon"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("uncordons the worker", func() {
+				err := client.UncordonWorker("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("failing to uncordon worker", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/uncordon"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.UncordonWorker("some-worker")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ListWorkerBuilds", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/builds"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []int{1, 2}),
+					),
+				)
+			})
+
+			It("returns the running builds on the worker", func() {
+				buildIDs, err := client.ListWorkerBuilds("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buildIDs).To(Equal([]int{1, 2}))
+			})
+		})
+
+		Context("when the worker does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/builds"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, err := client.ListWorkerBuilds("some-worker")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
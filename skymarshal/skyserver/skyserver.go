@@ -295,26 +295,41 @@ func (s *SkyServer) Token(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if grantType = r.FormValue("grant_type"); grantType != "password" {
-		logger.Error("invalid-grant-type", nil, lager.Data{"grant_type": grantType})
-		w.WriteHeader(http.StatusBadRequest)
-		return
-	}
-
-	username := r.FormValue("username")
-	password := r.FormValue("password")
-	scope := r.FormValue("scope")
+	grantType = r.FormValue("grant_type")
 
 	oauth2Config := &oauth2.Config{
 		ClientID:     s.config.DexClientID,
 		ClientSecret: s.config.DexClientSecret,
 		Endpoint:     s.endpoint(),
-		Scopes:       strings.Split(scope, "+"),
+		Scopes:       strings.Split(r.FormValue("scope"), "+"),
 	}
 
 	ctx := oidc.ClientContext(r.Context(), s.config.DexHTTPClient)
 
-	if dexToken, err = oauth2Config.PasswordCredentialsToken(ctx, username, password); err != nil {
+	switch grantType {
+	case "password":
+		username := r.FormValue("username")
+		password := r.FormValue("password")
+
+		dexToken, err = oauth2Config.PasswordCredentialsToken(ctx, username, password)
+
+	case "refresh_token":
+		refreshToken := r.FormValue("refresh_token")
+		if refreshToken == "" {
+			logger.Error("missing-refresh-token", nil)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		dexToken, err = oauth2Config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+
+	default:
+		logger.Error("invalid-grant-type", nil, lager.Data{"grant_type": grantType})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
 		logger.Error("failed-to-fetch-dex-token", err)
 		switch e := err.(type) {
 		case *oauth2.RetrieveError:
@@ -338,6 +353,10 @@ func (s *SkyServer) Token(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// hand dex's refresh token on so that fly can renew its token without
+	// asking for credentials again
+	skyToken.RefreshToken = dexToken.RefreshToken
+
 	w.Header().Add("Content-Type", "application/json")
 
 	json.NewEncoder(w).Encode(skyToken)
@@ -0,0 +1,74 @@
+package tsa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/tedsuo/rata"
+)
+
+type BuildLister struct {
+	ATCEndpoint    *rata.RequestGenerator
+	TokenGenerator TokenGenerator
+}
+
+// RunningBuilds returns the IDs of the running builds which own containers on
+// the worker, and which must finish before the worker can land.
+func (l *BuildLister) RunningBuilds(ctx context.Context, worker atc.Worker) ([]int, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	logger.Debug("start")
+	defer logger.Debug("end")
+
+	request, err := l.ATCEndpoint.CreateRequest(atc.ListWorkerBuilds, rata.Params{
+		"worker_name": worker.Name,
+	}, nil)
+	if err != nil {
+		logger.Error("failed-to-construct-request", err)
+		return nil, err
+	}
+
+	var jwtToken string
+	if worker.Team != "" {
+		jwtToken, err = l.TokenGenerator.GenerateTeamToken(worker.Team)
+	} else {
+		jwtToken, err = l.TokenGenerator.GenerateSystemToken()
+	}
+	if err != nil {
+		logger.Error("failed-to-generate-token", err)
+		return nil, err
+	}
+
+	request.Header.Add("Authorization", "Bearer "+jwtToken)
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		logger.Error("failed-to-list-builds", err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		logger.Error("bad-response", nil, lager.Data{
+			"status-code": response.StatusCode,
+		})
+
+		b, _ := httputil.DumpResponse(response, true)
+		return nil, fmt.Errorf("bad-response (%d): %s", response.StatusCode, string(b))
+	}
+
+	var buildIDs []int
+	err = json.NewDecoder(response.Body).Decode(&buildIDs)
+	if err != nil {
+		logger.Error("failed-to-decode-builds", err)
+		return nil, err
+	}
+
+	return buildIDs, nil
+}
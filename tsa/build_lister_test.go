@@ -0,0 +1,95 @@
+package tsa_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/tsa"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/tsa/tsafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("BuildLister", func() {
+	var (
+		lister *tsa.BuildLister
+
+		ctx                context.Context
+		worker             atc.Worker
+		fakeTokenGenerator *tsafakes.FakeTokenGenerator
+		fakeATC            *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		worker = atc.Worker{
+			Name: "some-worker",
+		}
+		fakeTokenGenerator = new(tsafakes.FakeTokenGenerator)
+		fakeTokenGenerator.GenerateSystemTokenReturns("yo", nil)
+		fakeTokenGenerator.GenerateTeamTokenReturns("yo-team", nil)
+
+		fakeATC = ghttp.NewServer()
+
+		atcEndpoint := rata.NewRequestGenerator(fakeATC.URL(), atc.Routes)
+
+		lister = &tsa.BuildLister{
+			ATCEndpoint:    atcEndpoint,
+			TokenGenerator: fakeTokenGenerator,
+		}
+	})
+
+	AfterEach(func() {
+		fakeATC.Close()
+	})
+
+	It("returns the running builds on the worker", func() {
+		fakeATC.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/builds"),
+			ghttp.VerifyHeaderKV("Authorization", "Bearer yo"),
+			ghttp.RespondWithJSONEncoded(200, []int{1, 2}),
+		))
+
+		buildIDs, err := lister.RunningBuilds(ctx, worker)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buildIDs).To(Equal([]int{1, 2}))
+	})
+
+	Context("when the worker request is for a team-owned worker", func() {
+		BeforeEach(func() {
+			worker.Team = "some-team"
+		})
+
+		It("generates a team-specific token", func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/builds"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer yo-team"),
+				ghttp.RespondWithJSONEncoded(200, []int{}),
+			))
+
+			buildIDs, err := lister.RunningBuilds(ctx, worker)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buildIDs).To(BeEmpty())
+		})
+	})
+
+	Context("when the ATC responds with an error", func() {
+		BeforeEach(func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/builds"),
+				ghttp.RespondWith(500, nil),
+			))
+		})
+
+		It("errors", func() {
+			_, err := lister.RunningBuilds(ctx, worker)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("500"))
+		})
+	})
+})
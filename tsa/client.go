@@ -84,6 +84,11 @@ type RegisterOptions struct {
 	// The function must be careful not to take too long or become deadlocked, or
 	// else the SSH connection can starve.
 	HeartbeatedFunc func()
+
+	// Health, if configured, provides the results of the worker's own health
+	// checks. They are streamed to the SSH gateway as they change and included
+	// in each heartbeat.
+	Health HealthReporter
 }
 
 // Register invokes the 'forward-worker' command, proxying traffic through the
@@ -153,10 +158,11 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 		}
 	}()
 
-	err = client.run(
+	err = client.runWithHealth(
 		ctx,
 		sshClient,
 		"forward-worker --garden "+gardenForwardAddr+" --baggageclaim "+baggageclaimForwardAddr,
+		opts.Health,
 		eventsW,
 	)
 	if err != nil {
@@ -225,6 +231,35 @@ func (client *Client) Delete(ctx context.Context) error {
 	return client.run(ctx, sshClient, "delete-worker", os.Stdout)
 }
 
+// RunningBuilds invokes the 'list-worker-builds' command, returning the IDs of
+// the running builds which must finish before the worker can land.
+func (client *Client) RunningBuilds(ctx context.Context) ([]int, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	sshClient, _, err := client.dial(ctx, 0)
+	if err != nil {
+		logger.Error("failed-to-dial", err)
+		return nil, err
+	}
+
+	defer sshClient.Close()
+
+	out := new(bytes.Buffer)
+	err = client.run(ctx, sshClient, "list-worker-builds", out)
+	if err != nil {
+		return nil, err
+	}
+
+	var buildIDs []int
+	err = json.Unmarshal(out.Bytes(), &buildIDs)
+	if err != nil {
+		logger.Error("failed-to-unmarshal-builds", err)
+		return nil, err
+	}
+
+	return buildIDs, nil
+}
+
 // ContainersToDestroy invokes the 'sweep-containers' command, returning a list
 // of handles to be destroyed.
 func (client *Client) ContainersToDestroy(ctx context.Context) ([]string, error) {
@@ -435,6 +470,10 @@ func (client *Client) keepAlive(ctx context.Context, sshClient *ssh.Client, tcpC
 }
 
 func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command string, stdout io.Writer) error {
+	return client.runWithHealth(ctx, sshClient, command, nil, stdout)
+}
+
+func (client *Client) runWithHealth(ctx context.Context, sshClient *ssh.Client, command string, health HealthReporter, stdout io.Writer) error {
 	argv := strings.Split(command, " ")
 	commandName := ""
 	if len(argv) > 0 {
@@ -458,7 +497,19 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
-	sess.Stdin = bytes.NewBuffer(workerPayload)
+	var stdin io.WriteCloser
+	if health == nil {
+		sess.Stdin = bytes.NewBuffer(workerPayload)
+	} else {
+		// keep stdin open after the worker payload so that health check results
+		// can follow it for as long as the command runs
+		stdin, err = sess.StdinPipe()
+		if err != nil {
+			logger.Error("failed-to-open-stdin", err)
+			return err
+		}
+	}
+
 	sess.Stdout = stdout
 	sess.Stderr = os.Stderr
 
@@ -468,6 +519,19 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
+	if stdin != nil {
+		_, err = stdin.Write(workerPayload)
+		if err != nil {
+			logger.Error("failed-to-write-worker", err)
+			return err
+		}
+
+		healthCtx, stopHealth := context.WithCancel(ctx)
+		defer stopHealth()
+
+		go streamHealth(healthCtx, stdin, health)
+	}
+
 	errs := make(chan error, 1)
 	go func() {
 		errs <- sess.Wait()
@@ -506,6 +570,19 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 	}
 }
 
+func streamHealth(ctx context.Context, stdin io.Writer, health HealthReporter) {
+	logger := lagerctx.WithSession(ctx, "stream-health")
+
+	encoder := json.NewEncoder(stdin)
+
+	err := WatchHealth(ctx, health, func(result atc.WorkerHealth) error {
+		return encoder.Encode(result)
+	})
+	if err != nil {
+		logger.Error("failed-to-send-health", err)
+	}
+}
+
 func proxyListenerTo(ctx context.Context, listener net.Listener, network string, addr string) {
 	for {
 		remoteConn, err := listener.Accept()
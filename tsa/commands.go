@@ -8,6 +8,8 @@ const (
 	RetireWorker = "retire-worker"
 	DeleteWorker = "delete-worker"
 
+	ListWorkerBuilds = "list-worker-builds"
+
 	ReportContainers      = "report-containers"
 	ReportVolumes         = "report-volumes"
 	ResourceActionMissing = "resource-type-missing"
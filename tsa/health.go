@@ -0,0 +1,39 @@
+package tsa
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . HealthReporter
+
+// HealthReporter provides the results of the health checks run by the worker
+// itself, which are sent to the ATC along with each heartbeat.
+type HealthReporter interface {
+	// Health returns the most recent results, or nil if no checks have
+	// completed yet, along with a channel which is closed once newer results
+	// are available.
+	Health() (*atc.WorkerHealth, <-chan struct{})
+}
+
+// WatchHealth calls the given function with the current and every subsequent
+// result from the reporter, until the context is canceled or the function
+// returns an error.
+func WatchHealth(ctx context.Context, reporter HealthReporter, report func(atc.WorkerHealth) error) error {
+	for {
+		health, changed := reporter.Health()
+		if health != nil {
+			err := report(*health)
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
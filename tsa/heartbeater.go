@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -36,6 +37,9 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	healthL sync.Mutex
+	health  *atc.WorkerHealth
 }
 
 func NewHeartbeater(
@@ -65,6 +69,14 @@ func NewHeartbeater(
 	}
 }
 
+// ReportHealth records the results of the worker's own health checks, to be
+// sent along with every subsequent heartbeat.
+func (heartbeater *Heartbeater) ReportHealth(health atc.WorkerHealth) {
+	heartbeater.healthL.Lock()
+	heartbeater.health = &health
+	heartbeater.healthL.Unlock()
+}
+
 func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 	logger := lagerctx.FromContext(ctx)
 
@@ -298,6 +310,10 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	heartbeater.healthL.Lock()
+	registration.Health = heartbeater.health
+	heartbeater.healthL.Unlock()
+
 	return registration, true
 }
 
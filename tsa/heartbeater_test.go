@@ -47,6 +47,7 @@ var _ = Describe("Heartbeater", func() {
 		fakeATC1               *ghttp.Server
 		fakeATC2               *ghttp.Server
 		atcEndpointPicker      *tsafakes.FakeEndpointPicker
+		heartbeater            *Heartbeater
 		heartbeatErr           <-chan error
 
 		verifyRegister  http.HandlerFunc
@@ -152,7 +153,7 @@ var _ = Describe("Heartbeater", func() {
 	})
 
 	JustBeforeEach(func() {
-		heartbeater := NewHeartbeater(
+		heartbeater = NewHeartbeater(
 			fakeClock,
 			interval,
 			cprInterval,
@@ -261,6 +262,24 @@ var _ = Describe("Heartbeater", func() {
 					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
 				})
 
+				It("includes the reported health in subsequent heartbeats", func() {
+					Eventually(registrations).Should(Receive())
+
+					health := atc.WorkerHealth{
+						CheckedAt: 123,
+						Volumes:   2,
+						Breaches:  []string{"too many volumes"},
+					}
+
+					heartbeater.ReportHealth(health)
+
+					fakeClock.WaitForWatcherAndIncrement(interval)
+					expectedWorker.ActiveContainers = 5
+					expectedWorker.ActiveVolumes = 2
+					expectedWorker.Health = &health
+					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+				})
+
 				It("emits events", func() {
 					Eventually(registrations).Should(Receive())
 
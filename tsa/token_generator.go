@@ -41,3 +41,22 @@ func (tk *tokenGenerator) GenerateTeamToken(teamName string) (string, error) {
 
 	return jwtToken.SignedString(tk.signingKey)
 }
+
+type staticTokenGenerator struct {
+	token string
+}
+
+// NewStaticTokenGenerator returns a TokenGenerator which always returns the
+// given token, e.g. a pre-shared token with which workers register directly
+// with the ATC.
+func NewStaticTokenGenerator(token string) TokenGenerator {
+	return &staticTokenGenerator{token: token}
+}
+
+func (tk *staticTokenGenerator) GenerateSystemToken() (string, error) {
+	return tk.token, nil
+}
+
+func (tk *staticTokenGenerator) GenerateTeamToken(string) (string, error) {
+	return tk.token, nil
+}
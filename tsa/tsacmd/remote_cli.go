@@ -33,8 +33,10 @@ type forwardWorkerRequest struct {
 func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
 	logger := lagerctx.FromContext(ctx)
 
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -94,6 +96,8 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 		tsa.NewEventWriter(channel),
 	)
 
+	go readHealth(decoder, heartbeater)
+
 	err = heartbeater.Heartbeat(ctx)
 	if err != nil {
 		logger.Error("failed-to-heartbeat", err)
@@ -138,6 +142,20 @@ func (r forwardWorkerRequest) expectedForwards() int {
 	return expected
 }
 
+// readHealth passes along any health check results the worker sends after its
+// initial registration payload, until the channel is closed.
+func readHealth(decoder *json.Decoder, heartbeater *tsa.Heartbeater) {
+	for {
+		var health atc.WorkerHealth
+		err := decoder.Decode(&health)
+		if err != nil {
+			return
+		}
+
+		heartbeater.ReportHealth(health)
+	}
+}
+
 type registerWorkerRequest struct {
 	server *server
 }
@@ -258,6 +276,32 @@ func (req deleteWorkerRequest) Handle(ctx context.Context, state ConnState, chan
 	}).Delete(ctx, worker)
 }
 
+type listWorkerBuildsRequest struct {
+	server *server
+}
+
+func (req listWorkerBuildsRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	var worker atc.Worker
+	err := json.NewDecoder(channel).Decode(&worker)
+	if err != nil {
+		return err
+	}
+
+	if err := checkTeam(state, worker); err != nil {
+		return err
+	}
+
+	buildIDs, err := (&tsa.BuildLister{
+		ATCEndpoint:    req.server.atcEndpointPicker.Pick(),
+		TokenGenerator: req.server.tokenGenerator,
+	}).RunningBuilds(ctx, worker)
+	if err != nil {
+		return err
+	}
+
+	return json.NewEncoder(channel).Encode(buildIDs)
+}
+
 type sweepContainersRequest struct {
 	server *server
 }
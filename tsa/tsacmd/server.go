@@ -523,6 +523,10 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 		req = deleteWorkerRequest{
 			server: server,
 		}
+	case tsa.ListWorkerBuilds:
+		req = listWorkerBuildsRequest{
+			server: server,
+		}
 	case tsa.SweepContainers:
 		req = sweepContainersRequest{
 			server: server,
@@ -0,0 +1,107 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package tsafakes
+
+import (
+	sync "sync"
+
+	atc "github.com/concourse/concourse/atc"
+	tsa "github.com/concourse/concourse/tsa"
+)
+
+type FakeHealthReporter struct {
+	HealthStub        func() (*atc.WorkerHealth, <-chan struct{})
+	healthMutex       sync.RWMutex
+	healthArgsForCall []struct {
+	}
+	healthReturns struct {
+		result1 *atc.WorkerHealth
+		result2 <-chan struct{}
+	}
+	healthReturnsOnCall map[int]struct {
+		result1 *atc.WorkerHealth
+		result2 <-chan struct{}
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeHealthReporter) Health() (*atc.WorkerHealth, <-chan struct{}) {
+	fake.healthMutex.Lock()
+	ret, specificReturn := fake.healthReturnsOnCall[len(fake.healthArgsForCall)]
+	fake.healthArgsForCall = append(fake.healthArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Health", []interface{}{})
+	fake.healthMutex.Unlock()
+	if fake.HealthStub != nil {
+		return fake.HealthStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.healthReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeHealthReporter) HealthCallCount() int {
+	fake.healthMutex.RLock()
+	defer fake.healthMutex.RUnlock()
+	return len(fake.healthArgsForCall)
+}
+
+func (fake *FakeHealthReporter) HealthCalls(stub func() (*atc.WorkerHealth, <-chan struct{})) {
+	fake.healthMutex.Lock()
+	defer fake.healthMutex.Unlock()
+	fake.HealthStub = stub
+}
+
+func (fake *FakeHealthReporter) HealthReturns(result1 *atc.WorkerHealth, result2 <-chan struct{}) {
+	fake.healthMutex.Lock()
+	defer fake.healthMutex.Unlock()
+	fake.HealthStub = nil
+	fake.healthReturns = struct {
+		result1 *atc.WorkerHealth
+		result2 <-chan struct{}
+	}{result1, result2}
+}
+
+func (fake *FakeHealthReporter) HealthReturnsOnCall(i int, result1 *atc.WorkerHealth, result2 <-chan struct{}) {
+	fake.healthMutex.Lock()
+	defer fake.healthMutex.Unlock()
+	fake.HealthStub = nil
+	if fake.healthReturnsOnCall == nil {
+		fake.healthReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerHealth
+			result2 <-chan struct{}
+		})
+	}
+	fake.healthReturnsOnCall[i] = struct {
+		result1 *atc.WorkerHealth
+		result2 <-chan struct{}
+	}{result1, result2}
+}
+
+func (fake *FakeHealthReporter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.healthMutex.RLock()
+	defer fake.healthMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeHealthReporter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ tsa.HealthReporter = new(FakeHealthReporter)
@@ -0,0 +1,360 @@
+package worker
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	gclient "code.cloudfoundry.org/garden/client"
+	gconn "code.cloudfoundry.org/garden/client/connection"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	bclient "github.com/concourse/baggageclaim/client"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/worker/tunnel"
+	"github.com/concourse/concourse/tsa"
+	"github.com/tedsuo/rata"
+)
+
+// ErrTunnelClosed is returned when the tunnel to the ATC breaks while the
+// worker is registered.
+var ErrTunnelClosed = errors.New("tunnel to ATC closed")
+
+// ATCClient registers the worker directly with an ATC using a pre-shared
+// token, rather than through the SSH gateway. It can be used anywhere a
+// TSAClient is expected.
+//
+// Garden and Baggageclaim are reached by the ATC through a tunnel opened by
+// the worker, so no inbound connectivity to the worker is necessary.
+type ATCClient struct {
+	ATCEndpointPicker tsa.EndpointPicker
+	TokenGenerator    tsa.TokenGenerator
+
+	HeartbeatInterval time.Duration
+
+	Worker atc.Worker
+}
+
+// Register opens a tunnel to the ATC, registers the worker with the addresses
+// through which the tunnel is forwarded, and continuously heartbeats the
+// worker until it lands, goes away, or the context is canceled.
+//
+// If the context is canceled, heartbeating is immediately stopped and the
+// tunnel is kept open until all streams through it have finished. If a
+// DrainTimeout is configured, the tunnel will be closed after the configured
+// duration regardless.
+func (client *ATCClient) Register(ctx context.Context, opts tsa.RegisterOptions) error {
+	logger := lagerctx.FromContext(ctx)
+
+	endpoint := client.ATCEndpointPicker.Pick()
+
+	conn, header, err := client.connect(ctx, endpoint)
+	if err != nil {
+		logger.Error("failed-to-connect", err)
+		return err
+	}
+
+	defer conn.Close()
+
+	server := &tunnel.Server{
+		Logger: logger.Session("tunnel"),
+		Targets: map[string]tunnel.Target{
+			tunnel.Garden: {
+				Network: opts.LocalGardenNetwork,
+				Addr:    opts.LocalGardenAddr,
+			},
+			tunnel.Baggageclaim: {
+				Network: opts.LocalBaggageclaimNetwork,
+				Addr:    opts.LocalBaggageclaimAddr,
+			},
+		},
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		server.Serve(conn)
+		close(closed)
+	}()
+
+	registration := client.Worker
+	registration.GardenAddr = header.Get(tunnel.GardenAddrHeader)
+	registration.BaggageclaimURL = header.Get(tunnel.BaggageclaimURLHeader)
+
+	eventsR, eventsW := io.Pipe()
+	defer eventsW.Close()
+
+	go forwardEvents(logger, tsa.NewEventReader(eventsR), opts)
+
+	heartbeater := tsa.NewHeartbeater(
+		clock.NewClock(),
+		client.HeartbeatInterval,
+		time.Second,
+		gclient.New(gconn.NewWithLogger(
+			opts.LocalGardenNetwork,
+			opts.LocalGardenAddr,
+			logger.Session("garden-connection"),
+		)),
+		bclient.NewWithHTTPClient("http://"+opts.LocalBaggageclaimAddr, &http.Client{
+			Transport: &http.Transport{
+				DisableKeepAlives:     true,
+				ResponseHeaderTimeout: 1 * time.Minute,
+			},
+		}),
+		staticEndpointPicker{endpoint},
+		client.TokenGenerator,
+		registration,
+		tsa.NewEventWriter(eventsW),
+	)
+
+	if opts.Health != nil {
+		go tsa.WatchHealth(ctx, opts.Health, func(health atc.WorkerHealth) error {
+			heartbeater.ReportHealth(health)
+			return nil
+		})
+	}
+
+	heartbeated := make(chan error, 1)
+	go func() {
+		heartbeated <- heartbeater.Heartbeat(ctx)
+	}()
+
+	select {
+	case err := <-heartbeated:
+		if err != nil {
+			logger.Error("failed-to-heartbeat", err)
+			return err
+		}
+
+	case <-closed:
+		logger.Info("tunnel-closed")
+		return ErrTunnelClosed
+	}
+
+	// only drain if heartbeating was interrupted; otherwise the worker landed or
+	// retired, so it's time to go away
+	if ctx.Err() == nil {
+		return nil
+	}
+
+	logger.Info("draining-tunnel")
+
+	var timeout <-chan time.Time
+	if opts.DrainTimeout != 0 {
+		timer := time.NewTimer(opts.DrainTimeout)
+		defer timer.Stop()
+
+		timeout = timer.C
+	}
+
+	poll := time.NewTicker(time.Second)
+	defer poll.Stop()
+
+	for server.ActiveStreams() > 0 {
+		select {
+		case <-poll.C:
+		case <-closed:
+			return nil
+		case <-timeout:
+			return tsa.ErrDrainTimeout
+		}
+	}
+
+	return nil
+}
+
+// Land marks the worker as landing via the ATC API.
+func (client *ATCClient) Land(ctx context.Context) error {
+	return (&tsa.Lander{
+		ATCEndpoint:    client.ATCEndpointPicker.Pick(),
+		TokenGenerator: client.TokenGenerator,
+	}).Land(ctx, client.Worker)
+}
+
+// Retire marks the worker as retiring via the ATC API.
+func (client *ATCClient) Retire(ctx context.Context) error {
+	return (&tsa.Retirer{
+		ATCEndpoint:    client.ATCEndpointPicker.Pick(),
+		TokenGenerator: client.TokenGenerator,
+	}).Retire(ctx, client.Worker)
+}
+
+// Delete unregisters the worker via the ATC API.
+func (client *ATCClient) Delete(ctx context.Context) error {
+	return (&tsa.Deleter{
+		ATCEndpoint:    client.ATCEndpointPicker.Pick(),
+		TokenGenerator: client.TokenGenerator,
+	}).Delete(ctx, client.Worker)
+}
+
+// RunningBuilds returns the IDs of the running builds which must finish before
+// the worker can land.
+func (client *ATCClient) RunningBuilds(ctx context.Context) ([]int, error) {
+	return (&tsa.BuildLister{
+		ATCEndpoint:    client.ATCEndpointPicker.Pick(),
+		TokenGenerator: client.TokenGenerator,
+	}).RunningBuilds(ctx, client.Worker)
+}
+
+// ContainersToDestroy returns the handles of the containers which the ATC has
+// marked for destruction.
+func (client *ATCClient) ContainersToDestroy(ctx context.Context) ([]string, error) {
+	return client.sweep(ctx, tsa.SweepContainers)
+}
+
+// ReportContainers sends the worker's container handles to the ATC.
+func (client *ATCClient) ReportContainers(ctx context.Context, handles []string) error {
+	return (&tsa.WorkerStatus{
+		ATCEndpoint:      client.ATCEndpointPicker.Pick(),
+		TokenGenerator:   client.TokenGenerator,
+		ContainerHandles: handles,
+	}).WorkerStatus(ctx, client.Worker, tsa.ReportContainers)
+}
+
+// VolumesToDestroy returns the handles of the volumes which the ATC has marked
+// for destruction.
+func (client *ATCClient) VolumesToDestroy(ctx context.Context) ([]string, error) {
+	return client.sweep(ctx, tsa.SweepVolumes)
+}
+
+// ReportVolumes sends the worker's volume handles to the ATC.
+func (client *ATCClient) ReportVolumes(ctx context.Context, handles []string) error {
+	return (&tsa.WorkerStatus{
+		ATCEndpoint:    client.ATCEndpointPicker.Pick(),
+		TokenGenerator: client.TokenGenerator,
+		VolumeHandles:  handles,
+	}).WorkerStatus(ctx, client.Worker, tsa.ReportVolumes)
+}
+
+func (client *ATCClient) sweep(ctx context.Context, action string) ([]string, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	payload, err := (&tsa.Sweeper{
+		ATCEndpoint:    client.ATCEndpointPicker.Pick(),
+		TokenGenerator: client.TokenGenerator,
+	}).Sweep(ctx, client.Worker, action)
+	if err != nil {
+		return nil, err
+	}
+
+	var handles []string
+	err = json.Unmarshal(payload, &handles)
+	if err != nil {
+		logger.Error("failed-to-unmarshal-handles", err)
+		return nil, err
+	}
+
+	return handles, nil
+}
+
+func (client *ATCClient) connect(ctx context.Context, endpoint *rata.RequestGenerator) (net.Conn, http.Header, error) {
+	request, err := endpoint.CreateRequest(atc.ConnectWorker, rata.Params{
+		"worker_name": client.Worker.Name,
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token, err := client.TokenGenerator.GenerateSystemToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Connection", "Upgrade")
+	request.Header.Set("Upgrade", tunnel.Protocol)
+
+	addr := request.URL.Host
+	if request.URL.Port() == "" {
+		if request.URL.Scheme == "https" {
+			addr = net.JoinHostPort(request.URL.Hostname(), "443")
+		} else {
+			addr = net.JoinHostPort(request.URL.Hostname(), "80")
+		}
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 15 * time.Second,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if request.URL.Scheme == "https" {
+		conn = tls.Client(conn, &tls.Config{
+			ServerName: request.URL.Hostname(),
+
+			// the tunnel is established by upgrading an HTTP/1.1 request
+			NextProtos: []string{"http/1.1"},
+		})
+	}
+
+	err = request.Write(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+
+	response, err := http.ReadResponse(reader, request)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		conn.Close()
+		return nil, nil, fmt.Errorf("bad response (%d) connecting to ATC: %s", response.StatusCode, string(body))
+	}
+
+	return tunnel.BufferedConn{Conn: conn, Reader: reader}, response.Header, nil
+}
+
+func forwardEvents(logger lager.Logger, events tsa.EventReader, opts tsa.RegisterOptions) {
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err != io.EOF {
+				logger.Error("failed-to-read-event", err)
+			}
+
+			return
+		}
+
+		switch ev.Type {
+		case tsa.EventTypeRegistered:
+			if opts.RegisteredFunc != nil {
+				opts.RegisteredFunc()
+			}
+
+		case tsa.EventTypeHeartbeated:
+			if opts.HeartbeatedFunc != nil {
+				opts.HeartbeatedFunc()
+			}
+		}
+	}
+}
+
+// staticEndpointPicker always picks the ATC the tunnel was established with,
+// since the addresses it forwards are only reachable through that ATC.
+type staticEndpointPicker struct {
+	endpoint *rata.RequestGenerator
+}
+
+func (picker staticEndpointPicker) Pick() *rata.RequestGenerator {
+	return picker.endpoint
+}
@@ -0,0 +1,237 @@
+package worker_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/worker/tunnel"
+	"github.com/concourse/concourse/tsa"
+	"github.com/concourse/concourse/worker"
+	"github.com/concourse/flag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATCClient", func() {
+	var (
+		ctx context.Context
+
+		fakeATC          *ghttp.Server
+		fakeGarden       *ghttp.Server
+		fakeBaggageclaim *ghttp.Server
+
+		client *worker.ATCClient
+	)
+
+	BeforeEach(func() {
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+
+		fakeATC = ghttp.NewServer()
+		fakeGarden = ghttp.NewServer()
+		fakeBaggageclaim = ghttp.NewServer()
+
+		atcURL := flag.URL{}
+		Expect(atcURL.UnmarshalFlag(fakeATC.URL())).To(Succeed())
+
+		client = worker.ATCConfig{
+			URLs:              []flag.URL{atcURL},
+			Token:             "some-token",
+			HeartbeatInterval: 100 * time.Millisecond,
+		}.Client(atc.Worker{
+			Name:     "some-worker",
+			Platform: "linux",
+		})
+	})
+
+	AfterEach(func() {
+		fakeATC.Close()
+		fakeGarden.Close()
+		fakeBaggageclaim.Close()
+	})
+
+	Describe("Register", func() {
+		var (
+			registered chan atc.Worker
+			tunnels    chan *tunnel.Client
+		)
+
+		BeforeEach(func() {
+			registered = make(chan atc.Worker, 100)
+			tunnels = make(chan *tunnel.Client, 1)
+
+			fakeATC.RouteToHandler("GET", "/api/v1/workers/some-worker/connect", ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.VerifyHeaderKV("Upgrade", tunnel.Protocol),
+				func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+
+					conn, buf, err := w.(http.Hijacker).Hijack()
+					Expect(err).ToNot(HaveOccurred())
+
+					fmt.Fprintf(buf, "HTTP/1.1 101 Switching Protocols\r\n"+
+						"Upgrade: %s\r\n"+
+						"Connection: Upgrade\r\n"+
+						"%s: 1.2.3.4:7777\r\n"+
+						"%s: http://1.2.3.4:7788\r\n"+
+						"\r\n",
+						tunnel.Protocol,
+						tunnel.GardenAddrHeader,
+						tunnel.BaggageclaimURLHeader,
+					)
+					Expect(buf.Flush()).To(Succeed())
+
+					client, err := tunnel.NewClient(
+						lagertest.NewTestLogger("atc"),
+						tunnel.BufferedConn{Conn: conn, Reader: buf.Reader},
+					)
+					Expect(err).ToNot(HaveOccurred())
+
+					tunnels <- client
+				},
+			))
+
+			fakeATC.RouteToHandler("POST", "/api/v1/workers", ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				func(w http.ResponseWriter, r *http.Request) {
+					var registration atc.Worker
+					Expect(json.NewDecoder(r.Body).Decode(&registration)).To(Succeed())
+
+					registered <- registration
+				},
+				ghttp.RespondWithJSONEncoded(200, atc.Worker{}),
+			))
+
+			fakeATC.RouteToHandler("PUT", "/api/v1/workers/some-worker/heartbeat", ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.RespondWithJSONEncoded(200, atc.Worker{}),
+			))
+
+			fakeGarden.RouteToHandler("GET", "/containers", ghttp.RespondWithJSONEncoded(200, map[string][]string{
+				"handles": []string{"some-handle"},
+			}))
+
+			fakeBaggageclaim.RouteToHandler("GET", "/volumes", ghttp.RespondWithJSONEncoded(200, []interface{}{}))
+		})
+
+		It("registers the worker with the addresses forwarded through the tunnel, draining on cancel", func() {
+			ctx, cancel := context.WithCancel(ctx)
+
+			heartbeated := make(chan struct{}, 100)
+
+			errs := make(chan error, 1)
+			go func() {
+				errs <- client.Register(ctx, tsa.RegisterOptions{
+					LocalGardenNetwork:       "tcp",
+					LocalGardenAddr:          fakeGarden.Addr(),
+					LocalBaggageclaimNetwork: "tcp",
+					LocalBaggageclaimAddr:    fakeBaggageclaim.Addr(),
+
+					HeartbeatedFunc: func() {
+						heartbeated <- struct{}{}
+					},
+				})
+			}()
+
+			var tunnelClient *tunnel.Client
+			Eventually(tunnels).Should(Receive(&tunnelClient))
+
+			var registration atc.Worker
+			Eventually(registered).Should(Receive(&registration))
+			Expect(registration.Name).To(Equal("some-worker"))
+			Expect(registration.GardenAddr).To(Equal("1.2.3.4:7777"))
+			Expect(registration.BaggageclaimURL).To(Equal("http://1.2.3.4:7788"))
+			Expect(registration.ActiveContainers).To(Equal(1))
+
+			Eventually(heartbeated).Should(Receive())
+
+			By("forwarding streams to the local garden server")
+			stream, err := tunnelClient.Dial(tunnel.Garden)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = stream.Write([]byte("GET /ping HTTP/1.1\r\nHost: garden\r\n\r\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeGarden.RouteToHandler("GET", "/ping", ghttp.RespondWith(200, nil))
+
+			res, err := http.ReadResponse(bufio.NewReader(stream), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+			cancel()
+
+			Consistently(errs).ShouldNot(Receive())
+
+			stream.Close()
+
+			Eventually(errs, "5s").Should(Receive(BeNil()))
+		})
+
+		Context("when the ATC rejects the connection", func() {
+			BeforeEach(func() {
+				fakeATC.RouteToHandler("GET", "/api/v1/workers/some-worker/connect", ghttp.RespondWith(403, "nope"))
+			})
+
+			It("returns an error", func() {
+				err := client.Register(ctx, tsa.RegisterOptions{})
+				Expect(err).To(MatchError(ContainSubstring("403")))
+			})
+		})
+
+		Context("when the tunnel closes", func() {
+			It("returns ErrTunnelClosed", func() {
+				errs := make(chan error, 1)
+				go func() {
+					errs <- client.Register(ctx, tsa.RegisterOptions{
+						LocalGardenNetwork:       "tcp",
+						LocalGardenAddr:          fakeGarden.Addr(),
+						LocalBaggageclaimNetwork: "tcp",
+						LocalBaggageclaimAddr:    fakeBaggageclaim.Addr(),
+					})
+				}()
+
+				var tunnelClient *tunnel.Client
+				Eventually(tunnels).Should(Receive(&tunnelClient))
+				Eventually(registered).Should(Receive())
+
+				Expect(tunnelClient.Close()).To(Succeed())
+
+				Eventually(errs).Should(Receive(Equal(worker.ErrTunnelClosed)))
+			})
+		})
+	})
+
+	Describe("Land", func() {
+		It("lands the worker using the token", func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.RespondWith(200, nil),
+			))
+
+			Expect(client.Land(ctx)).To(Succeed())
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("ContainersToDestroy", func() {
+		It("returns the handles listed by the ATC", func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/containers/destroying", "worker_name=some-worker"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.RespondWithJSONEncoded(200, []string{"handle-a", "handle-b"}),
+			))
+
+			handles, err := client.ContainersToDestroy(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handles).To(Equal([]string{"handle-a", "handle-b"}))
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/tsa"
+	"github.com/concourse/flag"
+)
+
+type ATCConfig struct {
+	URLs  []flag.URL `long:"url"   description:"ATC API endpoint with which to register directly, rather than through the TSA. Can be specified multiple times."`
+	Token string     `long:"token" description:"Pre-shared token with which to register with the ATC."`
+
+	HeartbeatInterval time.Duration `long:"heartbeat-interval" default:"30s" description:"Interval on which to heartbeat the worker to the ATC."`
+}
+
+func (config ATCConfig) Client(worker atc.Worker) *ATCClient {
+	return &ATCClient{
+		ATCEndpointPicker: tsa.NewRandomATCEndpointPicker(config.URLs),
+		TokenGenerator:    tsa.NewStaticTokenGenerator(config.Token),
+		HeartbeatInterval: config.HeartbeatInterval,
+		Worker:            worker,
+	}
+}
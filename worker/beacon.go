@@ -2,11 +2,13 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"os"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 )
 
@@ -23,8 +25,18 @@ type Beacon struct {
 
 	LocalBaggageclaimNetwork string
 	LocalBaggageclaimAddr    string
+
+	// Health, if configured, provides health check results to report with
+	// each heartbeat.
+	Health tsa.HealthReporter
+
+	// AutoLand causes the worker to land as soon as the health checks report
+	// a breached threshold.
+	AutoLand bool
 }
 
+var errLanding = errors.New("landing")
+
 // total number of active registrations; all but one are "live", the rest
 // should all be draining
 const maxActiveRegistrations = 5
@@ -53,6 +65,10 @@ func (beacon *Beacon) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 	cwg.Add(1)
 	go beacon.registerWorker(ctx, cwg, func() { close(ready) }, latestErrChan)
 
+	if beacon.Health != nil && beacon.AutoLand {
+		go beacon.landWhenUnhealthy(lagerctx.NewContext(rootCtx, beacon.Logger.Session("auto-land")))
+	}
+
 	for {
 		select {
 		case <-rebalanceCh:
@@ -130,5 +146,28 @@ func (beacon *Beacon) registerWorker(
 		HeartbeatedFunc: func() {
 			logger.Info("heartbeated")
 		},
+
+		Health: beacon.Health,
+	})
+}
+
+func (beacon *Beacon) landWhenUnhealthy(ctx context.Context) {
+	logger := lagerctx.FromContext(ctx)
+
+	tsa.WatchHealth(ctx, beacon.Health, func(health atc.WorkerHealth) error {
+		if health.Healthy() {
+			return nil
+		}
+
+		logger.Info("landing", lager.Data{"breaches": health.Breaches})
+
+		err := beacon.Client.Land(ctx)
+		if err != nil {
+			// try again with the next results
+			logger.Error("failed-to-land", err)
+			return nil
+		}
+
+		return errLanding
 	})
 }
@@ -12,7 +12,7 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
-func NewBeaconRunner(logger lager.Logger, beacon *Beacon, tsaClient *tsa.Client) ifrit.Runner {
+func NewBeaconRunner(logger lager.Logger, beacon *Beacon, tsaClient TSAClient, waitForBuilds bool) ifrit.Runner {
 	signals := make(chan os.Signal, 2)
 	signal.Notify(signals, drainSignals...)
 
@@ -21,6 +21,8 @@ func NewBeaconRunner(logger lager.Logger, beacon *Beacon, tsaClient *tsa.Client)
 		Client:       tsaClient,
 		DrainSignals: signals,
 
+		WaitForBuilds: waitForBuilds,
+
 		Runner: beacon,
 	}
 
@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
+	"github.com/concourse/concourse/tsa/tsafakes"
 	"github.com/concourse/concourse/worker"
 	"github.com/concourse/concourse/worker/workerfakes"
 	. "github.com/onsi/ginkgo"
@@ -97,6 +99,60 @@ var _ = Describe("Beacon", func() {
 		})
 	})
 
+	Context("when health checks are configured", func() {
+		var fakeHealth *tsafakes.FakeHealthReporter
+
+		BeforeEach(func() {
+			fakeHealth = new(tsafakes.FakeHealthReporter)
+			fakeHealth.HealthReturns(&atc.WorkerHealth{
+				Breaches: []string{"too many volumes"},
+			}, make(chan struct{}))
+
+			beacon.Health = fakeHealth
+
+			fakeClient.RegisterStub = func(ctx context.Context, opts tsa.RegisterOptions) error {
+				<-ctx.Done()
+				return nil
+			}
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			<-process.Wait()
+		})
+
+		It("reports them when registering", func() {
+			Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+			_, opts := fakeClient.RegisterArgsForCall(0)
+			Expect(opts.Health).To(Equal(fakeHealth))
+		})
+
+		It("does not land the worker", func() {
+			Consistently(fakeClient.LandCallCount).Should(BeZero())
+		})
+
+		Context("when auto-landing is enabled", func() {
+			BeforeEach(func() {
+				beacon.AutoLand = true
+			})
+
+			It("lands the worker once a threshold is breached", func() {
+				Eventually(fakeClient.LandCallCount).Should(Equal(1))
+				Consistently(fakeClient.LandCallCount).Should(Equal(1))
+			})
+
+			Context("when the worker is healthy", func() {
+				BeforeEach(func() {
+					fakeHealth.HealthReturns(&atc.WorkerHealth{}, make(chan struct{}))
+				})
+
+				It("does not land the worker", func() {
+					Consistently(fakeClient.LandCallCount).Should(BeZero())
+				})
+			})
+		})
+	})
+
 	Context("when rebalancing is configured", func() {
 		BeforeEach(func() {
 			beacon.RebalanceInterval = 500 * time.Millisecond
@@ -0,0 +1,20 @@
+// +build !windows
+
+package worker
+
+import "syscall"
+
+func diskUsage(path string) (diskStats, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(path, &stat)
+	if err != nil {
+		return diskStats{}, err
+	}
+
+	return diskStats{
+		FreeBytes:   stat.Bavail * uint64(stat.Bsize),
+		TotalBytes:  stat.Blocks * uint64(stat.Bsize),
+		FreeInodes:  stat.Ffree,
+		TotalInodes: stat.Files,
+	}, nil
+}
@@ -0,0 +1,5 @@
+package worker
+
+func diskUsage(path string) (diskStats, error) {
+	return diskStats{}, ErrDiskUsageUnsupported
+}
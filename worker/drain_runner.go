@@ -4,17 +4,28 @@ import (
 	"context"
 	"os"
 	"sync/atomic"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/tedsuo/ifrit"
 )
 
+const DefaultWaitForBuildsInterval = 10 * time.Second
+
 type DrainRunner struct {
 	Logger       lager.Logger
 	Client       TSAClient
 	Runner       ifrit.Runner
 	DrainSignals <-chan os.Signal
 
+	// WaitForBuilds causes a shutdown signal to first land the worker and then
+	// hold off on forwarding the signal until the worker no longer has any
+	// running builds, polling every WaitForBuildsInterval. The worker keeps
+	// heartbeating as 'landing' in the meantime. A second shutdown signal is
+	// forwarded immediately.
+	WaitForBuilds         bool
+	WaitForBuildsInterval time.Duration
+
 	drained int32
 }
 
@@ -23,8 +34,20 @@ func (d *DrainRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 
 	close(ready)
 
+	landing := false
 	retiring := false
 
+	var waitingSignal os.Signal
+	var waitingTicker *time.Ticker
+	var waitingTicks <-chan time.Time
+	var remainingBuilds []int
+
+	defer func() {
+		if waitingTicker != nil {
+			waitingTicker.Stop()
+		}
+	}()
+
 	ctx := context.Background()
 
 	for {
@@ -37,6 +60,8 @@ func (d *DrainRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 			})
 
 			if isLand(sig) {
+				landing = true
+
 				d.Logger.Info("landing-worker")
 
 				err := d.Client.Land(ctx)
@@ -61,6 +86,42 @@ func (d *DrainRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 				"signal": sig.String(),
 			})
 
+			if d.WaitForBuilds && !retiring && waitingSignal == nil {
+				if !landing {
+					landing = true
+
+					d.Logger.Info("landing-worker")
+
+					err := d.Client.Land(ctx)
+					if err != nil {
+						d.Logger.Error("failed-to-land-worker", err)
+						proc.Signal(sig)
+						continue
+					}
+				}
+
+				d.Logger.Info("waiting-for-builds")
+
+				interval := d.WaitForBuildsInterval
+				if interval == 0 {
+					interval = DefaultWaitForBuildsInterval
+				}
+
+				waitingSignal = sig
+				waitingTicker = time.NewTicker(interval)
+				waitingTicks = waitingTicker.C
+
+				if d.buildsFinished(ctx, &remainingBuilds) {
+					waitingTicker.Stop()
+					waitingTicks = nil
+
+					d.Logger.Info("forwarding-signal")
+					proc.Signal(sig)
+				}
+
+				continue
+			}
+
 			if retiring {
 				d.Logger.Info("deleting-worker")
 
@@ -74,6 +135,15 @@ func (d *DrainRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 
 			proc.Signal(sig)
 
+		case <-waitingTicks:
+			if d.buildsFinished(ctx, &remainingBuilds) {
+				waitingTicker.Stop()
+				waitingTicks = nil
+
+				d.Logger.Info("forwarding-signal")
+				proc.Signal(waitingSignal)
+			}
+
 		case err := <-proc.Wait():
 			return err
 		}
@@ -83,3 +153,42 @@ func (d *DrainRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 func (d *DrainRunner) Drained() bool {
 	return atomic.LoadInt32(&d.drained) == 1
 }
+
+// buildsFinished checks whether the worker has any remaining running builds,
+// logging progress whenever the set of builds changes.
+func (d *DrainRunner) buildsFinished(ctx context.Context, remaining *[]int) bool {
+	buildIDs, err := d.Client.RunningBuilds(ctx)
+	if err != nil {
+		d.Logger.Error("failed-to-list-running-builds", err)
+		return false
+	}
+
+	if len(buildIDs) == 0 {
+		d.Logger.Info("builds-finished")
+		return true
+	}
+
+	if !sameBuilds(buildIDs, *remaining) {
+		d.Logger.Info("builds-remaining", lager.Data{
+			"builds": buildIDs,
+		})
+
+		*remaining = buildIDs
+	}
+
+	return false
+}
+
+func sameBuilds(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
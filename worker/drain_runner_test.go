@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/worker"
@@ -299,4 +300,82 @@ var _ = Describe("DrainRunner", func() {
 			})
 		})
 	})
+
+	Context("when waiting for builds", func() {
+		BeforeEach(func() {
+			runner.WaitForBuilds = true
+			runner.WaitForBuildsInterval = 10 * time.Millisecond
+
+			fakeClient.RunningBuildsReturnsOnCall(0, []int{1, 2}, nil)
+			fakeClient.RunningBuildsReturnsOnCall(1, []int{2}, nil)
+			fakeClient.RunningBuildsReturnsOnCall(2, nil, errors.New("nope"))
+			fakeClient.RunningBuildsReturnsOnCall(3, []int{}, nil)
+		})
+
+		Context("when syscall.SIGTERM is received", func() {
+			JustBeforeEach(func() {
+				process.Signal(syscall.SIGTERM)
+			})
+
+			It("lands the worker", func() {
+				Eventually(fakeClient.LandCallCount).Should(Equal(1))
+			})
+
+			It("forwards the signal once no running builds remain", func() {
+				Expect(<-subSignals).To(Equal(syscall.SIGTERM))
+				Expect(fakeClient.RunningBuildsCallCount()).To(Equal(4))
+			})
+
+			Context("when syscall.SIGTERM is received again while waiting", func() {
+				BeforeEach(func() {
+					fakeClient.RunningBuildsReturns([]int{1}, nil)
+					fakeClient.RunningBuildsReturnsOnCall(3, []int{1}, nil)
+				})
+
+				It("forwards the signal immediately", func() {
+					Eventually(fakeClient.RunningBuildsCallCount).Should(BeNumerically(">=", 1))
+					process.Signal(syscall.SIGTERM)
+					Expect(<-subSignals).To(Equal(syscall.SIGTERM))
+				})
+			})
+
+			Context("when landing the worker fails", func() {
+				BeforeEach(func() {
+					fakeClient.LandReturns(errors.New("nope"))
+				})
+
+				It("forwards the signal without waiting", func() {
+					Expect(<-subSignals).To(Equal(syscall.SIGTERM))
+					Expect(fakeClient.RunningBuildsCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when syscall.SIGTERM is received after landing", func() {
+			JustBeforeEach(func() {
+				drainSignals <- syscall.SIGUSR1
+				Eventually(fakeClient.LandCallCount).Should(Equal(1))
+				process.Signal(syscall.SIGTERM)
+			})
+
+			It("does not land the worker again", func() {
+				Expect(<-subSignals).To(Equal(syscall.SIGTERM))
+				Expect(fakeClient.LandCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when syscall.SIGTERM is received after retiring", func() {
+			JustBeforeEach(func() {
+				drainSignals <- syscall.SIGUSR2
+				Eventually(fakeClient.RetireCallCount).Should(Equal(1))
+				process.Signal(syscall.SIGTERM)
+			})
+
+			It("deletes the worker without waiting", func() {
+				Expect(<-subSignals).To(Equal(syscall.SIGTERM))
+				Expect(fakeClient.DeleteCallCount()).To(Equal(1))
+				Expect(fakeClient.RunningBuildsCallCount()).To(BeZero())
+			})
+		})
+	})
 })
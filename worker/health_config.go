@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+)
+
+type HealthConfig struct {
+	Interval time.Duration `long:"interval" default:"30s" description:"Interval on which to check the worker's disk usage, volume count, and ability to create containers."`
+
+	MinFreeDiskPercent   float64 `long:"min-free-disk-percent"   description:"Minimum percentage of disk space that must be free in the work dir. Disabled if zero."`
+	MinFreeInodesPercent float64 `long:"min-free-inodes-percent" description:"Minimum percentage of inodes that must be free in the work dir. Disabled if zero."`
+	MaxVolumes           int     `long:"max-volumes"             description:"Maximum number of volumes the worker may have. Disabled if zero."`
+
+	Canary bool `long:"canary" description:"Create and destroy a container on each check to verify that containers can still be created."`
+
+	AutoLand bool `long:"auto-land" description:"Land the worker when any health check threshold is breached, so that no new containers are placed on it."`
+}
+
+func (config HealthConfig) Monitor(
+	logger lager.Logger,
+	workDir string,
+	gardenClient garden.Client,
+	baggageclaimClient baggageclaim.Client,
+) *HealthMonitor {
+	return &HealthMonitor{
+		Logger: logger,
+
+		Interval: config.Interval,
+		WorkDir:  workDir,
+
+		MinFreeDiskPercent:   config.MinFreeDiskPercent,
+		MinFreeInodesPercent: config.MinFreeInodesPercent,
+		MaxVolumes:           config.MaxVolumes,
+		Canary:               config.Canary,
+
+		GardenClient:       gardenClient,
+		BaggageclaimClient: baggageclaimClient,
+	}
+}
@@ -0,0 +1,205 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// ErrDiskUsageUnsupported is returned when disk usage cannot be determined on
+// the current platform.
+var ErrDiskUsageUnsupported = errors.New("disk usage checks are not supported on this platform")
+
+type diskStats struct {
+	FreeBytes  uint64
+	TotalBytes uint64
+
+	FreeInodes  uint64
+	TotalInodes uint64
+}
+
+// HealthMonitor periodically checks the worker's disk space, inodes, and
+// volume count against the configured thresholds, and optionally verifies
+// that a container can still be created. The results are made available to
+// the beacon so that they can be reported with each heartbeat.
+type HealthMonitor struct {
+	Logger lager.Logger
+
+	Interval time.Duration
+
+	WorkDir string
+
+	MinFreeDiskPercent   float64
+	MinFreeInodesPercent float64
+	MaxVolumes           int
+	Canary               bool
+
+	GardenClient       garden.Client
+	BaggageclaimClient baggageclaim.Client
+
+	lock    sync.Mutex
+	health  *atc.WorkerHealth
+	changed chan struct{}
+}
+
+// Run checks the worker's health on every interval. The first check is only
+// made after one interval has passed, giving Garden and Baggageclaim time to
+// start up.
+func (monitor *HealthMonitor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	ticker := time.NewTicker(monitor.Interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-ticker.C:
+			monitor.publish(monitor.Check(monitor.Logger.Session("check")))
+
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+// Health returns the results of the most recent check, or nil if no check has
+// completed yet, along with a channel which is closed when newer results are
+// available.
+func (monitor *HealthMonitor) Health() (*atc.WorkerHealth, <-chan struct{}) {
+	monitor.lock.Lock()
+	defer monitor.lock.Unlock()
+
+	if monitor.changed == nil {
+		monitor.changed = make(chan struct{})
+	}
+
+	return monitor.health, monitor.changed
+}
+
+// Check runs each configured health check once and returns the results,
+// including a description of every threshold that was breached.
+func (monitor *HealthMonitor) Check(logger lager.Logger) atc.WorkerHealth {
+	health := atc.WorkerHealth{
+		CheckedAt: time.Now().Unix(),
+	}
+
+	usage, err := diskUsage(monitor.WorkDir)
+	if err == ErrDiskUsageUnsupported {
+		logger.Debug("skipping-disk-usage")
+	} else if err != nil {
+		logger.Error("failed-to-get-disk-usage", err)
+	} else {
+		health.DiskFreeBytes = usage.FreeBytes
+		health.DiskTotalBytes = usage.TotalBytes
+		health.InodesFree = usage.FreeInodes
+		health.InodesTotal = usage.TotalInodes
+
+		if breach, ok := checkFreePercent("disk space", usage.FreeBytes, usage.TotalBytes, monitor.MinFreeDiskPercent); !ok {
+			health.Breaches = append(health.Breaches, breach)
+		}
+
+		if breach, ok := checkFreePercent("inodes", usage.FreeInodes, usage.TotalInodes, monitor.MinFreeInodesPercent); !ok {
+			health.Breaches = append(health.Breaches, breach)
+		}
+	}
+
+	volumes, err := monitor.BaggageclaimClient.ListVolumes(logger.Session("list-volumes"), nil)
+	if err != nil {
+		logger.Error("failed-to-list-volumes", err)
+	} else {
+		health.Volumes = len(volumes)
+
+		if monitor.MaxVolumes != 0 && health.Volumes > monitor.MaxVolumes {
+			health.Breaches = append(health.Breaches, fmt.Sprintf("%d volumes exceeds the maximum of %d", health.Volumes, monitor.MaxVolumes))
+		}
+	}
+
+	if monitor.Canary {
+		err := monitor.createCanary(logger.Session("canary"))
+		if err != nil {
+			logger.Error("failed-to-create-canary", err)
+			health.CanaryError = err.Error()
+			health.Breaches = append(health.Breaches, "failed to create canary container: "+err.Error())
+		}
+	}
+
+	if len(health.Breaches) > 0 {
+		logger.Info("thresholds-breached", lager.Data{"breaches": health.Breaches})
+	}
+
+	return health
+}
+
+func (monitor *HealthMonitor) publish(health atc.WorkerHealth) {
+	monitor.lock.Lock()
+	defer monitor.lock.Unlock()
+
+	monitor.health = &health
+
+	if monitor.changed != nil {
+		close(monitor.changed)
+	}
+
+	monitor.changed = make(chan struct{})
+}
+
+// createCanary creates an empty volume and a container using it as its
+// rootfs, and destroys them both.
+func (monitor *HealthMonitor) createCanary(logger lager.Logger) error {
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	handle := "health-canary-" + guid.String()
+
+	volume, err := monitor.BaggageclaimClient.CreateVolume(logger, handle, baggageclaim.VolumeSpec{
+		Strategy: baggageclaim.EmptyStrategy{},
+	})
+	if err != nil {
+		return fmt.Errorf("create volume: %s", err)
+	}
+
+	defer func() {
+		err := volume.Destroy()
+		if err != nil {
+			logger.Error("failed-to-destroy-volume", err)
+		}
+	}()
+
+	_, err = monitor.GardenClient.Create(garden.ContainerSpec{
+		Handle:     handle,
+		RootFSPath: "raw://" + volume.Path(),
+	})
+	if err != nil {
+		return fmt.Errorf("create container: %s", err)
+	}
+
+	err = monitor.GardenClient.Destroy(handle)
+	if err != nil {
+		return fmt.Errorf("destroy container: %s", err)
+	}
+
+	return nil
+}
+
+func checkFreePercent(name string, free uint64, total uint64, minPercent float64) (string, bool) {
+	if minPercent == 0 || total == 0 {
+		return "", true
+	}
+
+	percent := float64(free) / float64(total) * 100
+	if percent >= minPercent {
+		return "", true
+	}
+
+	return fmt.Sprintf("free %s (%.1f%%) is below the minimum of %.1f%%", name, percent, minPercent), false
+}
@@ -0,0 +1,205 @@
+package worker_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/baggageclaim/baggageclaimfakes"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("HealthMonitor", func() {
+	var (
+		monitor *worker.HealthMonitor
+
+		workDir                string
+		fakeGardenClient       *gardenfakes.FakeClient
+		fakeBaggageclaimClient *baggageclaimfakes.FakeClient
+		fakeVolume             *baggageclaimfakes.FakeVolume
+	)
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = ioutil.TempDir("", "health-monitor")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeGardenClient = new(gardenfakes.FakeClient)
+		fakeBaggageclaimClient = new(baggageclaimfakes.FakeClient)
+
+		fakeBaggageclaimClient.ListVolumesReturns(baggageclaim.Volumes{
+			new(baggageclaimfakes.FakeVolume),
+			new(baggageclaimfakes.FakeVolume),
+		}, nil)
+
+		fakeVolume = new(baggageclaimfakes.FakeVolume)
+		fakeVolume.PathReturns("/some/volume/path")
+		fakeBaggageclaimClient.CreateVolumeReturns(fakeVolume, nil)
+
+		monitor = &worker.HealthMonitor{
+			Logger:   lagertest.NewTestLogger("test"),
+			Interval: 100 * time.Millisecond,
+			WorkDir:  workDir,
+
+			GardenClient:       fakeGardenClient,
+			BaggageclaimClient: fakeBaggageclaimClient,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(workDir)).To(Succeed())
+	})
+
+	Describe("Check", func() {
+		var health atc.WorkerHealth
+
+		JustBeforeEach(func() {
+			health = monitor.Check(lagertest.NewTestLogger("check"))
+		})
+
+		It("reports the disk usage of the work dir", func() {
+			Expect(health.DiskTotalBytes).ToNot(BeZero())
+			Expect(health.DiskFreeBytes).To(BeNumerically("<=", health.DiskTotalBytes))
+			Expect(health.InodesFree).To(BeNumerically("<=", health.InodesTotal))
+		})
+
+		It("reports the number of volumes", func() {
+			Expect(health.Volumes).To(Equal(2))
+		})
+
+		It("does not create a canary", func() {
+			Expect(fakeGardenClient.CreateCallCount()).To(BeZero())
+			Expect(fakeBaggageclaimClient.CreateVolumeCallCount()).To(BeZero())
+		})
+
+		It("is healthy when no thresholds are configured", func() {
+			Expect(health.Healthy()).To(BeTrue())
+		})
+
+		Context("when the free disk space is below the minimum", func() {
+			BeforeEach(func() {
+				monitor.MinFreeDiskPercent = 100
+			})
+
+			It("reports a breach", func() {
+				Expect(health.Healthy()).To(BeFalse())
+				Expect(health.Breaches).To(ConsistOf(ContainSubstring("free disk space")))
+			})
+		})
+
+		Context("when the free inodes are below the minimum", func() {
+			BeforeEach(func() {
+				monitor.MinFreeInodesPercent = 100
+			})
+
+			It("reports a breach", func() {
+				Expect(health.Healthy()).To(BeFalse())
+				Expect(health.Breaches).To(ConsistOf(ContainSubstring("free inodes")))
+			})
+		})
+
+		Context("when there are more volumes than the maximum", func() {
+			BeforeEach(func() {
+				monitor.MaxVolumes = 1
+			})
+
+			It("reports a breach", func() {
+				Expect(health.Breaches).To(ConsistOf("2 volumes exceeds the maximum of 1"))
+			})
+		})
+
+		Context("when there are at most the maximum volumes", func() {
+			BeforeEach(func() {
+				monitor.MaxVolumes = 2
+			})
+
+			It("is healthy", func() {
+				Expect(health.Healthy()).To(BeTrue())
+			})
+		})
+
+		Context("when the canary is enabled", func() {
+			BeforeEach(func() {
+				monitor.Canary = true
+			})
+
+			It("creates and destroys a container on an empty volume", func() {
+				Expect(fakeBaggageclaimClient.CreateVolumeCallCount()).To(Equal(1))
+				_, handle, spec := fakeBaggageclaimClient.CreateVolumeArgsForCall(0)
+				Expect(spec.Strategy).To(Equal(baggageclaim.EmptyStrategy{}))
+
+				Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+				Expect(fakeGardenClient.CreateArgsForCall(0)).To(Equal(garden.ContainerSpec{
+					Handle:     handle,
+					RootFSPath: "raw:///some/volume/path",
+				}))
+
+				Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+				Expect(fakeGardenClient.DestroyArgsForCall(0)).To(Equal(handle))
+				Expect(fakeVolume.DestroyCallCount()).To(Equal(1))
+			})
+
+			It("is healthy", func() {
+				Expect(health.Healthy()).To(BeTrue())
+				Expect(health.CanaryError).To(BeEmpty())
+			})
+
+			Context("when the container cannot be created", func() {
+				BeforeEach(func() {
+					fakeGardenClient.CreateReturns(nil, errors.New("nope"))
+				})
+
+				It("reports the error as a breach", func() {
+					Expect(health.CanaryError).To(Equal("create container: nope"))
+					Expect(health.Breaches).To(ConsistOf("failed to create canary container: create container: nope"))
+				})
+
+				It("still destroys the volume", func() {
+					Expect(fakeVolume.DestroyCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		JustBeforeEach(func() {
+			process = ifrit.Invoke(monitor)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive())
+		})
+
+		It("publishes the results of each check", func() {
+			health, changed := monitor.Health()
+			Expect(health).To(BeNil())
+
+			Eventually(changed).Should(BeClosed())
+
+			health, changed = monitor.Health()
+			Expect(health).ToNot(BeNil())
+			Expect(health.Volumes).To(Equal(2))
+
+			fakeBaggageclaimClient.ListVolumesReturns(baggageclaim.Volumes{}, nil)
+
+			Eventually(changed).Should(BeClosed())
+
+			Eventually(func() int {
+				health, _ := monitor.Health()
+				return health.Volumes
+			}).Should(Equal(0))
+		})
+	})
+})
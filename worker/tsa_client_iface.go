@@ -15,6 +15,8 @@ type TSAClient interface {
 	Retire(context.Context) error
 	Delete(context.Context) error
 
+	RunningBuilds(context.Context) ([]int, error)
+
 	ReportContainers(context.Context, []string) error
 	ContainersToDestroy(context.Context) ([]string, error)
 
@@ -92,6 +92,19 @@ type FakeTSAClient struct {
 	retireReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RunningBuildsStub        func(context.Context) ([]int, error)
+	runningBuildsMutex       sync.RWMutex
+	runningBuildsArgsForCall []struct {
+		arg1 context.Context
+	}
+	runningBuildsReturns struct {
+		result1 []int
+		result2 error
+	}
+	runningBuildsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	VolumesToDestroyStub        func(context.Context) ([]string, error)
 	volumesToDestroyMutex       sync.RWMutex
 	volumesToDestroyArgsForCall []struct {
@@ -545,6 +558,69 @@ func (fake *FakeTSAClient) RetireReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTSAClient) RunningBuilds(arg1 context.Context) ([]int, error) {
+	fake.runningBuildsMutex.Lock()
+	ret, specificReturn := fake.runningBuildsReturnsOnCall[len(fake.runningBuildsArgsForCall)]
+	fake.runningBuildsArgsForCall = append(fake.runningBuildsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("RunningBuilds", []interface{}{arg1})
+	fake.runningBuildsMutex.Unlock()
+	if fake.RunningBuildsStub != nil {
+		return fake.RunningBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runningBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTSAClient) RunningBuildsCallCount() int {
+	fake.runningBuildsMutex.RLock()
+	defer fake.runningBuildsMutex.RUnlock()
+	return len(fake.runningBuildsArgsForCall)
+}
+
+func (fake *FakeTSAClient) RunningBuildsCalls(stub func(context.Context) ([]int, error)) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = stub
+}
+
+func (fake *FakeTSAClient) RunningBuildsArgsForCall(i int) context.Context {
+	fake.runningBuildsMutex.RLock()
+	defer fake.runningBuildsMutex.RUnlock()
+	argsForCall := fake.runningBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTSAClient) RunningBuildsReturns(result1 []int, result2 error) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = nil
+	fake.runningBuildsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTSAClient) RunningBuildsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.runningBuildsMutex.Lock()
+	defer fake.runningBuildsMutex.Unlock()
+	fake.RunningBuildsStub = nil
+	if fake.runningBuildsReturnsOnCall == nil {
+		fake.runningBuildsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.runningBuildsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTSAClient) VolumesToDestroy(arg1 context.Context) ([]string, error) {
 	fake.volumesToDestroyMutex.Lock()
 	ret, specificReturn := fake.volumesToDestroyReturnsOnCall[len(fake.volumesToDestroyArgsForCall)]
@@ -625,6 +701,8 @@ func (fake *FakeTSAClient) Invocations() map[string][][]interface{} {
 	defer fake.reportVolumesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
+	fake.runningBuildsMutex.RLock()
+	defer fake.runningBuildsMutex.RUnlock()
 	fake.volumesToDestroyMutex.RLock()
 	defer fake.volumesToDestroyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}